@@ -3,6 +3,7 @@ package api
 import (
 	"context"
 	"fmt"
+	"strconv"
 	"sync/atomic"
 
 	"github.com/ssugameworks/kkemi/cache"
@@ -22,6 +23,12 @@ type CachedSolvedACClient struct {
 		SetUserAdditionalInfo(string, interface{})
 		GetUserOrganizations(string) (interface{}, bool)
 		SetUserOrganizations(string, interface{})
+		GetUserSolvedProblems(string) (interface{}, bool)
+		SetUserSolvedProblems(string, interface{})
+		GetProblemInfo(string) (interface{}, bool)
+		SetProblemInfo(string, interface{})
+		GetClassProblems(string) (interface{}, bool)
+		SetClassProblems(string, interface{})
 		GetStats() cache.CacheStats
 		Clear()
 	}
@@ -84,6 +91,21 @@ func (cachedClient *CachedSolvedACClient) GetUserInfo(ctx context.Context, handl
 	return userInfo, nil
 }
 
+// RefreshUserInfo 캐시를 거치지 않고 사용자 정보를 조회한 뒤 캐시를 갱신합니다 (자기소개 변경 확인용)
+func (cachedClient *CachedSolvedACClient) RefreshUserInfo(ctx context.Context, handle string) (*UserInfo, error) {
+	atomic.AddInt64(&cachedClient.totalCalls, 1)
+	atomic.AddInt64(&cachedClient.cacheMisses, 1)
+	utils.Debug("Refreshing user info: %s, bypassing cache", handle)
+
+	userInfo, err := cachedClient.client.GetUserInfo(ctx, handle)
+	if err != nil {
+		return nil, err
+	}
+
+	cachedClient.cache.SetUserInfo(handle, userInfo)
+	return userInfo, nil
+}
+
 // GetUserTop100 캐시를 통해 사용자 TOP 100을 조회합니다
 func (cachedClient *CachedSolvedACClient) GetUserTop100(ctx context.Context, handle string) (*Top100Response, error) {
 	atomic.AddInt64(&cachedClient.totalCalls, 1)
@@ -162,6 +184,100 @@ func (cachedClient *CachedSolvedACClient) GetUserOrganizations(ctx context.Conte
 	return organizations, nil
 }
 
+// GetUserSolvedProblems 캐시를 통해 사용자의 전체 해결 문제 목록을 조회합니다
+func (cachedClient *CachedSolvedACClient) GetUserSolvedProblems(ctx context.Context, handle string) ([]ProblemInfo, error) {
+	atomic.AddInt64(&cachedClient.totalCalls, 1)
+
+	// 캐시에서 먼저 조회
+	if cachedData, found := cachedClient.cache.GetUserSolvedProblems(handle); found {
+		atomic.AddInt64(&cachedClient.cacheHits, 1)
+		utils.Debug("Cache hit for user solved problems: %s", handle)
+		return cachedData.([]ProblemInfo), nil
+	}
+
+	// 캐시 미스 - API 호출
+	atomic.AddInt64(&cachedClient.cacheMisses, 1)
+	utils.Debug("Cache miss for user solved problems: %s, calling API", handle)
+
+	problems, err := cachedClient.client.GetUserSolvedProblems(ctx, handle)
+	if err != nil {
+		return nil, err
+	}
+
+	// 성공한 응답을 캐시에 저장
+	cachedClient.cache.SetUserSolvedProblems(handle, problems)
+
+	return problems, nil
+}
+
+// GetProblemInfo 캐시를 통해 태그를 포함한 문제 정보를 조회합니다
+func (cachedClient *CachedSolvedACClient) GetProblemInfo(ctx context.Context, problemID int) (*ProblemInfo, error) {
+	atomic.AddInt64(&cachedClient.totalCalls, 1)
+	key := strconv.Itoa(problemID)
+
+	// 캐시에서 먼저 조회
+	if cachedData, found := cachedClient.cache.GetProblemInfo(key); found {
+		atomic.AddInt64(&cachedClient.cacheHits, 1)
+		utils.Debug("Cache hit for problem info: %d", problemID)
+		return cachedData.(*ProblemInfo), nil
+	}
+
+	// 캐시 미스 - API 호출
+	atomic.AddInt64(&cachedClient.cacheMisses, 1)
+	utils.Debug("Cache miss for problem info: %d, calling API", problemID)
+
+	problem, err := cachedClient.client.GetProblemInfo(ctx, problemID)
+	if err != nil {
+		return nil, err
+	}
+
+	// 성공한 응답을 캐시에 저장
+	cachedClient.cache.SetProblemInfo(key, problem)
+
+	return problem, nil
+}
+
+// GetClassProblemIDs 캐시를 통해 클래스 소속 문제 번호 목록을 조회합니다
+func (cachedClient *CachedSolvedACClient) GetClassProblemIDs(ctx context.Context, class int) ([]int, error) {
+	atomic.AddInt64(&cachedClient.totalCalls, 1)
+	key := strconv.Itoa(class)
+
+	// 캐시에서 먼저 조회
+	if cachedData, found := cachedClient.cache.GetClassProblems(key); found {
+		atomic.AddInt64(&cachedClient.cacheHits, 1)
+		utils.Debug("Cache hit for class problems: %d", class)
+		return cachedData.([]int), nil
+	}
+
+	// 캐시 미스 - API 호출
+	atomic.AddInt64(&cachedClient.cacheMisses, 1)
+	utils.Debug("Cache miss for class problems: %d, calling API", class)
+
+	problemIDs, err := cachedClient.client.GetClassProblemIDs(ctx, class)
+	if err != nil {
+		return nil, err
+	}
+
+	// 성공한 응답을 캐시에 저장
+	cachedClient.cache.SetClassProblems(key, problemIDs)
+
+	return problemIDs, nil
+}
+
+// SearchProblems 문제 검색은 쿼리가 다양하므로 캐시 없이 그대로 호출합니다
+func (cachedClient *CachedSolvedACClient) SearchProblems(ctx context.Context, query string, page int) (*ProblemSearchResponse, error) {
+	atomic.AddInt64(&cachedClient.totalCalls, 1)
+	atomic.AddInt64(&cachedClient.cacheMisses, 1)
+	return cachedClient.client.SearchProblems(ctx, query, page)
+}
+
+// GetOrganization 단체 정보는 참가 자격 설정과 거절 안내에서만 드물게 조회하므로 캐시 없이 그대로 호출합니다
+func (cachedClient *CachedSolvedACClient) GetOrganization(ctx context.Context, organizationID int) (*Organization, error) {
+	atomic.AddInt64(&cachedClient.totalCalls, 1)
+	atomic.AddInt64(&cachedClient.cacheMisses, 1)
+	return cachedClient.client.GetOrganization(ctx, organizationID)
+}
+
 // GetCacheStats 캐시 통계를 반환합니다
 func (cachedClient *CachedSolvedACClient) GetCacheStats() CacheMetrics {
 	cacheStats := cachedClient.cache.GetStats()
@@ -183,6 +299,9 @@ func (cachedClient *CachedSolvedACClient) GetCacheStats() CacheMetrics {
 		UserInfoCached:       cacheStats.UserInfoCount,
 		UserTop100Cached:     cacheStats.UserTop100Count,
 		UserAdditionalCached: cacheStats.UserAdditionalCount,
+		UserSolvedCached:     cacheStats.UserSolvedCount,
+		ProblemInfoCached:    cacheStats.ProblemInfoCount,
+		ClassProblemsCached:  cacheStats.ClassProblemsCount,
 	}
 }
 
@@ -195,13 +314,17 @@ type CacheMetrics struct {
 	UserInfoCached       int
 	UserTop100Cached     int
 	UserAdditionalCached int
+	UserSolvedCached     int
+	ProblemInfoCached    int
+	ClassProblemsCached  int
 }
 
 // String CacheMetrics의 문자열 표현을 반환합니다
 func (metrics CacheMetrics) String() string {
-	return fmt.Sprintf("API Cache Stats: Calls=%d, Hits=%d, Misses=%d, Hit Rate=%.2f%%, Cached Items: UserInfo=%d, Top100=%d, Additional=%d",
+	return fmt.Sprintf("API Cache Stats: Calls=%d, Hits=%d, Misses=%d, Hit Rate=%.2f%%, Cached Items: UserInfo=%d, Top100=%d, Additional=%d, Solved=%d, Problems=%d, Classes=%d",
 		metrics.TotalCalls, metrics.CacheHits, metrics.CacheMisses, metrics.HitRate,
-		metrics.UserInfoCached, metrics.UserTop100Cached, metrics.UserAdditionalCached)
+		metrics.UserInfoCached, metrics.UserTop100Cached, metrics.UserAdditionalCached, metrics.UserSolvedCached,
+		metrics.ProblemInfoCached, metrics.ClassProblemsCached)
 }
 
 // ClearCache 모든 캐시를 삭제합니다
@@ -229,8 +352,8 @@ func (cachedClient *CachedSolvedACClient) WarmupCache(handles []string) error {
 			if _, err := cachedClient.GetUserInfo(ctx, h); err != nil {
 				utils.Warn("Cache warmup failed for user info %s: %v", h, err)
 			}
-			if _, err := cachedClient.GetUserTop100(ctx, h); err != nil {
-				utils.Warn("Cache warmup failed for top100 %s: %v", h, err)
+			if _, err := cachedClient.GetUserSolvedProblems(ctx, h); err != nil {
+				utils.Warn("Cache warmup failed for solved problems %s: %v", h, err)
 			}
 		}(handle)
 	}
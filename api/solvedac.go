@@ -3,15 +3,22 @@ package api
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/ssugameworks/kkemi/constants"
 	"github.com/ssugameworks/kkemi/utils"
 )
 
+// ErrNotFound solved.ac가 404로 응답해 대상이 없음이 확인된 경우의 에러입니다 (일시적인 장애와 구분)
+var ErrNotFound = errors.New("solved.ac에서 찾을 수 없습니다")
+
 // SolvedACClient solved.ac API와 통신하는 클라이언트입니다
 type SolvedACClient struct {
 	client  *http.Client
@@ -30,15 +37,41 @@ type UserInfo struct {
 	SolvedCount     int    `json:"solvedCount"`
 	Verified        bool   `json:"verified"`
 	Rank            int    `json:"rank"`
+	// JoinedAt solved.ac 가입 시각 (응답에 없으면 zero)
+	JoinedAt time.Time `json:"joinedAt"`
 }
 
 // ProblemInfo solved.ac 문제 정보를 나타냅니다
 type ProblemInfo struct {
-	ProblemID         int     `json:"problemId"`
-	Level             int     `json:"level"`
-	TitleKo           string  `json:"titleKo"`
-	AcceptedUserCount int     `json:"acceptedUserCount"`
-	AverageTries      float64 `json:"averageTries"`
+	ProblemID         int          `json:"problemId"`
+	Level             int          `json:"level"`
+	TitleKo           string       `json:"titleKo"`
+	AcceptedUserCount int          `json:"acceptedUserCount"`
+	AverageTries      float64      `json:"averageTries"`
+	Tags              []ProblemTag `json:"tags"`
+}
+
+// ProblemTag solved.ac 알고리즘 태그를 나타냅니다
+type ProblemTag struct {
+	Key          string           `json:"key"`
+	DisplayNames []TagDisplayName `json:"displayNames"`
+}
+
+// TagDisplayName 언어별 태그 표시 이름을 나타냅니다
+type TagDisplayName struct {
+	Language string `json:"language"`
+	Name     string `json:"name"`
+	Short    string `json:"short"`
+}
+
+// HasTag 문제에 지정된 태그 키가 붙어 있는지 확인합니다 (대소문자 무시)
+func (problem *ProblemInfo) HasTag(key string) bool {
+	for _, tag := range problem.Tags {
+		if strings.EqualFold(tag.Key, key) {
+			return true
+		}
+	}
+	return false
 }
 
 // Top100Response 사용자의 TOP 100 문제 응답을 나타냅니다
@@ -47,6 +80,12 @@ type Top100Response struct {
 	Items []ProblemInfo `json:"items"`
 }
 
+// ProblemSearchResponse 문제 검색 결과의 한 페이지를 나타냅니다
+type ProblemSearchResponse struct {
+	Count int           `json:"count"`
+	Items []ProblemInfo `json:"items"`
+}
+
 // UserAdditionalInfo 사용자의 추가 정보를 나타냅니다
 type UserAdditionalInfo struct {
 	CountryCode *string `json:"countryCode"`
@@ -125,6 +164,11 @@ func (client *SolvedACClient) doRequest(ctx context.Context, url, requestType, h
 			continue
 		}
 
+		if resp.StatusCode == http.StatusNotFound {
+			lastErr = fmt.Errorf("%w: %s", ErrNotFound, handle)
+			break
+		}
+
 		if resp.StatusCode != http.StatusOK {
 			lastErr = fmt.Errorf("API가 상태 코드 %d를 반환했습니다", resp.StatusCode)
 			utils.Warn("API returned non-200 status for %s %s: %d", requestType, handle, resp.StatusCode)
@@ -254,3 +298,134 @@ func (client *SolvedACClient) getUserOrganizationsWithRetry(ctx context.Context,
 	utils.Debug("Successfully fetched %d organizations for %s", len(organizations), handle)
 	return organizations, nil
 }
+
+// GetOrganization 단체 ID로 solved.ac 단체 정보를 가져옵니다
+func (client *SolvedACClient) GetOrganization(ctx context.Context, organizationID int) (*Organization, error) {
+	if organizationID <= 0 {
+		return nil, fmt.Errorf("잘못된 단체 ID: %d", organizationID)
+	}
+
+	requestURL := fmt.Sprintf("%s/organization/show?organizationId=%d", client.baseURL, organizationID)
+	body, err := client.doRequest(ctx, requestURL, "organization info", strconv.Itoa(organizationID))
+	if err != nil {
+		return nil, err
+	}
+
+	var organization Organization
+	if err := json.Unmarshal(body, &organization); err != nil {
+		utils.Error("Failed to parse organization %d: %v", organizationID, err)
+		return nil, fmt.Errorf("단체 정보 파싱 실패: %w", err)
+	}
+
+	return &organization, nil
+}
+
+// SearchProblems solved.ac 검색 쿼리로 문제 목록의 한 페이지를 가져옵니다 (문제 번호 오름차순)
+func (client *SolvedACClient) SearchProblems(ctx context.Context, query string, page int) (*ProblemSearchResponse, error) {
+	if page < 1 {
+		page = 1
+	}
+
+	requestURL := fmt.Sprintf("%s/search/problem?query=%s&sort=id&direction=asc&page=%d",
+		client.baseURL, url.QueryEscape(query), page)
+	body, err := client.doRequest(ctx, requestURL, "problem search", query)
+	if err != nil {
+		return nil, err
+	}
+
+	var result ProblemSearchResponse
+	if err := json.Unmarshal(body, &result); err != nil {
+		utils.Error("Failed to parse problem search result for %s: %v", query, err)
+		return nil, fmt.Errorf("문제 검색 결과 파싱 실패: %w", err)
+	}
+
+	return &result, nil
+}
+
+// GetUserSolvedProblems 지정된 사용자가 해결한 모든 문제를 페이지네이션하여 가져옵니다
+func (client *SolvedACClient) GetUserSolvedProblems(ctx context.Context, handle string) ([]ProblemInfo, error) {
+	if !utils.IsValidBaekjoonID(handle) {
+		return nil, fmt.Errorf("잘못된 핸들 형식: %s", handle)
+	}
+
+	problems, err := client.searchAllProblems(ctx, "s@"+handle)
+	if err != nil {
+		return nil, err
+	}
+
+	utils.Debug("Successfully fetched %d solved problems for %s", len(problems), handle)
+	return problems, nil
+}
+
+// GetProblemInfo 문제 번호로 태그를 포함한 문제 정보를 가져옵니다
+func (client *SolvedACClient) GetProblemInfo(ctx context.Context, problemID int) (*ProblemInfo, error) {
+	if problemID <= 0 {
+		return nil, fmt.Errorf("잘못된 문제 번호: %d", problemID)
+	}
+
+	requestURL := fmt.Sprintf("%s/problem/show?problemId=%d", client.baseURL, problemID)
+	body, err := client.doRequest(ctx, requestURL, "problem info", strconv.Itoa(problemID))
+	if err != nil {
+		return nil, err
+	}
+
+	var problem ProblemInfo
+	if err := json.Unmarshal(body, &problem); err != nil {
+		utils.Error("Failed to parse problem info for %d: %v", problemID, err)
+		return nil, fmt.Errorf("문제 정보 파싱 실패: %w", err)
+	}
+
+	return &problem, nil
+}
+
+// GetClassProblemIDs 지정된 solved.ac 클래스에 속한 문제 번호 목록을 가져옵니다
+func (client *SolvedACClient) GetClassProblemIDs(ctx context.Context, class int) ([]int, error) {
+	if class < constants.MinSolvedACClass || class > constants.MaxSolvedACClass {
+		return nil, fmt.Errorf("잘못된 클래스: %d", class)
+	}
+
+	problems, err := client.searchAllProblems(ctx, fmt.Sprintf(constants.ClassSearchQueryFormat, class))
+	if err != nil {
+		return nil, err
+	}
+
+	problemIDs := make([]int, 0, len(problems))
+	for _, problem := range problems {
+		problemIDs = append(problemIDs, problem.ProblemID)
+	}
+
+	utils.Debug("Successfully fetched %d problems for class %d", len(problemIDs), class)
+	return problemIDs, nil
+}
+
+// searchAllProblems 검색 쿼리의 모든 페이지를 순회하여 중복 없이 문제를 모읍니다
+func (client *SolvedACClient) searchAllProblems(ctx context.Context, query string) ([]ProblemInfo, error) {
+	seen := make(map[int]bool)
+	var problems []ProblemInfo
+
+	for page := 1; page <= constants.MaxSolvedProblemPages; page++ {
+		result, err := client.SearchProblems(ctx, query, page)
+		if err != nil {
+			return nil, err
+		}
+
+		if page == 1 {
+			problems = make([]ProblemInfo, 0, result.Count)
+		}
+
+		for _, problem := range result.Items {
+			// 조회 도중 새 문제를 풀면 페이지 경계가 밀릴 수 있으므로 중복 제거
+			if seen[problem.ProblemID] {
+				continue
+			}
+			seen[problem.ProblemID] = true
+			problems = append(problems, problem)
+		}
+
+		if len(result.Items) < constants.SolvedProblemsPageSize || len(problems) >= result.Count {
+			break
+		}
+	}
+
+	return problems, nil
+}
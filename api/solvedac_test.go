@@ -2,8 +2,12 @@ package api
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"strconv"
+	"strings"
 	"testing"
 	"time"
 
@@ -103,6 +107,9 @@ func TestSolvedACClient_GetUserInfo_NotFound(t *testing.T) {
 	if err == nil {
 		t.Error("Expected error for non-existent user")
 	}
+	if !errors.Is(err, ErrNotFound) {
+		t.Errorf("Expected ErrNotFound, got %v", err)
+	}
 
 	if userInfo != nil {
 		t.Error("Expected nil userInfo on error")
@@ -169,6 +176,88 @@ func TestSolvedACClient_GetUserTop100_Success(t *testing.T) {
 	}
 }
 
+func TestSolvedACClient_GetUserSolvedProblems_Pagination(t *testing.T) {
+	// 3페이지(50 + 50 + 1)에 걸친 해결 문제 목록을 제공하는 Mock 서버
+	total := 2*constants.SolvedProblemsPageSize + 1
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/search/problem" {
+			t.Errorf("Expected path '/search/problem', got '%s'", r.URL.Path)
+		}
+		if query := r.URL.Query().Get("query"); query != "s@testuser" {
+			t.Errorf("Expected query 's@testuser', got '%s'", query)
+		}
+
+		page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+		start := (page - 1) * constants.SolvedProblemsPageSize
+		end := start + constants.SolvedProblemsPageSize
+		if end > total {
+			end = total
+		}
+
+		items := make([]string, 0, constants.SolvedProblemsPageSize)
+		for i := start; i < end; i++ {
+			items = append(items, fmt.Sprintf(`{"problemId": %d, "level": %d}`, 1000+i, i%30+1))
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, `{"count": %d, "items": [%s]}`, total, strings.Join(items, ","))
+	}))
+	defer server.Close()
+
+	client := &SolvedACClient{
+		client:  &http.Client{Timeout: constants.TestAPITimeout},
+		baseURL: server.URL,
+	}
+
+	problems, err := client.GetUserSolvedProblems(context.Background(), "testuser")
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	if len(problems) != total {
+		t.Fatalf("Expected %d problems, got %d", total, len(problems))
+	}
+
+	if problems[total-1].ProblemID != 1000+total-1 {
+		t.Errorf("Expected last problem ID %d, got %d", 1000+total-1, problems[total-1].ProblemID)
+	}
+}
+
+func TestSolvedACClient_GetProblemInfo_Tags(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/problem/show" {
+			t.Errorf("Expected path '/problem/show', got '%s'", r.URL.Path)
+		}
+		if id := r.URL.Query().Get("problemId"); id != "1932" {
+			t.Errorf("Expected problemId '1932', got '%s'", id)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{
+			"problemId": 1932,
+			"level": 9,
+			"tags": [{"key": "dp", "displayNames": [{"language": "ko", "name": "다이나믹 프로그래밍", "short": "dp"}]}]
+		}`))
+	}))
+	defer server.Close()
+
+	client := &SolvedACClient{
+		client:  &http.Client{Timeout: constants.TestAPITimeout},
+		baseURL: server.URL,
+	}
+
+	problem, err := client.GetProblemInfo(context.Background(), 1932)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	if !problem.HasTag("DP") {
+		t.Errorf("Expected problem to have tag 'dp', got %v", problem.Tags)
+	}
+}
+
 func TestSolvedACClient_GetUserAdditionalInfo_Success(t *testing.T) {
 	// Mock 서버 생성
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
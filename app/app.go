@@ -132,18 +132,36 @@ func (app *Application) setupHandlers() {
 	app.tierManager = models.GetTierManager()
 
 	// 의존성 주입을 통한 컴포넌트 생성
-	calculator := scoring.NewScoreCalculator(app.apiClient, app.tierManager)
+	calculator := scoring.NewScoreCalculatorWithRules(app.apiClient, app.tierManager, app.loadScoringRules())
 	app.scoreboardManager = bot.NewScoreboardManager(app.storage, calculator, app.apiClient, app.tierManager)
-	deps := bot.NewCommandDependencies(app.storage, app.apiClient, app.scoreboardManager, app.tierManager, calculator, app.session, app.metricsClient, app.sheetsClient)
+	deps := bot.NewCommandDependencies(app.storage, app.apiClient, app.scoreboardManager, app.tierManager, calculator, app.session, app.metricsClient, app.sheetsClient,
+		app.config.Discord.GuildID, app.config.Discord.AuditChannelID)
 	app.commandHandler = bot.NewCommandHandler(deps)
 
 	app.session.AddHandler(app.commandHandler.HandleMessage)
+	app.session.AddHandler(app.commandHandler.HandleInteraction)
 	app.session.AddHandler(app.handleReady)
 
 	// 캐시 워밍업 - 기존 참가자 데이터로 캐시 미리 로드
 	app.warmupCache()
 }
 
+// loadScoringRules 설정된 규칙 파일을 읽고, 없거나 잘못된 경우 기본 규칙을 사용합니다
+func (app *Application) loadScoringRules() *models.ScoringRules {
+	if app.config.Scoring.RulesFile == "" {
+		return models.DefaultScoringRules()
+	}
+
+	rules, err := models.LoadScoringRulesFile(app.config.Scoring.RulesFile)
+	if err != nil {
+		utils.Warn("Failed to load scoring rules from %s, using defaults: %v", app.config.Scoring.RulesFile, err)
+		return models.DefaultScoringRules()
+	}
+
+	utils.Info("Loaded scoring rules '%s' from %s", rules.Name, app.config.Scoring.RulesFile)
+	return rules
+}
+
 func (app *Application) initializeScheduler() {
 	app.scheduler = scheduler.NewScheduler(app.session, app.config, app.scoreboardManager)
 }
@@ -164,6 +182,12 @@ func (app *Application) Start() error {
 		utils.Warn("DISCORD_CHANNEL_ID가 설정되지 않았습니다. 스코어보드가 비활성화되었습니다.")
 	}
 
+	// 발송 시각을 따로 지정한 대회의 스코어보드 스케줄러 시작
+	app.scheduler.StartCompetitionSchedules()
+
+	// 해결 기록 수집 스케줄러 시작 (대회 기간 판정의 기준 시각)
+	app.scheduler.StartSolveTracking()
+
 	// 스프레드시트 업데이트 스케줄러 시작
 	app.scheduler.StartSheetsUpdate()
 	utils.Info("📊 30분마다 스프레드시트가 자동으로 업데이트됩니다.")
@@ -200,6 +224,11 @@ func (app *Application) handleReady(s *discordgo.Session, event *discordgo.Ready
 
 	// 봇 상태 설정
 	app.updateBotStatus(s)
+
+	// 슬래시 명령어 등록 (실패해도 텍스트 명령어는 계속 사용 가능)
+	if err := bot.RegisterSlashCommands(s, app.config.Discord.GuildID); err != nil {
+		utils.Warn("Failed to register slash commands: %v", err)
+	}
 }
 
 // updateBotStatus 봇의 상태를 현재 대회에 맞게 업데이트합니다
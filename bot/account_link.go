@@ -0,0 +1,264 @@
+package bot
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/ssugameworks/kkemi/constants"
+	"github.com/ssugameworks/kkemi/errors"
+	"github.com/ssugameworks/kkemi/models"
+	"github.com/ssugameworks/kkemi/utils"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+// discordLink 참가자와 연결할 디스코드 사용자
+type discordLink struct {
+	BaekjoonID string
+	UserID     string
+}
+
+// handleMyInfo 작성자와 연결된 참가자 정보를 보여줍니다
+func (handler *CommandHandler) handleMyInfo(session *discordgo.Session, message *discordgo.MessageCreate) {
+	competition := handler.deps.Storage.GetCompetition()
+	if competition == nil {
+		utils.NewErrorHandlerFactory(session, message.ChannelID).Data().HandleNoActiveCompetition()
+		return
+	}
+
+	participant, ok := handler.authorParticipant(session, message, competition)
+	if !ok {
+		return
+	}
+
+	description := fmt.Sprintf(constants.MsgMyInfoDescription,
+		participant.Name, participant.BaekjoonID,
+		handler.deps.TierManager.GetTierName(participant.StartTier),
+		utils.FormatDateTime(participant.CreatedAt),
+		formatDiscordUser(participant.DiscordUserID))
+	if previous := handler.previousHandles(participant); len(previous) > 0 {
+		description += fmt.Sprintf(constants.MsgMyInfoPreviousHandles, strings.Join(previous, ", "))
+	}
+
+	embed := &discordgo.MessageEmbed{
+		Title:       fmt.Sprintf(constants.MsgMyInfoTitle, competition.Name),
+		Description: description,
+		Color:       handler.deps.TierManager.GetTierColor(participant.StartTier),
+	}
+	if _, err := session.ChannelMessageSendEmbed(message.ChannelID, embed); err != nil {
+		utils.Error("DISCORD API ERROR: Failed to send participant info: %v", err)
+	}
+}
+
+// previousHandles 참가자가 이전에 사용한 백준 ID를 변경 순서대로 반환합니다
+func (handler *CommandHandler) previousHandles(participant models.Participant) []string {
+	changes, err := handler.deps.Storage.GetHandleChanges()
+	if err != nil {
+		utils.Warn("Failed to load handle changes: %v", err)
+		return nil
+	}
+	return models.PreviousBaekjoonIDs(changes, participant.BaekjoonID)
+}
+
+// handleAccountLink 참가자와 디스코드 계정 연결을 관리합니다 (`link|unlink|migrate`)
+func (handler *CommandHandler) handleAccountLink(session *discordgo.Session, message *discordgo.MessageCreate, params []string) {
+	errorHandlers := utils.NewErrorHandlerFactory(session, message.ChannelID)
+
+	if handler.deps.Storage.GetCompetition() == nil {
+		errorHandlers.Data().HandleNoActiveCompetition()
+		return
+	}
+
+	action := ""
+	if len(params) > 0 {
+		action = params[0]
+	}
+
+	switch {
+	case action == "link" && len(params) == 3:
+		userID := parseMentionID(params[2])
+		if userID == "" {
+			errorHandlers.Validation().HandleInvalidParams("LINK_INVALID_USER",
+				fmt.Sprintf("Invalid discord user: %s", params[2]),
+				constants.MsgLinkUsage)
+			return
+		}
+		handler.updateAccountLink(session, message, params[1], userID)
+	case action == "unlink" && len(params) == 2:
+		handler.updateAccountLink(session, message, params[1], "")
+	case action == "migrate" && len(params) == 1:
+		handler.handleLinkMigration(session, message)
+	default:
+		errorHandlers.Validation().HandleInvalidParams("LINK_INVALID_PARAMS",
+			"Invalid account link parameters",
+			constants.MsgLinkUsage)
+	}
+}
+
+// updateAccountLink 참가자를 다른 디스코드 사용자에게 다시 연결하거나 연결을 해제합니다
+func (handler *CommandHandler) updateAccountLink(session *discordgo.Session, message *discordgo.MessageCreate, baekjoonID, userID string) {
+	errorHandlers := utils.NewErrorHandlerFactory(session, message.ChannelID)
+
+	participants := handler.deps.Storage.GetParticipants()
+	participant, found := findParticipant(participants, baekjoonID)
+	if !found {
+		errorHandlers.Data().HandleParticipantNotFound(baekjoonID)
+		return
+	}
+	if linked, exists := models.FindParticipantByDiscordUser(participants, userID); exists && linked.BaekjoonID != participant.BaekjoonID {
+		errorHandlers.Validation().HandleInvalidParams("LINK_USER_TAKEN",
+			fmt.Sprintf("Discord user %s is already linked to %s", userID, linked.BaekjoonID),
+			fmt.Sprintf(constants.MsgLinkUserTaken, formatDiscordUser(userID), linked.BaekjoonID))
+		return
+	}
+
+	if err := handler.deps.Storage.LinkDiscordUser(participant.BaekjoonID, userID); err != nil {
+		errorHandlers.System().HandleSystemError("LINK_UPDATE_FAILED",
+			"Failed to update discord link", constants.MsgLinkUpdateFailed, err)
+		return
+	}
+
+	entry := models.AuditEntry{
+		Action: models.AuditActionParticipantLink,
+		Target: participant.BaekjoonID,
+		Before: formatOptionalDiscordUser(participant.DiscordUserID),
+		After:  formatOptionalDiscordUser(userID),
+	}
+	response := fmt.Sprintf(constants.MsgLinkUpdated, participant.BaekjoonID, formatDiscordUser(userID))
+	if userID == "" {
+		entry.Action = models.AuditActionParticipantUnlink
+		response = fmt.Sprintf(constants.MsgLinkRemoved, participant.BaekjoonID)
+	}
+	if competition := handler.deps.Storage.GetCompetition(); competition != nil {
+		entry.CompetitionID = competition.ID
+	}
+	handler.recordAudit(session, message, entry)
+	errors.SendDiscordSuccess(session, message.ChannelID, response)
+}
+
+// handleLinkMigration 연결되지 않은 기존 참가자를 서버 멤버의 이름으로 찾아 한꺼번에 연결합니다
+func (handler *CommandHandler) handleLinkMigration(session *discordgo.Session, message *discordgo.MessageCreate) {
+	errorHandlers := utils.NewErrorHandlerFactory(session, message.ChannelID)
+
+	guildID := handler.authorizationGuild(message)
+	members, err := fetchGuildMembers(session, guildID)
+	if guildID == "" || err != nil {
+		errorHandlers.System().HandleSystemError("LINK_MIGRATE_MEMBERS_FAILED",
+			"Failed to list guild members", constants.MsgLinkMigrateMembersFailed, err)
+		return
+	}
+
+	participants := handler.deps.Storage.GetParticipants()
+	links := matchDiscordLinks(participants, members)
+	linked := 0
+	for _, link := range links {
+		if err := handler.deps.Storage.LinkDiscordUser(link.BaekjoonID, link.UserID); err != nil {
+			utils.Warn("Failed to migrate discord link for %s: %v", link.BaekjoonID, err)
+			continue
+		}
+		linked++
+	}
+
+	unlinked := 0
+	for _, participant := range participants {
+		if participant.DiscordUserID == "" {
+			unlinked++
+		}
+	}
+
+	if linked > 0 {
+		entry := models.AuditEntry{
+			Action: models.AuditActionParticipantLink,
+			Target: constants.MsgLinkMigrateTarget,
+			After:  fmt.Sprintf(constants.MsgLinkMigrateAudit, linked),
+		}
+		if competition := handler.deps.Storage.GetCompetition(); competition != nil {
+			entry.CompetitionID = competition.ID
+		}
+		handler.recordAudit(session, message, entry)
+	}
+	errors.SendDiscordSuccess(session, message.ChannelID, fmt.Sprintf(constants.MsgLinkMigrated, linked, unlinked-linked))
+}
+
+// fetchGuildMembers 서버 멤버 전체를 페이지 단위로 가져옵니다 (Server Members Intent 필요)
+func fetchGuildMembers(session *discordgo.Session, guildID string) ([]*discordgo.Member, error) {
+	var members []*discordgo.Member
+	after := ""
+	for {
+		page, err := session.GuildMembers(guildID, after, constants.GuildMembersPageSize)
+		if err != nil {
+			return nil, err
+		}
+		members = append(members, page...)
+		if len(page) < constants.GuildMembersPageSize {
+			return members, nil
+		}
+		after = page[len(page)-1].User.ID
+	}
+}
+
+// matchDiscordLinks 연결되지 않은 참가자와 이름(사용자명, 표시 이름, 별명)이 하나씩만 일치하는 멤버를 짝지어 줍니다
+func matchDiscordLinks(participants []models.Participant, members []*discordgo.Member) []discordLink {
+	taken := make(map[string]bool)
+	for _, participant := range participants {
+		if participant.DiscordUserID != "" {
+			taken[participant.DiscordUserID] = true
+		}
+	}
+
+	candidates := make(map[string][]string) // key: BaekjoonID → 일치하는 사용자 ID
+	claims := make(map[string]int)          // key: 사용자 ID → 일치하는 참가자 수
+	for _, participant := range participants {
+		if participant.DiscordUserID != "" {
+			continue
+		}
+		for _, member := range members {
+			if member.User == nil || member.User.Bot || taken[member.User.ID] {
+				continue
+			}
+			if memberMatchesParticipant(member.User, member.Nick, participant) {
+				candidates[participant.BaekjoonID] = append(candidates[participant.BaekjoonID], member.User.ID)
+				claims[member.User.ID]++
+			}
+		}
+	}
+
+	// 이름이 겹치는 경우는 잘못 연결하지 않도록 관리자가 직접 연결하게 둡니다
+	links := make([]discordLink, 0, len(candidates))
+	for _, participant := range participants {
+		users := candidates[participant.BaekjoonID]
+		if len(users) == 1 && claims[users[0]] == 1 {
+			links = append(links, discordLink{BaekjoonID: participant.BaekjoonID, UserID: users[0]})
+		}
+	}
+	return links
+}
+
+// memberMatchesParticipant 디스코드 사용자명, 표시 이름 또는 서버 별명이 참가자의 백준 ID나 이름과 같은지 확인합니다
+func memberMatchesParticipant(user *discordgo.User, nick string, participant models.Participant) bool {
+	for _, candidate := range []string{user.Username, user.GlobalName, nick} {
+		if candidate == "" {
+			continue
+		}
+		if strings.EqualFold(candidate, participant.BaekjoonID) || candidate == participant.Name {
+			return true
+		}
+	}
+	return false
+}
+
+// formatDiscordUser 디스코드 사용자를 멘션으로 표시합니다 (연결 전이면 안내 문구)
+func formatDiscordUser(userID string) string {
+	if userID == "" {
+		return constants.MsgMyInfoUnlinkedUser
+	}
+	return formatPermissionTarget(models.PermissionTargetUser, userID)
+}
+
+// formatOptionalDiscordUser 감사 로그용 디스코드 사용자 표시 (없으면 빈 문자열)
+func formatOptionalDiscordUser(userID string) string {
+	if userID == "" {
+		return ""
+	}
+	return formatDiscordUser(userID)
+}
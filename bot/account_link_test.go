@@ -0,0 +1,88 @@
+package bot
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ssugameworks/kkemi/models"
+	"github.com/ssugameworks/kkemi/storage"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+func TestMatchDiscordLinks(t *testing.T) {
+	participants := []models.Participant{
+		{Name: "앨리스", BaekjoonID: "alice"},
+		{Name: "밥", BaekjoonID: "bob"},
+		{Name: "캐럴", BaekjoonID: "carol", DiscordUserID: "3"},
+		{Name: "데이브", BaekjoonID: "dave"},
+	}
+	members := []*discordgo.Member{
+		{User: &discordgo.User{ID: "1", Username: "Alice"}},
+		{User: &discordgo.User{ID: "2", Username: "someone"}, Nick: "밥"},
+		{User: &discordgo.User{ID: "4", Username: "bob"}},
+		{User: &discordgo.User{ID: "3", Username: "dave"}},
+		{User: &discordgo.User{ID: "5", Username: "carol"}},
+		{User: &discordgo.User{ID: "6", Username: "dave", Bot: true}},
+	}
+
+	links := matchDiscordLinks(participants, members)
+	if len(links) != 1 || links[0] != (discordLink{BaekjoonID: "alice", UserID: "1"}) {
+		t.Errorf("Expected only alice to be linked, got %+v", links)
+	}
+}
+
+func TestResolveAuthorHandle(t *testing.T) {
+	store := storage.NewInMemoryStorage(&MockSolvedACClient{})
+	start := time.Now().AddDate(0, 0, -1)
+	if _, err := store.CreateCompetition("test", start, start.AddDate(0, 0, 7)); err != nil {
+		t.Fatalf("Failed to create competition: %v", err)
+	}
+	if err := store.AddParticipant("홍길동", "legacy", 6, 0, 0, ""); err != nil {
+		t.Fatalf("Failed to add participant: %v", err)
+	}
+	if err := store.AddParticipant("김철수", "linked", 6, 0, 0, "7"); err != nil {
+		t.Fatalf("Failed to add participant: %v", err)
+	}
+	if err := store.AddParticipant("이영희", "taken", 6, 0, 0, "7"); err == nil {
+		t.Error("Expected second participant for the same discord user to be rejected")
+	}
+
+	handler := &CommandHandler{deps: &CommandDependencies{Storage: store}}
+	message := func(id, username, nick string) *discordgo.MessageCreate {
+		return &discordgo.MessageCreate{Message: &discordgo.Message{
+			Author: &discordgo.User{ID: id, Username: username},
+			Member: &discordgo.Member{Nick: nick},
+		}}
+	}
+
+	// 조회 명령어는 이름이 같은 미연결 참가자를 보여주지만 연결하지는 않습니다
+	impostor := message("9", "someone", "legacy")
+	if handle := handler.resolveAuthorHandle(impostor); handle != "legacy" {
+		t.Fatalf("Expected legacy participant by nickname for read-only lookup, got %q", handle)
+	}
+	if participant, _ := findParticipant(store.GetParticipants(), "legacy"); participant.DiscordUserID != "" {
+		t.Errorf("Expected lookup not to link the participant, got %q", participant.DiscordUserID)
+	}
+
+	// 참가자를 바꾸는 명령어는 디스코드 ID로 연결된 참가자만 인정합니다
+	if handle := handler.linkedAuthorHandle(impostor); handle != "" {
+		t.Errorf("Expected nickname match not to count as a link, got %q", handle)
+	}
+	if handle := handler.linkedAuthorHandle(message("7", "renamed", "")); handle != "linked" {
+		t.Errorf("Expected linked participant by discord ID, got %q", handle)
+	}
+
+	if handle := handler.resolveAuthorHandle(message("10", "linked", "")); handle != "" {
+		t.Errorf("Expected participant linked to another user not to be claimed by name, got %q", handle)
+	}
+	if handle := handler.resolveAuthorHandle(message("7", "renamed", "")); handle != "linked" {
+		t.Errorf("Expected linked participant by discord ID, got %q", handle)
+	}
+	if err := store.LinkDiscordUser("linked", "9"); err != nil {
+		t.Errorf("Expected unlinked discord user to be linkable, got %v", err)
+	}
+	if err := store.LinkDiscordUser("legacy", "9"); err == nil {
+		t.Error("Expected relinking to a user of another participant to be rejected")
+	}
+}
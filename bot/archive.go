@@ -0,0 +1,350 @@
+package bot
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/ssugameworks/kkemi/constants"
+	"github.com/ssugameworks/kkemi/errors"
+	"github.com/ssugameworks/kkemi/interfaces"
+	"github.com/ssugameworks/kkemi/models"
+	"github.com/ssugameworks/kkemi/utils"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+// FinalizeCompetition 대회의 최종 점수를 계산 내역과 함께 보관하고 대회를 비활성화합니다
+// solved.ac 조회에 실패한 참가자가 있으면 allowPartial일 때만 마지막 점수 스냅샷 값으로 확정하고(부분 결과),
+// 아니면 확정하지 않습니다. 어느 경우든 조회에 실패한 참가자의 백준 ID를 함께 반환합니다.
+func (manager *ScoreboardManager) FinalizeCompetition(allowPartial bool) ([]models.ArchivedResult, []string, error) {
+	competition := manager.storage.GetCompetition()
+	if competition == nil || !competition.IsActive {
+		return nil, nil, fmt.Errorf("활성화된 대회가 없습니다")
+	}
+
+	participants := manager.storage.GetParticipants()
+	scores := make([]models.ScoreData, 0, len(participants))
+	breakdowns := make(map[string]*models.ScoreBreakdown, len(participants))
+	partial := make(map[string]bool)
+	var failed []string
+	var latest *models.ScoreSnapshot
+	// 확정은 한 번뿐이므로 병렬 수집 대신 순서대로 계산합니다
+	for _, participant := range participants {
+		score, breakdown, err := manager.scoreParticipant(competition, participant)
+		if err != nil {
+			utils.Warn("Failed to score %s for finalization: %v", participant.BaekjoonID, err)
+			failed = append(failed, participant.BaekjoonID)
+			if !allowPartial {
+				continue
+			}
+			if latest == nil {
+				latest = manager.latestSnapshotOrEmpty()
+			}
+			score, breakdown = manager.fallbackScore(competition, participant, latest), nil
+			partial[score.BaekjoonID] = true
+		}
+		scores = append(scores, score)
+		breakdowns[score.BaekjoonID] = breakdown
+	}
+	if len(failed) > 0 && !allowPartial {
+		return nil, failed, fmt.Errorf("참가자 %d명 점수 계산 실패: %s", len(failed), strings.Join(failed, ", "))
+	}
+	manager.assignTeams(scores)
+
+	results := manager.buildArchivedResults(competition, scores, breakdowns, partial)
+	if err := manager.storage.FinalizeCompetition(results); err != nil {
+		return nil, failed, err
+	}
+	return results, failed, nil
+}
+
+// latestSnapshotOrEmpty 가장 최근 점수 스냅샷을 반환합니다 (없거나 조회에 실패하면 빈 스냅샷)
+func (manager *ScoreboardManager) latestSnapshotOrEmpty() *models.ScoreSnapshot {
+	snapshot, err := manager.storage.GetLatestSnapshot()
+	if err != nil {
+		utils.Warn("Failed to load latest snapshot for finalization fallback: %v", err)
+	}
+	if snapshot == nil {
+		return &models.ScoreSnapshot{}
+	}
+	return snapshot
+}
+
+// fallbackScore solved.ac 조회에 실패한 참가자의 점수를 마지막 점수 스냅샷 값으로 채웁니다 (스냅샷에 없으면 0점)
+func (manager *ScoreboardManager) fallbackScore(competition *models.Competition, participant models.Participant, snapshot *models.ScoreSnapshot) models.ScoreData {
+	calculator := manager.calculator.ForCompetition(competition)
+	league := calculator.GetUserLeague(participant.StartTier)
+	score := models.ScoreData{
+		ParticipantID: participant.ID,
+		Name:          participant.Name,
+		BaekjoonID:    participant.BaekjoonID,
+		League:        league,
+		LeagueName:    calculator.GetLeagueName(league),
+		StartTier:     participant.StartTier,
+		StartRating:   participant.StartRating,
+	}
+	if entry, found := snapshot.Entry(participant.BaekjoonID); found {
+		score.Score = entry.Score
+		score.RawScore = entry.RawScore
+		score.ProblemCount = entry.ProblemCount
+		score.SolvedTargets = entry.SolvedTargets
+	}
+	return score
+}
+
+// buildArchivedResults 점수와 계산 내역에 최종 순위를 붙여 보관할 결과를 만듭니다 (partial: 마지막 기록으로 채운 참가자)
+func (manager *ScoreboardManager) buildArchivedResults(competition *models.Competition, scores []models.ScoreData, breakdowns map[string]*models.ScoreBreakdown, partial map[string]bool) []models.ArchivedResult {
+	ranks := manager.rankScoresFor(competition, scores)
+	results := make([]models.ArchivedResult, 0, len(scores))
+	for _, score := range scores {
+		results = append(results, models.ArchivedResult{
+			Rank:      ranks[score.BaekjoonID],
+			Score:     score,
+			Breakdown: breakdowns[score.BaekjoonID],
+			Partial:   partial[score.BaekjoonID],
+		})
+	}
+	models.SortArchivedResults(results)
+	return results
+}
+
+// problemSetRanks 문제 목록 대회의 전체 순위를 계산합니다 (동점자는 같은 순위, key: BaekjoonID)
+func problemSetRanks(scores []models.ScoreData) map[string]int {
+	ranks := make(map[string]int, len(scores))
+	var lastRawScore float64 = -1.0
+	var rank int
+	for i, score := range sortProblemSetScores(scores) {
+		if score.RawScore != lastRawScore {
+			rank = i + 1
+		}
+		ranks[score.BaekjoonID] = rank
+		lastRawScore = score.RawScore
+	}
+	return ranks
+}
+
+// FormatArchivedScoreboard 보관된 결과로 최종 스코어보드를 만듭니다 (solved.ac를 호출하지 않음)
+func (manager *ScoreboardManager) FormatArchivedScoreboard(competition *models.Competition, results []models.ArchivedResult) *discordgo.MessageEmbed {
+	embed := manager.formatScoreboard(competition, models.ArchivedScores(results), nil)
+	embed.Title = fmt.Sprintf(constants.MsgArchiveResultsTitle, competition.Name)
+	footer := fmt.Sprintf(constants.MsgArchiveResultsFooter, utils.FormatDate(competition.FinalizedAt), len(results), competition.ID)
+	if partial := models.CountPartialResults(results); partial > 0 {
+		footer += fmt.Sprintf(constants.MsgArchivePartialFooter, partial)
+	}
+	embed.Footer = &discordgo.MessageEmbedFooter{Text: footer}
+	return embed
+}
+
+// handleCompetitionFinalize 대회 결과를 확정하여 보관합니다 (종료 전에는 `force`가 필요)
+func (ch *CompetitionHandler) handleCompetitionFinalize(s *discordgo.Session, m *discordgo.MessageCreate, params []string) {
+	errorHandlers := utils.NewErrorHandlerFactory(s, m.ChannelID)
+
+	competition := ch.commandHandler.deps.Storage.GetCompetition()
+	if competition == nil || !competition.IsActive {
+		errorHandlers.Data().HandleNoActiveCompetition()
+		return
+	}
+
+	force := len(params) == 1 && params[0] == "force"
+	if !force && utils.GetCurrentTimeKST().Before(competition.ScoringWindowEnd()) {
+		errorHandlers.Validation().HandleInvalidParams("COMPETITION_NOT_ENDED",
+			fmt.Sprintf("Competition has not ended: %s", competition.Name),
+			constants.MsgCompetitionFinalizeNotEnded)
+		return
+	}
+
+	errors.SendDiscordInfo(s, m.ChannelID, fmt.Sprintf(constants.MsgCompetitionFinalizeStarted, competition.Name))
+
+	// 관리자가 직접 확정할 때는 조회에 실패한 참가자를 마지막 기록으로 채워 바로 확정합니다
+	results, failed, err := ch.commandHandler.deps.ScoreboardManager.FinalizeCompetition(true)
+	if err != nil {
+		errorHandlers.System().HandleSystemError("COMPETITION_FINALIZE_FAILED",
+			"Failed to finalize competition",
+			"대회 결과 확정 중 오류가 발생했습니다. 잠시 후 다시 시도해주세요.", err)
+		return
+	}
+
+	ch.commandHandler.recordAudit(s, m, models.AuditEntry{
+		CompetitionID: competition.ID,
+		Action:        models.AuditActionCompetitionFinalize,
+		Target:        competition.Name,
+		After:         formatFinalizeAudit(results, failed),
+	})
+
+	ch.commandHandler.deps.UpdateBotStatus()
+	if ch.commandHandler.deps.MetricsClient != nil {
+		ch.commandHandler.deps.MetricsClient.SendCompetitionMetric("finalized", len(results))
+	}
+
+	errors.SendDiscordSuccess(s, m.ChannelID, fmt.Sprintf(constants.MsgCompetitionFinalizeSuccess,
+		competition.Name, len(results), competition.ID))
+	if len(failed) > 0 {
+		errors.SendDiscordInfo(s, m.ChannelID, fmt.Sprintf(constants.MsgCompetitionFinalizePartial,
+			len(failed), joinRosterNames(failed, constants.MaxFinalizeFailedNames)))
+	}
+}
+
+// RecordAutoFinalize 자동 확정 결과를 감사 로그에 남기고, 마지막 기록으로 확정한 참가자가 있으면 감사 로그 채널에 알립니다
+func RecordAutoFinalize(session *discordgo.Session, storage interfaces.StorageRepository, auditChannelID string, competition models.Competition, results []models.ArchivedResult, failed []string) {
+	entry := models.AuditEntry{
+		CompetitionID: competition.ID,
+		ActorName:     constants.SystemComponentName,
+		Action:        models.AuditActionCompetitionFinalize,
+		Target:        competition.Name,
+		After:         truncateAuditValue(formatFinalizeAudit(results, failed)),
+		Timestamp:     utils.GetCurrentTimeKST(),
+	}
+	if err := storage.AppendAuditEntry(entry); err != nil {
+		utils.Error("Failed to record auto finalize for %s: %v", competition.Name, err)
+	}
+
+	if len(failed) == 0 {
+		return
+	}
+	sendFinalizeAlert(session, auditChannelID, fmt.Sprintf(constants.MsgFinalizePartialTitle, competition.Name),
+		fmt.Sprintf(constants.MsgCompetitionFinalizePartial, len(failed), joinRosterNames(failed, constants.MaxFinalizeFailedNames)))
+}
+
+// ReportFinalizeRetry 자동 확정이 실패해 다시 시도할 예정임을 감사 로그 채널에 알립니다
+func ReportFinalizeRetry(session *discordgo.Session, auditChannelID string, competition models.Competition, attempt int, retryIn time.Duration, failed []string, err error) {
+	reason := err.Error()
+	if len(failed) > 0 {
+		reason = fmt.Sprintf(constants.MsgFinalizeFailedParticipants, len(failed), joinRosterNames(failed, constants.MaxFinalizeFailedNames))
+	}
+	sendFinalizeAlert(session, auditChannelID, fmt.Sprintf(constants.MsgFinalizeRetryTitle, competition.Name),
+		fmt.Sprintf(constants.MsgFinalizeRetry, attempt, constants.MaxAutoFinalizeAttempts, int(retryIn.Minutes()), reason))
+}
+
+// sendFinalizeAlert 자동 확정 관련 알림을 감사 로그 채널로 보냅니다 (채널이 없으면 로그만 남김)
+func sendFinalizeAlert(session *discordgo.Session, auditChannelID, title, description string) {
+	utils.Warn("%s: %s", title, description)
+	if auditChannelID == "" || session == nil {
+		return
+	}
+	embed := &discordgo.MessageEmbed{
+		Title:       title,
+		Description: description,
+		Color:       constants.ColorTierGold,
+	}
+	if _, err := session.ChannelMessageSendEmbed(auditChannelID, embed); err != nil {
+		utils.Warn("Failed to send finalize alert to channel %s: %v", auditChannelID, err)
+	}
+}
+
+// formatFinalizeAudit 확정한 결과 수와 마지막 기록으로 채운 참가자를 감사 로그 값으로 만듭니다
+func formatFinalizeAudit(results []models.ArchivedResult, failed []string) string {
+	if len(failed) == 0 {
+		return fmt.Sprintf(constants.MsgAuditFinalize, len(results))
+	}
+	return fmt.Sprintf(constants.MsgAuditFinalizePartial, len(results), len(failed), strings.Join(failed, ", "))
+}
+
+// handleCompetitionHistory 확정된 지난 대회 목록을 보여줍니다
+func (ch *CompetitionHandler) handleCompetitionHistory(s *discordgo.Session, m *discordgo.MessageCreate) {
+	competitions := ch.commandHandler.deps.Storage.GetArchivedCompetitions()
+	if len(competitions) == 0 {
+		errors.SendDiscordInfo(s, m.ChannelID, constants.MsgArchiveEmpty)
+		return
+	}
+
+	var builder strings.Builder
+	for i, competition := range competitions {
+		if i >= constants.MaxArchiveListSize {
+			builder.WriteString(fmt.Sprintf(constants.MsgArchiveListMore, len(competitions)-i))
+			break
+		}
+		builder.WriteString(fmt.Sprintf(constants.MsgArchiveListEntry, competition.Name, competition.ID,
+			utils.FormatDate(competition.StartDate), utils.FormatDate(competition.EndDate),
+			competition.ParticipantCount, utils.FormatDate(competition.FinalizedAt)))
+	}
+
+	embed := &discordgo.MessageEmbed{
+		Title:       fmt.Sprintf(constants.MsgArchiveListTitle, len(competitions)),
+		Description: builder.String(),
+		Color:       constants.ColorTierGold,
+		Footer:      &discordgo.MessageEmbedFooter{Text: constants.MsgArchiveListFooter},
+	}
+	if _, err := s.ChannelMessageSendEmbed(m.ChannelID, embed); err != nil {
+		utils.Error("DISCORD API ERROR: Failed to send competition history: %v", err)
+	}
+}
+
+// handleCompetitionResults 지난 대회의 최종 스코어보드(`results <ID>`) 또는 참가자 점수 내역(`results <ID> <백준ID> [페이지]`)을 보여줍니다
+func (ch *CompetitionHandler) handleCompetitionResults(s *discordgo.Session, m *discordgo.MessageCreate, params []string) {
+	errorHandlers := utils.NewErrorHandlerFactory(s, m.ChannelID)
+
+	if len(params) == 0 {
+		errorHandlers.Validation().HandleInvalidParams("ARCHIVE_INVALID_PARAMS",
+			"Invalid archive results parameters",
+			constants.MsgArchiveResultsUsage)
+		return
+	}
+
+	competition, found := findCompetition(ch.commandHandler.deps.Storage.GetArchivedCompetitions(), params[0])
+	if !found {
+		errorHandlers.Validation().HandleInvalidParams("ARCHIVE_NOT_FOUND",
+			fmt.Sprintf("Archived competition not found: %s", params[0]),
+			constants.MsgArchiveNotFound)
+		return
+	}
+
+	handle, page, ok := parseScoreParams(params[1:])
+	if !ok {
+		errorHandlers.Validation().HandleInvalidParams("ARCHIVE_INVALID_PARAMS",
+			"Invalid archive results parameters",
+			constants.MsgArchiveResultsUsage)
+		return
+	}
+
+	storage := ch.commandHandler.deps.Storage.ForCompetition(competition.ID)
+	results, err := storage.GetArchivedResults()
+	if err != nil {
+		errorHandlers.System().HandleSystemError("ARCHIVE_LOAD_FAILED",
+			"Failed to load archived results",
+			"지난 대회 결과를 불러오는 중 오류가 발생했습니다.", err)
+		return
+	}
+
+	var embed *discordgo.MessageEmbed
+	if handle == "" {
+		manager := ch.commandHandler.deps.ScoreboardManager.ForStorage(storage)
+		embed = manager.FormatArchivedScoreboard(&competition, results)
+	} else {
+		result, found := models.FindArchivedResult(results, handle)
+		if found && result.Partial {
+			errors.SendDiscordInfo(s, m.ChannelID, fmt.Sprintf(constants.MsgArchivePartialBreakdown, result.Score.BaekjoonID))
+			return
+		}
+		if !found || result.Breakdown == nil {
+			errorHandlers.Validation().HandleInvalidParams("ARCHIVE_PARTICIPANT_NOT_FOUND",
+				fmt.Sprintf("Archived result not found: %s", handle),
+				fmt.Sprintf(constants.MsgArchiveParticipantNotFound, handle))
+			return
+		}
+		embed = ch.formatArchivedBreakdown(&competition, result, page)
+	}
+
+	if err := SendScoreboardEmbed(s, m.ChannelID, embed, nil); err != nil {
+		utils.Error("DISCORD API ERROR: Failed to send archived results: %v", err)
+	}
+}
+
+// formatArchivedBreakdown 보관된 점수 내역을 최종 순위와 함께 표시합니다
+func (ch *CompetitionHandler) formatArchivedBreakdown(competition *models.Competition, result models.ArchivedResult, page int) *discordgo.MessageEmbed {
+	embed := ch.commandHandler.formatScoreBreakdown(result.Breakdown, page)
+	embed.Title = fmt.Sprintf(constants.MsgArchiveResultsTitle, competition.Name) + " · " + embed.Title
+	rankScope := result.Score.LeagueName
+	if competition.IsProblemSet() {
+		rankScope = constants.MsgArchiveOverallRank
+	}
+	embed.Description = fmt.Sprintf(constants.MsgArchiveRank, rankScope, result.Rank) + embed.Description
+
+	_, page, totalPages := paginateBreakdown(result.Breakdown.Entries, page, constants.BreakdownPageSize)
+	footer := fmt.Sprintf(constants.MsgArchivePageFooterLast, page, totalPages)
+	if page < totalPages {
+		footer = fmt.Sprintf(constants.MsgArchivePageFooter, page, totalPages, competition.ID, result.Score.BaekjoonID, page+1)
+	}
+	embed.Footer = &discordgo.MessageEmbedFooter{Text: footer}
+	return embed
+}
@@ -0,0 +1,107 @@
+package bot
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/ssugameworks/kkemi/api"
+	"github.com/ssugameworks/kkemi/models"
+	"github.com/ssugameworks/kkemi/scoring"
+	"github.com/ssugameworks/kkemi/storage"
+)
+
+func TestBuildArchivedResults(t *testing.T) {
+	manager := &ScoreboardManager{}
+	scores := []models.ScoreData{
+		{BaekjoonID: "a1", League: 1, RawScore: 10},
+		{BaekjoonID: "a2", League: 1, RawScore: 30},
+		{BaekjoonID: "a3", League: 1, RawScore: 30},
+		{BaekjoonID: "b1", League: 2, RawScore: 5},
+	}
+	breakdowns := map[string]*models.ScoreBreakdown{"a2": {BaekjoonID: "a2", Total: 30}}
+
+	results := manager.buildArchivedResults(&models.Competition{}, scores, breakdowns, map[string]bool{"b1": true})
+
+	expected := []struct {
+		id   string
+		rank int
+	}{{"a2", 1}, {"a3", 1}, {"a1", 3}, {"b1", 1}}
+	for i, want := range expected {
+		if results[i].Score.BaekjoonID != want.id || results[i].Rank != want.rank {
+			t.Errorf("Expected %s at rank %d, got %s at rank %d", want.id, want.rank, results[i].Score.BaekjoonID, results[i].Rank)
+		}
+	}
+	if results[0].Breakdown == nil || results[0].Breakdown.Total != 30 {
+		t.Errorf("Expected breakdown to be archived with the result, got %+v", results[0].Breakdown)
+	}
+	if results[0].Partial || !results[3].Partial {
+		t.Errorf("Expected only b1 to be marked partial, got %+v", results)
+	}
+}
+
+func TestFinalizeCompetitionFallsBackToLastSnapshot(t *testing.T) {
+	client := &MockSolvedACClient{userInfo: &api.UserInfo{Handle: "player", Tier: 6}}
+	store := storage.NewInMemoryStorage(client)
+	start := time.Now().AddDate(0, 0, -1)
+	if _, err := store.CreateCompetition("test", start, start.AddDate(0, 0, 7)); err != nil {
+		t.Fatalf("Failed to create competition: %v", err)
+	}
+	for _, handle := range []string{"player", "other"} {
+		if err := store.AddParticipant(handle, handle, 6, 0, 0, ""); err != nil {
+			t.Fatalf("Failed to add participant: %v", err)
+		}
+	}
+	snapshot := models.ScoreSnapshot{TakenAt: time.Now(), Entries: []models.SnapshotEntry{
+		{BaekjoonID: "player", Score: 42, RawScore: 41.6, ProblemCount: 3},
+	}}
+	if err := store.SaveSnapshot(snapshot); err != nil {
+		t.Fatalf("Failed to save snapshot: %v", err)
+	}
+
+	client.userInfoErr = fmt.Errorf("solved.ac unavailable")
+	tierManager := models.GetTierManager()
+	manager := NewScoreboardManager(store, scoring.NewScoreCalculator(client, tierManager), client, tierManager)
+
+	// 부분 확정을 허용하지 않으면 확정하지 않고 실패한 참가자만 알려줍니다
+	_, failed, err := manager.FinalizeCompetition(false)
+	if err == nil || len(failed) != 2 {
+		t.Fatalf("Expected finalize to fail for 2 participants, got %v (%v)", failed, err)
+	}
+	if store.GetCompetition().IsFinalized() {
+		t.Fatal("Competition must not be finalized without allowPartial")
+	}
+
+	results, failed, err := manager.FinalizeCompetition(true)
+	if err != nil {
+		t.Fatalf("Expected partial finalize to succeed, got %v", err)
+	}
+	if len(failed) != 2 || len(results) != 2 {
+		t.Fatalf("Expected 2 partial results, got %d results and failed %v", len(results), failed)
+	}
+	player, found := models.FindArchivedResult(results, "player")
+	if !found || !player.Partial || player.Score.Score != 42 || player.Score.ProblemCount != 3 || player.Breakdown != nil {
+		t.Errorf("Expected player to fall back to the last snapshot, got %+v", player)
+	}
+	other, found := models.FindArchivedResult(results, "other")
+	if !found || !other.Partial || other.Score.Score != 0 {
+		t.Errorf("Expected participant missing from the snapshot to be finalized with 0, got %+v", other)
+	}
+	if archived := store.GetArchivedCompetitions(); len(archived) != 1 {
+		t.Errorf("Expected competition to be archived, got %d archived competitions", len(archived))
+	}
+}
+
+func TestProblemSetRanks(t *testing.T) {
+	scores := []models.ScoreData{
+		{BaekjoonID: "low", League: 1, RawScore: 1},
+		{BaekjoonID: "high", League: 3, RawScore: 5},
+		{BaekjoonID: "tied", League: 2, RawScore: 5},
+	}
+
+	ranks := problemSetRanks(scores)
+
+	if ranks["high"] != 1 || ranks["tied"] != 1 || ranks["low"] != 3 {
+		t.Errorf("Expected overall ranks across leagues, got %v", ranks)
+	}
+}
@@ -0,0 +1,154 @@
+package bot
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ssugameworks/kkemi/constants"
+	"github.com/ssugameworks/kkemi/errors"
+	"github.com/ssugameworks/kkemi/models"
+	"github.com/ssugameworks/kkemi/utils"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+// recordAudit 관리 작업을 감사 로그에 남기고, 로그 채널이 설정되어 있으면 함께 보냅니다 (기록 실패는 작업을 되돌리지 않음)
+func (handler *CommandHandler) recordAudit(session *discordgo.Session, message *discordgo.MessageCreate, entry models.AuditEntry) {
+	entry.ActorID = message.Author.ID
+	entry.ActorName = message.Author.Username
+	entry.Timestamp = utils.GetCurrentTimeKST()
+	entry.Before = truncateAuditValue(entry.Before)
+	entry.After = truncateAuditValue(entry.After)
+
+	if err := handler.deps.Storage.AppendAuditEntry(entry); err != nil {
+		utils.Error("Failed to record audit entry %s by %s: %v", entry.Action, entry.ActorName, err)
+	}
+	utils.Info("AUDIT: %s %s %s (%s)", entry.ActorName, entry.Action, entry.Target, formatAuditChange(entry))
+
+	if handler.deps.AuditChannelID == "" || session == nil {
+		return
+	}
+	embed := &discordgo.MessageEmbed{
+		Title:       fmt.Sprintf(constants.MsgAuditMirrorTitle, entry.Action),
+		Description: formatAuditEntry(entry),
+		Color:       constants.ColorTierGold,
+	}
+	if _, err := session.ChannelMessageSendEmbed(handler.deps.AuditChannelID, embed); err != nil {
+		utils.Warn("Failed to mirror audit entry to channel %s: %v", handler.deps.AuditChannelID, err)
+	}
+}
+
+// handleAuditLog 최근 관리 작업 기록을 최신순으로 보여줍니다 (`!감사로그 [개수]`)
+func (handler *CommandHandler) handleAuditLog(session *discordgo.Session, message *discordgo.MessageCreate, params []string) {
+	errorHandlers := utils.NewErrorHandlerFactory(session, message.ChannelID)
+
+	limit, ok := parseAuditLimit(params)
+	if !ok {
+		errorHandlers.Validation().HandleInvalidParams("AUDIT_INVALID_PARAMS",
+			"Invalid audit log parameters",
+			fmt.Sprintf(constants.MsgAuditUsage, constants.MaxAuditLogSize))
+		return
+	}
+
+	entries, err := handler.deps.Storage.GetAuditEntries(limit)
+	if err != nil {
+		errorHandlers.System().HandleSystemError("AUDIT_LOAD_FAILED",
+			"Failed to load audit entries",
+			"감사 로그를 불러오는 중 오류가 발생했습니다.", err)
+		return
+	}
+	if len(entries) == 0 {
+		errors.SendDiscordInfo(session, message.ChannelID, constants.MsgAuditEmpty)
+		return
+	}
+
+	lines := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		lines = append(lines, formatAuditEntry(entry))
+	}
+
+	embed := &discordgo.MessageEmbed{
+		Title:       fmt.Sprintf(constants.MsgAuditTitle, len(entries)),
+		Description: strings.Join(lines, "\n\n"),
+		Color:       constants.ColorTierGold,
+	}
+	if err := SendScoreboardEmbed(session, message.ChannelID, embed, nil); err != nil {
+		utils.Error("DISCORD API ERROR: Failed to send audit log: %v", err)
+	}
+}
+
+// parseAuditLimit 조회할 기록 수를 해석합니다 (생략하면 기본값)
+func parseAuditLimit(params []string) (int, bool) {
+	if len(params) == 0 {
+		return constants.DefaultAuditLogSize, true
+	}
+	limit, err := strconv.Atoi(params[0])
+	if len(params) != 1 || err != nil || limit < 1 || limit > constants.MaxAuditLogSize {
+		return 0, false
+	}
+	return limit, true
+}
+
+// formatAuditEntry 감사 로그 한 건을 시각, 작업자, 작업, 대상, 변경 내용으로 표시합니다
+func formatAuditEntry(entry models.AuditEntry) string {
+	kst := time.FixedZone("KST", constants.KSTOffsetSeconds)
+	line := fmt.Sprintf(constants.MsgAuditEntry,
+		utils.FormatDateTime(entry.Timestamp.In(kst)), entry.ActorName, entry.Action, entry.Target)
+	if change := formatAuditChange(entry); change != "" {
+		line += "\n└ " + change
+	}
+	return line
+}
+
+// formatAuditChange 변경 전/후 값을 한 줄로 표시합니다 (값이 없으면 빈 문자열)
+func formatAuditChange(entry models.AuditEntry) string {
+	switch {
+	case entry.Before == "" && entry.After == "":
+		return ""
+	case entry.Before == "":
+		return entry.After
+	case entry.After == "":
+		return entry.Before + " → " + constants.MsgAuditNoValue
+	default:
+		return entry.Before + " → " + entry.After
+	}
+}
+
+// truncateAuditValue 긴 값(규칙 JSON, 문제 목록 등)을 글자 단위로 잘라 기록합니다
+func truncateAuditValue(value string) string {
+	runes := []rune(value)
+	if len(runes) <= constants.MaxAuditValueLength {
+		return value
+	}
+	return string(runes[:constants.MaxAuditValueLength-len([]rune(constants.TruncateIndicator))]) + constants.TruncateIndicator
+}
+
+// auditVisibility 스코어보드 공개 여부를 감사 로그용 문자열로 바꿉니다
+func auditVisibility(visible bool) string {
+	if visible {
+		return constants.StatusVisible
+	}
+	return constants.StatusHidden
+}
+
+// auditProblems 문제 목록을 감사 로그용 문자열로 바꿉니다 (없으면 자유 해결)
+func auditProblems(problems []models.TargetProblem) string {
+	if len(problems) == 0 {
+		return constants.MsgAuditFreeSolve
+	}
+	labels := make([]string, 0, len(problems))
+	for _, problem := range problems {
+		labels = append(labels, fmt.Sprintf("%d:%g", problem.ProblemID, problem.Points))
+	}
+	return strings.Join(labels, " ")
+}
+
+// auditRules 점수 규칙을 감사 로그용 문자열로 바꿉니다 (대회 전용 규칙이 없으면 기본 규칙)
+func auditRules(rules *models.ScoringRules) string {
+	if rules == nil {
+		return constants.MsgAuditDefaultRules
+	}
+	return fmt.Sprintf(constants.MsgAuditRules, rules.Name, models.ScoringModeLabel(rules.EffectiveMode()), len(rules.Leagues))
+}
@@ -0,0 +1,82 @@
+package bot
+
+import (
+	"strings"
+	"testing"
+	"unicode/utf8"
+
+	"github.com/ssugameworks/kkemi/constants"
+	"github.com/ssugameworks/kkemi/models"
+	"github.com/ssugameworks/kkemi/storage"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+func TestRecordAudit(t *testing.T) {
+	store := storage.NewInMemoryStorage(&MockSolvedACClient{})
+	handler := &CommandHandler{deps: &CommandDependencies{Storage: store}}
+	message := &discordgo.MessageCreate{Message: &discordgo.Message{
+		Author: &discordgo.User{ID: "42", Username: "admin"},
+	}}
+
+	handler.recordAudit(nil, message, models.AuditEntry{Action: models.AuditActionParticipantRemove, Target: "alice", Before: "앨리스 (alice)"})
+	handler.recordAudit(nil, message, models.AuditEntry{Action: models.AuditActionCompetitionName, Target: "old", Before: "old", After: "new"})
+
+	entries, err := store.GetAuditEntries(10)
+	if err != nil || len(entries) != 2 {
+		t.Fatalf("Expected 2 entries, got %d (%v)", len(entries), err)
+	}
+	if entries[0].Action != models.AuditActionCompetitionName {
+		t.Errorf("Expected newest entry first, got %s", entries[0].Action)
+	}
+	if entries[1].ActorID != "42" || entries[1].ActorName != "admin" || entries[1].Timestamp.IsZero() {
+		t.Errorf("Expected actor and timestamp to be filled, got %+v", entries[1])
+	}
+
+	if limited, _ := store.GetAuditEntries(1); len(limited) != 1 {
+		t.Errorf("Expected limit to be applied, got %d entries", len(limited))
+	}
+}
+
+func TestFormatAuditChange(t *testing.T) {
+	tests := []struct {
+		entry    models.AuditEntry
+		expected string
+	}{
+		{models.AuditEntry{}, ""},
+		{models.AuditEntry{After: "on"}, "on"},
+		{models.AuditEntry{Before: "alice"}, "alice → " + constants.MsgAuditNoValue},
+		{models.AuditEntry{Before: "a", After: "b"}, "a → b"},
+	}
+
+	for _, test := range tests {
+		if got := formatAuditChange(test.entry); got != test.expected {
+			t.Errorf("Expected %q, got %q", test.expected, got)
+		}
+	}
+}
+
+func TestParseAuditLimit(t *testing.T) {
+	if limit, ok := parseAuditLimit(nil); !ok || limit != constants.DefaultAuditLogSize {
+		t.Errorf("Expected default limit, got %d %t", limit, ok)
+	}
+	if limit, ok := parseAuditLimit([]string{"5"}); !ok || limit != 5 {
+		t.Errorf("Expected 5, got %d %t", limit, ok)
+	}
+	for _, params := range [][]string{{"0"}, {"abc"}, {"1000"}, {"1", "2"}} {
+		if _, ok := parseAuditLimit(params); ok {
+			t.Errorf("Expected %v to be rejected", params)
+		}
+	}
+}
+
+func TestTruncateAuditValue(t *testing.T) {
+	value := strings.Repeat("가", constants.MaxAuditValueLength+10)
+	truncated := truncateAuditValue(value)
+	if utf8.RuneCountInString(truncated) != constants.MaxAuditValueLength || !utf8.ValidString(truncated) {
+		t.Errorf("Expected %d valid runes, got %d", constants.MaxAuditValueLength, utf8.RuneCountInString(truncated))
+	}
+	if !strings.HasSuffix(truncated, constants.TruncateIndicator) {
+		t.Errorf("Expected truncate indicator, got %q", truncated[len(truncated)-10:])
+	}
+}
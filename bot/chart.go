@@ -0,0 +1,219 @@
+package bot
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/ssugameworks/kkemi/charts"
+	"github.com/ssugameworks/kkemi/constants"
+	"github.com/ssugameworks/kkemi/errors"
+	"github.com/ssugameworks/kkemi/models"
+	"github.com/ssugameworks/kkemi/utils"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+// scoreboardChart 저장된 스냅샷(current가 있으면 지금 시점 추가)으로 리그별 점수 추이 차트를 그립니다
+// 시점이 부족하거나 그리지 못하면 nil을 반환하며, 이때 스코어보드는 차트 없이 전송됩니다
+func (manager *ScoreboardManager) scoreboardChart(current []models.ScoreData) []byte {
+	competition := manager.storage.GetCompetition()
+	if competition == nil {
+		return nil
+	}
+
+	snapshots, err := manager.storage.GetSnapshots()
+	if err != nil {
+		utils.Warn("Failed to load score snapshots for chart: %v", err)
+		return nil
+	}
+	if current != nil {
+		snapshots = append(snapshots, models.NewScoreSnapshot(current, manager.rankScoresFor(competition, current), utils.GetCurrentTimeKST()))
+	}
+	if len(snapshots) < constants.MinChartPoints {
+		return nil
+	}
+
+	panels, _ := leagueChartPanels(competition, snapshots)
+	chart, err := charts.Render(panels)
+	if err != nil {
+		utils.Warn("Failed to render scoreboard chart: %v", err)
+		return nil
+	}
+	return chart
+}
+
+// leagueChartPanels 리그마다(문제 목록 대회는 전체 하나) 마지막 스냅샷 기준 상위 참가자의 점수 선을 담은 칸을 만듭니다
+// 이미지에는 한글을 그릴 수 없으므로 칸 제목은 `#번호`이고, 번호별 리그 이름을 함께 반환합니다
+func leagueChartPanels(competition *models.Competition, snapshots []models.ScoreSnapshot) ([]charts.Panel, []string) {
+	if len(snapshots) == 0 {
+		return nil, nil
+	}
+
+	// 마지막 스냅샷의 항목은 리그·순위 순으로 정렬되어 있습니다
+	latest := snapshots[len(snapshots)-1]
+	groups := make(map[int][]models.SnapshotEntry)
+	names := make(map[int]string)
+	for _, entry := range latest.Entries {
+		group := entry.League
+		if competition.IsProblemSet() {
+			group = 0
+		}
+		groups[group] = append(groups[group], entry)
+		names[group] = entry.LeagueName
+	}
+
+	keys := make([]int, 0, len(groups))
+	for key := range groups {
+		keys = append(keys, key)
+	}
+	sort.Ints(keys)
+
+	panels := make([]charts.Panel, 0, len(keys))
+	legends := make([]string, 0, len(keys))
+	for i, key := range keys {
+		entries := groups[key]
+		if competition.IsProblemSet() {
+			sort.SliceStable(entries, func(a, b int) bool { return entries[a].Rank < entries[b].Rank })
+		}
+		if len(entries) > constants.MaxChartSeries {
+			entries = entries[:constants.MaxChartSeries]
+		}
+
+		series := make([]charts.Series, 0, len(entries))
+		for _, entry := range entries {
+			points := models.ScoreTrend(snapshots, entry.BaekjoonID)
+			series = append(series, charts.Series{Label: entry.BaekjoonID, Points: scorePoints(points)})
+		}
+		panels = append(panels, charts.Panel{Title: fmt.Sprintf("#%d", i+1), Kind: charts.PanelLine, Series: series})
+
+		name := names[key]
+		if competition.IsProblemSet() {
+			name = constants.MsgArchiveOverallRank
+		}
+		legends = append(legends, fmt.Sprintf(constants.MsgChartLeagueLegend, i+1, name))
+	}
+	return panels, legends
+}
+
+// participantChartPanels 참가자의 KST 날짜별 누적 점수 선과 하루에 푼 문제 수 막대 칸을 만듭니다
+func participantChartPanels(baekjoonID string, points []models.TrendPoint) []charts.Panel {
+	daily := models.DailyTrend(points)
+	location := utils.GetCurrentTimeKST().Location()
+
+	scores := make([]charts.Point, 0, len(daily))
+	solved := make([]charts.Point, 0, len(daily))
+	for i, point := range daily {
+		day := point.TakenAt.In(location)
+		scores = append(scores, charts.Point{Time: day, Value: point.Score})
+
+		// 첫 기록은 그날까지 푼 문제 수를 그대로 사용
+		count := point.ProblemCount
+		if i > 0 {
+			count -= daily[i-1].ProblemCount
+		}
+		if count < 0 {
+			count = 0
+		}
+		solved = append(solved, charts.Point{Time: day, Value: float64(count)})
+	}
+
+	return []charts.Panel{
+		{Title: constants.ChartScoreTitle, Kind: charts.PanelLine, Series: []charts.Series{{Label: baekjoonID, Points: scores}}},
+		{Title: constants.ChartSolvedTitle, Kind: charts.PanelBar, Series: []charts.Series{{Label: baekjoonID, Points: solved}}},
+	}
+}
+
+// scorePoints 점수 추이를 KST 시각의 차트 점으로 바꿉니다
+func scorePoints(points []models.TrendPoint) []charts.Point {
+	location := utils.GetCurrentTimeKST().Location()
+	chartPoints := make([]charts.Point, 0, len(points))
+	for _, point := range points {
+		chartPoints = append(chartPoints, charts.Point{Time: point.TakenAt.In(location), Value: point.Score})
+	}
+	return chartPoints
+}
+
+// visibleSnapshots 블랙아웃으로 점수가 숨겨질 때 블랙아웃 시작 이후의 스냅샷을 잘라냅니다
+func visibleSnapshots(snapshots []models.ScoreSnapshot, competition *models.Competition, hidden bool) []models.ScoreSnapshot {
+	if !hidden {
+		return snapshots
+	}
+	if _, index, ok := models.SnapshotBefore(snapshots, competition.BlackoutStartDate); ok {
+		return snapshots[:index+1]
+	}
+	return nil
+}
+
+// handleChart 리그별 상위 참가자 점수 추이(`!그래프`) 또는 참가자 한 명의 누적 점수·일별 해결 수(`!그래프 <백준ID>`) 차트를 보여줍니다
+func (handler *CommandHandler) handleChart(session *discordgo.Session, message *discordgo.MessageCreate, params []string) {
+	errorHandlers := utils.NewErrorHandlerFactory(session, message.ChannelID)
+
+	competition := handler.deps.Storage.GetCompetition()
+	if competition == nil || !competition.IsActive {
+		errorHandlers.Data().HandleNoActiveCompetition()
+		return
+	}
+
+	if len(params) > 1 || (len(params) == 1 && !utils.IsValidBaekjoonID(params[0])) {
+		errorHandlers.Validation().HandleInvalidParams("CHART_INVALID_PARAMS",
+			"Invalid chart parameters",
+			constants.MsgChartUsage)
+		return
+	}
+
+	var participant models.Participant
+	if len(params) == 1 {
+		var found bool
+		if participant, found = findParticipant(handler.deps.Storage.GetParticipants(), params[0]); !found {
+			errorHandlers.Validation().HandleInvalidParams("CHART_PARTICIPANT_NOT_FOUND",
+				fmt.Sprintf("Participant not found: %s", params[0]),
+				constants.MsgChartUsage)
+			return
+		}
+	}
+
+	snapshots, err := handler.deps.Storage.GetSnapshots()
+	if err != nil {
+		errorHandlers.System().HandleSystemError("CHART_LOAD_FAILED",
+			"Failed to load score snapshots",
+			"점수 기록을 불러오는 중 오류가 발생했습니다.", err)
+		return
+	}
+
+	// 블랙아웃 중에는 블랙아웃 시작 이후 기록을 숨깁니다
+	hidden := handler.deps.ScoreboardManager.IsScoreHidden(handler.hasCapability(session, message, models.CapabilityViewHiddenScoreboard))
+	snapshots = visibleSnapshots(snapshots, competition, hidden)
+
+	embed := &discordgo.MessageEmbed{Color: constants.ColorTierGold}
+	var panels []charts.Panel
+	if participant.BaekjoonID == "" {
+		var legends []string
+		panels, legends = leagueChartPanels(competition, snapshots)
+		embed.Title = fmt.Sprintf(constants.MsgChartLeagueTitle, competition.Name)
+		embed.Description = fmt.Sprintf(constants.MsgChartLeagueDescription, constants.MaxChartSeries) + "\n" + strings.Join(legends, "\n")
+	} else {
+		panels = participantChartPanels(participant.BaekjoonID, models.ScoreTrend(snapshots, participant.BaekjoonID))
+		embed.Title = fmt.Sprintf(constants.MsgChartParticipantTitle, participant.BaekjoonID)
+		embed.Description = constants.MsgChartParticipantDescription
+	}
+	if hidden {
+		embed.Footer = &discordgo.MessageEmbedFooter{Text: constants.MsgTrendBlackoutFooter}
+	}
+
+	chart, err := charts.Render(panels)
+	if err == charts.ErrNoData {
+		errors.SendDiscordInfo(session, message.ChannelID, constants.MsgChartEmpty)
+		return
+	}
+	if err != nil {
+		errorHandlers.System().HandleSystemError("CHART_RENDER_FAILED",
+			"Failed to render chart",
+			"차트를 그리는 중 오류가 발생했습니다.", err)
+		return
+	}
+
+	if err := SendScoreboardEmbed(session, message.ChannelID, embed, chart); err != nil {
+		utils.Error("DISCORD API ERROR: Failed to send chart: %v", err)
+	}
+}
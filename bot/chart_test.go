@@ -0,0 +1,80 @@
+package bot
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ssugameworks/kkemi/charts"
+	"github.com/ssugameworks/kkemi/models"
+)
+
+func TestLeagueChartPanels(t *testing.T) {
+	competition := &models.Competition{Name: "테스트"}
+	day := func(d int) time.Time { return time.Date(2025, 3, d, 12, 0, 0, 0, time.UTC) }
+
+	var entries []models.SnapshotEntry
+	for i := 0; i < 12; i++ {
+		entries = append(entries, models.SnapshotEntry{BaekjoonID: string(rune('a'+i)) + "user", League: 1, LeagueName: "프로", Rank: i + 1, Score: float64(100 - i)})
+	}
+	entries = append(entries, models.SnapshotEntry{BaekjoonID: "rookie", League: 0, LeagueName: "루키", Rank: 1, Score: 3})
+	snapshots := []models.ScoreSnapshot{
+		{TakenAt: day(1), Entries: []models.SnapshotEntry{{BaekjoonID: "auser", League: 1, Rank: 1, Score: 40}}},
+		{TakenAt: day(2)},
+		{TakenAt: day(3), Entries: entries},
+	}
+
+	panels, legends := leagueChartPanels(competition, snapshots)
+	if len(panels) != 2 || len(legends) != 2 {
+		t.Fatalf("Expected a panel per league, got %d panels %v", len(panels), legends)
+	}
+	if legends[0] != "`#1` 루키" || panels[0].Title != "#1" {
+		t.Errorf("Expected rookie league first, got %q / %q", legends[0], panels[0].Title)
+	}
+	pro := panels[1].Series
+	if len(pro) != 10 || pro[0].Label != "auser" || len(pro[0].Points) != 2 {
+		t.Errorf("Expected top 10 of league with full history, got %d series %+v", len(pro), pro[0])
+	}
+
+	if panels, _ := leagueChartPanels(competition, nil); panels != nil {
+		t.Error("Expected no panels without snapshots")
+	}
+}
+
+func TestParticipantChartPanels(t *testing.T) {
+	points := []models.TrendPoint{
+		{TakenAt: time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC), Score: 10, ProblemCount: 2},
+		{TakenAt: time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC), Score: 15, ProblemCount: 3},
+		{TakenAt: time.Date(2025, 3, 2, 12, 0, 0, 0, time.UTC), Score: 40, ProblemCount: 7},
+	}
+
+	panels := participantChartPanels("alice", points)
+	if len(panels) != 2 || panels[1].Kind != charts.PanelBar {
+		t.Fatalf("Expected score line and solved bar panels, got %+v", panels)
+	}
+	scores, solved := panels[0].Series[0].Points, panels[1].Series[0].Points
+	if len(scores) != 2 || scores[1].Value != 40 {
+		t.Errorf("Expected daily cumulative scores, got %+v", scores)
+	}
+	if len(solved) != 2 || solved[0].Value != 3 || solved[1].Value != 4 {
+		t.Errorf("Expected problems solved per day, got %+v", solved)
+	}
+}
+
+func TestVisibleSnapshots(t *testing.T) {
+	blackout := time.Date(2025, 3, 2, 0, 0, 0, 0, time.UTC)
+	competition := &models.Competition{BlackoutStartDate: blackout}
+	snapshots := []models.ScoreSnapshot{
+		{TakenAt: blackout.Add(-time.Hour)},
+		{TakenAt: blackout.Add(time.Hour)},
+	}
+
+	if got := visibleSnapshots(snapshots, competition, false); len(got) != 2 {
+		t.Errorf("Expected all snapshots when scores are visible, got %d", len(got))
+	}
+	if got := visibleSnapshots(snapshots, competition, true); len(got) != 1 {
+		t.Errorf("Expected snapshots before blackout only, got %d", len(got))
+	}
+	if got := visibleSnapshots(snapshots[1:], competition, true); got != nil {
+		t.Errorf("Expected no snapshots, got %d", len(got))
+	}
+}
@@ -21,6 +21,10 @@ type CommandDependencies struct {
 	Session           *discordgo.Session
 	MetricsClient     *telemetry.MetricsClient
 	SheetsClient      *sheets.SheetsClient
+	// GuildID DM에서 받은 관리 명령어의 권한을 확인할 서버 (비어 있으면 DM 관리 명령어 불가)
+	GuildID string
+	// AuditChannelID 관리 작업 감사 로그를 함께 보낼 채널 (비어 있으면 저장소에만 기록)
+	AuditChannelID string
 }
 
 // NewCommandDependencies 새로운 CommandDependencies 인스턴스를 생성합니다
@@ -33,6 +37,8 @@ func NewCommandDependencies(
 	session *discordgo.Session,
 	metricsClient *telemetry.MetricsClient,
 	sheetsClient *sheets.SheetsClient,
+	guildID string,
+	auditChannelID string,
 ) *CommandDependencies {
 	return &CommandDependencies{
 		Storage:           storage,
@@ -43,6 +49,8 @@ func NewCommandDependencies(
 		Session:           session,
 		MetricsClient:     metricsClient,
 		SheetsClient:      sheetsClient,
+		GuildID:           guildID,
+		AuditChannelID:    auditChannelID,
 	}
 }
 
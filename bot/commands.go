@@ -2,6 +2,7 @@ package bot
 
 import (
 	"context"
+	stderrors "errors"
 	"fmt"
 	"strings"
 	"time"
@@ -9,6 +10,8 @@ import (
 	"github.com/ssugameworks/kkemi/api"
 	"github.com/ssugameworks/kkemi/constants"
 	"github.com/ssugameworks/kkemi/errors"
+	"github.com/ssugameworks/kkemi/interfaces"
+	"github.com/ssugameworks/kkemi/models"
 	"github.com/ssugameworks/kkemi/utils"
 
 	"github.com/bwmarrin/discordgo"
@@ -38,7 +41,8 @@ func (handler *CommandHandler) HandleMessage(session *discordgo.Session, message
 		return
 	}
 
-	handler.routeCommand(session, message, command, params, isDM)
+	// 채널에 지정된 대회를 대상으로 명령어 실행
+	handler.forChannel(message.ChannelID).routeCommand(session, message, command, params, isDM)
 }
 
 // shouldIgnoreMessage 메시지를 무시해야 하는지 확인합니다
@@ -83,13 +87,36 @@ func (handler *CommandHandler) routeCommand(session *discordgo.Session, message
 		handler.deps.MetricsClient.SendCommandMetric(command, isAdmin)
 	}
 
+	// 권한이 필요한 명령어는 핸들러 실행 전에 한 곳에서 확인합니다
+	if !handler.authorizeCommand(session, message, command, params) {
+		return
+	}
+
 	switch command {
 	case "help", "도움말":
 		handler.handleHelp(session, message)
 	case "register", "등록":
 		handler.handleRegister(session, message, params)
+	case "verify", "인증":
+		handler.handleVerify(session, message)
+	case "withdraw", "탈퇴":
+		handler.handleWithdraw(session, message, params)
+	case "rename", "핸들변경":
+		handler.handleHandleChange(session, message, params)
 	case "scoreboard", "스코어보드":
-		handler.handleScoreboardCommand(session, message, isDM)
+		handler.handleScoreboardCommand(session, message, params, isDM)
+	case "score", "내점수":
+		handler.handleScoreBreakdown(session, message, params)
+	case "streak", "잔디":
+		handler.handleStreakboard(session, message)
+	case "trend", "추이":
+		handler.handleTrend(session, message, params)
+	case "chart", "그래프":
+		handler.handleChart(session, message, params)
+	case "team", "팀":
+		handler.handleTeam(session, message, params)
+	case "season", "시즌":
+		handler.handleSeason(session, message, params)
 	case "competition", "대회":
 		handler.competitionHandler.HandleCompetition(session, message, params)
 	case "participants", "참가자":
@@ -98,22 +125,63 @@ func (handler *CommandHandler) routeCommand(session *discordgo.Session, message
 		handler.handleRemoveParticipant(session, message, params)
 	case "cache", "캐시":
 		handler.handleCacheStats(session, message)
+	case "me", "내정보":
+		handler.handleMyInfo(session, message)
+	case "link", "계정연결":
+		handler.handleAccountLink(session, message, params)
+	case "permissions", "권한":
+		handler.handlePermissions(session, message, params)
+	case "audit", "감사로그":
+		handler.handleAuditLog(session, message, params)
+	case "roster", "명단":
+		handler.handleRoster(session, message, params)
 	case "ping":
 		handler.handlePing(session, message)
 	}
 }
 
 // handleScoreboardCommand 스코어보드 명령어를 처리합니다 (DM 체크 포함)
-func (handler *CommandHandler) handleScoreboardCommand(session *discordgo.Session, message *discordgo.MessageCreate, isDM bool) {
+func (handler *CommandHandler) handleScoreboardCommand(session *discordgo.Session, message *discordgo.MessageCreate, params []string, isDM bool) {
 	if isDM {
 		if _, err := session.ChannelMessageSend(message.ChannelID, constants.MsgScoreboardDMOnly); err != nil {
 			utils.Error("Failed to send DM response: %v", err)
 		}
 		return
 	}
+	if len(params) > 0 {
+		handler.handleScoreboardAsOf(session, message, params)
+		return
+	}
 	handler.handleScoreboard(session, message)
 }
 
+// handleScoreboardAsOf 저장된 스냅샷으로 지정한 날짜 기준의 스코어보드를 다시 만듭니다
+func (handler *CommandHandler) handleScoreboardAsOf(session *discordgo.Session, message *discordgo.MessageCreate, params []string) {
+	errorHandlers := utils.NewErrorHandlerFactory(session, message.ChannelID)
+
+	date, err := utils.ParseDateWithValidation(params[0], "scoreboard")
+	if len(params) != 1 || err != nil {
+		errorHandlers.Validation().HandleInvalidParams("SCOREBOARD_INVALID_DATE",
+			"Invalid scoreboard date",
+			constants.MsgScoreboardUsage)
+		return
+	}
+
+	embed, found, err := handler.deps.ScoreboardManager.ScoreboardAsOf(date)
+	if err != nil {
+		errorHandlers.System().HandleScoreboardGenerationFailed(err)
+		return
+	}
+	if !found {
+		errors.SendDiscordInfo(session, message.ChannelID, fmt.Sprintf(constants.MsgScoreboardAsOfNotFound, utils.FormatDate(date)))
+		return
+	}
+
+	if err := SendScoreboardEmbed(session, message.ChannelID, embed, nil); err != nil {
+		utils.Error("DISCORD API ERROR: Failed to send scoreboard snapshot: %v", err)
+	}
+}
+
 // handlePing ping 명령어를 처리합니다
 func (handler *CommandHandler) handlePing(session *discordgo.Session, message *discordgo.MessageCreate) {
 	if err := errors.SendDiscordInfo(session, message.ChannelID, constants.MsgPong); err != nil {
@@ -141,25 +209,46 @@ func (handler *CommandHandler) handleRegister(session *discordgo.Session, messag
 		return
 	}
 
-	// 3. solved.ac 사용자 정보 조회 및 검증
-	userInfo, ok := handler.validateSolvedACUser(name, baekjoonID, errorHandlers)
-	if !ok {
+	// 디스코드 계정 하나로는 대회마다 한 명만 등록할 수 있습니다
+	if linked, found := models.FindParticipantByDiscordUser(handler.deps.Storage.GetParticipants(), message.Author.ID); found {
+		errorHandlers.Validation().HandleInvalidParams("REGISTER_ALREADY_LINKED",
+			fmt.Sprintf("Discord user %s is already registered as %s", message.Author.ID, linked.BaekjoonID),
+			fmt.Sprintf(constants.MsgRegisterAlreadyLinked, linked.BaekjoonID))
+		return
+	}
+
+	// 이미 등록된 백준 ID는 본인 확인 전에 거절합니다
+	// 계정 연결 기능 이전에 등록해 아직 연결되지 않은 참가자는 본인 확인을 거쳐 이 계정과 연결합니다
+	if existing, exists := findParticipant(handler.deps.Storage.GetParticipants(), baekjoonID); exists {
+		if existing.DiscordUserID != "" {
+			errorHandlers.Data().HandleParticipantAlreadyExists(baekjoonID)
+			return
+		}
+		handler.issueRegistrationChallenge(session, message, models.RegistrationChallenge{
+			Name:       existing.Name,
+			BaekjoonID: existing.BaekjoonID,
+		})
 		return
 	}
 
-	// 4. 숭실대학교 소속 검증
-	organizationID, ok := handler.validateUniversityAffiliation(baekjoonID, errorHandlers)
+	// 3. solved.ac 사용자 정보 조회 및 검증
+	userInfo, ok := handler.validateSolvedACUser(name, baekjoonID, errorHandlers)
 	if !ok {
 		return
 	}
 
-	// 5. 참가자 등록
-	if !handler.registerParticipant(name, baekjoonID, userInfo, organizationID, errorHandlers) {
+	// 4. 대회 참가 자격 검증
+	organizationID, ok := handler.validateEligibility(name, baekjoonID, userInfo, errorHandlers)
+	if !ok {
 		return
 	}
 
-	// 6. 성공 메시지 전송
-	handler.sendRegistrationSuccess(session, message.ChannelID, name, userInfo)
+	// 5. 본인 확인 토큰 발급 (solved.ac 자기소개에서 토큰을 확인한 뒤 `!인증`으로 등록 완료)
+	handler.issueRegistrationChallenge(session, message, models.RegistrationChallenge{
+		Name:           name,
+		BaekjoonID:     baekjoonID,
+		OrganizationID: organizationID,
+	})
 }
 
 // validateRegisterParams 등록 매개변수를 검증합니다
@@ -223,41 +312,6 @@ func (handler *CommandHandler) validateSolvedACUser(name, baekjoonID string, err
 		return nil, false
 	}
 
-	// 스프레드시트에서 이름 검증
-	if handler.deps.SheetsClient != nil {
-		isInList, err := handler.deps.SheetsClient.IsNameInParticipantList(name)
-		if err != nil {
-			utils.Warn("Failed to check participant list: %v", err)
-			errorHandlers.System().HandleSystemError("SHEETS_CHECK_FAILED",
-				"Failed to verify participant eligibility",
-				constants.ErrorSheetsCheckFailed, err)
-			return nil, false
-		}
-		if !isInList {
-			// 스프레드시트에 없으면 백업 명단에서 확인
-			if utils.IsNameInBackupList(name) {
-				utils.Info("Name '%s' found in backup participant list", name)
-			} else {
-				errorHandlers.Validation().HandleInvalidParams("NAME_NOT_IN_LIST",
-					"Name not found in participant list",
-					fmt.Sprintf(constants.ErrorNameNotInList, name))
-				return nil, false
-			}
-		}
-		utils.Info("Name '%s' verified in participant list", name)
-	} else {
-		// SheetsClient가 없으면 백업 명단에서만 확인
-		utils.Warn("SheetsClient not available, using backup participant list")
-		if utils.IsNameInBackupList(name) {
-			utils.Info("Name '%s' found in backup participant list", name)
-		} else {
-			errorHandlers.Validation().HandleInvalidParams("NAME_NOT_IN_LIST",
-				"Name not found in participant list",
-				fmt.Sprintf(constants.ErrorNameNotInList, name))
-			return nil, false
-		}
-	}
-
 	return info, true
 }
 
@@ -300,38 +354,73 @@ func (handler *CommandHandler) extractSolvedACName(additionalInfo interface{}, e
 	}
 }
 
-// validateUniversityAffiliation 사용자의 학교 소속을 검증합니다
-func (handler *CommandHandler) validateUniversityAffiliation(baekjoonID string, errorHandlers *utils.ErrorHandlerFactory) (organizationID int, ok bool) {
+// validateEligibility 대회 참가 자격 요건을 검사하고, 충족하지 못한 요건을 모두 사유와 함께 안내합니다
+func (handler *CommandHandler) validateEligibility(name, baekjoonID string, userInfo interface{}, errorHandlers *utils.ErrorHandlerFactory) (organizationID int, ok bool) {
+	info, ok := handler.assertUserInfo(userInfo, errorHandlers)
+	if !ok {
+		return 0, false
+	}
+	competition := handler.deps.Storage.GetCompetition()
+	if competition == nil {
+		errorHandlers.Data().HandleNoActiveCompetition()
+		return 0, false
+	}
+	policy := competition.EffectiveEligibility()
+
 	// solved.ac에서 사용자의 조직 정보 조회
-	ctx := context.Background()
-	organizations, err := handler.deps.APIClient.GetUserOrganizations(ctx, baekjoonID)
+	organizations, err := handler.deps.APIClient.GetUserOrganizations(context.Background(), baekjoonID)
 	if err != nil {
 		errorHandlers.API().HandleBaekjoonUserNotFound(baekjoonID, err)
 		return 0, false
 	}
 
-	// 특정 학교 소속인지 확인
+	profile := models.EligibilityProfile{
+		Tier:        info.Tier,
+		Class:       info.Class,
+		SolvedCount: info.SolvedCount,
+		JoinedAt:    info.JoinedAt,
+	}
 	for _, org := range organizations {
-		if org.OrganizationID == constants.UniversityID {
-			return constants.UniversityID, true
+		profile.OrganizationIDs = append(profile.OrganizationIDs, org.OrganizationID)
+	}
+	if policy.RequireRoster {
+		inRoster, err := handler.isNameInRoster(name)
+		if err != nil {
+			errorHandlers.System().HandleSystemError("ROSTER_CHECK_FAILED",
+				"Failed to verify participant eligibility",
+				constants.ErrorRosterCheckFailed, err)
+			return 0, false
 		}
+		profile.InRoster = inRoster
 	}
 
-	// 특정 학교 소속이 아닌 경우 에러 메시지 전송
-	errorHandlers.Validation().HandleInvalidParams("NOT_SOONGSIL_UNIVERSITY",
-		"User is not affiliated with Soongsil University",
-		constants.MsgRegisterNotSoongsilStudent)
-	return 0, false
+	now := utils.GetCurrentTimeKST()
+	violations := policy.Violations(profile, now)
+	if len(violations) > 0 {
+		errorHandlers.Validation().HandleInvalidParams("NOT_ELIGIBLE",
+			fmt.Sprintf("User %s does not meet eligibility rules: %v", baekjoonID, violations),
+			fmt.Sprintf(constants.MsgRegisterNotEligible, competition.Name,
+				handler.formatEligibilityViolations(policy, profile, violations, name, now)))
+		return 0, false
+	}
+
+	organizationID, _ = policy.MatchOrganization(profile.OrganizationIDs)
+	return organizationID, true
 }
 
 // registerParticipant 참가자를 등록합니다
-func (handler *CommandHandler) registerParticipant(name, baekjoonID string, userInfo interface{}, organizationID int, errorHandlers *utils.ErrorHandlerFactory) bool {
+func (handler *CommandHandler) registerParticipant(name, baekjoonID, discordUserID string, userInfo interface{}, organizationID int, errorHandlers *utils.ErrorHandlerFactory) bool {
 	info, ok := handler.assertUserInfo(userInfo, errorHandlers)
 	if !ok {
 		return false
 	}
 
-	err := handler.deps.Storage.AddParticipant(name, baekjoonID, info.Tier, info.Rating, organizationID)
+	err := handler.deps.Storage.AddParticipant(name, baekjoonID, info.Tier, info.Rating, organizationID, discordUserID)
+	if stderrors.Is(err, interfaces.ErrStartSnapshotUnavailable) {
+		errorHandlers.System().HandleSystemError("REGISTER_SNAPSHOT_FAILED",
+			"Failed to load start snapshot", fmt.Sprintf(constants.MsgRegisterSnapshotFailed, baekjoonID), err)
+		return false
+	}
 	if err != nil {
 		errorHandlers.Data().HandleParticipantAlreadyExists(baekjoonID)
 		return false
@@ -359,8 +448,9 @@ func (handler *CommandHandler) sendRegistrationSuccess(session *discordgo.Sessio
 	colorCode := handler.deps.TierManager.GetTierANSIColor(info.Tier)
 
 	// 사용자 리그 결정 및 이름 가져오기
-	userLeague := handler.deps.ScoreCalculator.GetUserLeague(info.Tier)
-	leagueName := handler.deps.ScoreCalculator.GetLeagueName(userLeague)
+	calculator := handler.deps.ScoreCalculator.ForCompetition(handler.deps.Storage.GetCompetition())
+	userLeague := calculator.GetUserLeague(info.Tier)
+	leagueName := calculator.GetLeagueName(userLeague)
 
 	response := fmt.Sprintf("```ansi\n"+constants.MsgRegisterSuccess+"\n```",
 		colorCode, name, tierName, handler.deps.TierManager.GetANSIReset(), leagueName)
@@ -376,19 +466,9 @@ func (handler *CommandHandler) handleScoreboard(session *discordgo.Session, mess
 	utils.Info("Scoreboard command received from user: %s (ID: %s)", message.Author.Username, message.Author.ID)
 	utils.Info("Guild ID: %s, Channel ID: %s", message.GuildID, message.ChannelID)
 
-	// 관리자 권한 확인
-	isAdmin := handler.isAdmin(session, message)
-	utils.Info("User %s admin status: %t", message.Author.Username, isAdmin)
-
-	if !isAdmin {
-		utils.Warn("User %s attempted to use scoreboard without admin permissions", message.Author.Username)
-		errorHandlers.Validation().HandleInsufficientPermissions()
-		return
-	}
-
-	// 스코어보드 생성 성능 측정 시작
+	// 스코어보드 생성 성능 측정 시작 (명령어 권한 확인을 통과했으므로 비공개 점수도 표시)
 	startTime := time.Now()
-	embed, err := handler.deps.ScoreboardManager.GenerateScoreboard(isAdmin)
+	messages, err := handler.deps.ScoreboardManager.GenerateScoreboardMessages(true)
 	duration := time.Since(startTime)
 
 	// 스코어보드 성능 텔레메트리 전송
@@ -404,7 +484,7 @@ func (handler *CommandHandler) handleScoreboard(session *discordgo.Session, mess
 
 	utils.Info("Scoreboard generated successfully, sending to channel %s", message.ChannelID)
 
-	if _, err := session.ChannelMessageSendEmbed(message.ChannelID, embed); err != nil {
+	if err := sendMessages(session, message.ChannelID, messages); err != nil {
 		utils.Error("DISCORD API ERROR: Failed to send scoreboard embed: %v", err)
 	} else {
 		utils.Info("Scoreboard sent successfully")
@@ -412,14 +492,6 @@ func (handler *CommandHandler) handleScoreboard(session *discordgo.Session, mess
 }
 
 func (handler *CommandHandler) handleParticipants(session *discordgo.Session, message *discordgo.MessageCreate) {
-	errorHandlers := utils.NewErrorHandlerFactory(session, message.ChannelID)
-
-	// 관리자 권한 확인
-	if !handler.isAdmin(session, message) {
-		errorHandlers.Validation().HandleInsufficientPermissions()
-		return
-	}
-
 	participants := handler.deps.Storage.GetParticipants()
 	if len(participants) == 0 {
 		errors.SendDiscordInfo(session, message.ChannelID, constants.MsgParticipantsEmpty)
@@ -445,12 +517,6 @@ func (handler *CommandHandler) handleParticipants(session *discordgo.Session, me
 func (handler *CommandHandler) handleRemoveParticipant(session *discordgo.Session, message *discordgo.MessageCreate, params []string) {
 	errorHandlers := utils.NewErrorHandlerFactory(session, message.ChannelID)
 
-	// 관리자 권한 확인
-	if !handler.isAdmin(session, message) {
-		errorHandlers.Validation().HandleInsufficientPermissions()
-		return
-	}
-
 	// 파라미터 확인
 	if len(params) < 1 {
 		errorHandlers.Validation().HandleInvalidParams("REMOVE_INVALID_PARAMS",
@@ -469,81 +535,32 @@ func (handler *CommandHandler) handleRemoveParticipant(session *discordgo.Sessio
 		return
 	}
 
-	// 참가자 삭제
+	// 참가자 삭제 (감사 로그에 남길 참가자 정보는 삭제 전에 조회)
+	participant, _ := findParticipant(handler.deps.Storage.GetParticipants(), baekjoonID)
 	err := handler.deps.Storage.RemoveParticipant(baekjoonID)
 	if err != nil {
 		errorHandlers.Data().HandleParticipantNotFound(baekjoonID)
 		return
 	}
 
-	response := fmt.Sprintf(constants.MsgRemoveSuccess, baekjoonID)
-	if err := errors.SendDiscordSuccess(session, message.ChannelID, response); err != nil {
-		utils.Error("Failed to send participant removal response: %v", err)
-	}
-}
-
-// isAdmin 사용자가 서버 관리자 권한을 가지고 있는지 확인합니다
-func (handler *CommandHandler) isAdmin(session *discordgo.Session, message *discordgo.MessageCreate) bool {
-
-	// DM에서는 관리자 권한 없음
-	if message.GuildID == "" {
-		utils.Info("User is in DM, no admin permissions")
-		return false
-	}
-
-	// 길드 정보 가져오기
-	guild, err := session.State.Guild(message.GuildID)
-	if err != nil || guild == nil {
-		utils.Warn("Cannot get guild information: %v", err)
-		return false
+	entry := models.AuditEntry{
+		Action: models.AuditActionParticipantRemove,
+		Target: baekjoonID,
+		Before: fmt.Sprintf(constants.MsgAuditParticipant, participant.Name, participant.BaekjoonID),
 	}
-
-	// 서버 소유자인지 확인
-	if message.Author.ID == guild.OwnerID {
-		utils.Info("User %s is the guild owner - granting admin access", message.Author.Username)
-		return true
+	if competition := handler.deps.Storage.GetCompetition(); competition != nil {
+		entry.CompetitionID = competition.ID
 	}
+	handler.recordAudit(session, message, entry)
 
-	// 멤버 정보 가져오기
-	member, err := session.GuildMember(message.GuildID, message.Author.ID)
-	if err != nil || member == nil {
-		utils.Warn("Cannot get member information for %s: %v", message.Author.Username, err)
-		return false
-	}
-
-	utils.Info("Member found with %d roles", len(member.Roles))
-
-	// 멤버의 역할들을 확인
-	for _, roleID := range member.Roles {
-		role, err := session.State.Role(message.GuildID, roleID)
-		if err != nil {
-			utils.Warn("Cannot get role %s: %v", roleID, err)
-			continue
-		}
-
-		utils.Info("Checking role: %s (ID: %s), Permissions: %d", role.Name, role.ID, role.Permissions)
-
-		// 관리자 권한(ADMINISTRATOR) 확인
-		if role.Permissions&discordgo.PermissionAdministrator != 0 {
-			utils.Info("User %s has ADMINISTRATOR permission through role %s - granting admin access", message.Author.Username, role.Name)
-			return true
-		}
+	response := fmt.Sprintf(constants.MsgRemoveSuccess, baekjoonID)
+	if err := errors.SendDiscordSuccess(session, message.ChannelID, response); err != nil {
+		utils.Error("Failed to send participant removal response: %v", err)
 	}
-
-	utils.Info("User %s has no admin permissions", message.Author.Username)
-	return false
 }
 
 // handleCacheStats 캐시 통계를 조회합니다
 func (handler *CommandHandler) handleCacheStats(session *discordgo.Session, message *discordgo.MessageCreate) {
-	errorHandlers := utils.NewErrorHandlerFactory(session, message.ChannelID)
-
-	// 관리자 권한 확인
-	if !handler.isAdmin(session, message) {
-		errorHandlers.Validation().HandleInsufficientPermissions()
-		return
-	}
-
 	if cachedClient, ok := handler.deps.APIClient.(*api.CachedSolvedACClient); ok {
 		stats := cachedClient.GetCacheStats()
 
@@ -555,9 +572,13 @@ func (handler *CommandHandler) handleCacheStats(session *discordgo.Session, mess
 			"Cached Items:\n"+
 			"  - User Info: %d\n"+
 			"  - User Top100: %d\n"+
-			"  - User Additional: %d\n```",
+			"  - User Additional: %d\n"+
+			"  - User Solved: %d\n"+
+			"  - Problem Info: %d\n"+
+			"  - Class Problems: %d\n```",
 			stats.TotalCalls, stats.CacheHits, stats.CacheMisses, stats.HitRate,
-			stats.UserInfoCached, stats.UserTop100Cached, stats.UserAdditionalCached)
+			stats.UserInfoCached, stats.UserTop100Cached, stats.UserAdditionalCached, stats.UserSolvedCached,
+			stats.ProblemInfoCached, stats.ClassProblemsCached)
 
 		if err := errors.SendDiscordInfo(session, message.ChannelID, statsMessage); err != nil {
 			utils.Error("Failed to send cache stats response: %v", err)
@@ -3,10 +3,14 @@ package bot
 import (
 	"context"
 	"fmt"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/ssugameworks/kkemi/api"
 	"github.com/ssugameworks/kkemi/constants"
+	"github.com/ssugameworks/kkemi/models"
+	"github.com/ssugameworks/kkemi/storage"
 
 	"github.com/bwmarrin/discordgo"
 )
@@ -16,10 +20,15 @@ type MockSolvedACClient struct {
 	userInfo       *api.UserInfo
 	additionalInfo *api.UserAdditionalInfo
 	organizations  []api.Organization
+	solved         []api.ProblemInfo
+	userInfoErr    error
 	shouldError    bool
 }
 
 func (m *MockSolvedACClient) GetUserInfo(ctx context.Context, handle string) (*api.UserInfo, error) {
+	if m.userInfoErr != nil {
+		return nil, m.userInfoErr
+	}
 	if m.shouldError {
 		return nil, fmt.Errorf("사용자를 찾을 수 없습니다: %s", handle)
 	}
@@ -47,6 +56,34 @@ func (m *MockSolvedACClient) GetUserOrganizations(ctx context.Context, handle st
 	return m.organizations, nil
 }
 
+func (m *MockSolvedACClient) GetOrganization(ctx context.Context, organizationID int) (*api.Organization, error) {
+	for _, organization := range m.organizations {
+		if organization.OrganizationID == organizationID {
+			return &organization, nil
+		}
+	}
+	return nil, fmt.Errorf("단체를 찾을 수 없습니다: %d", organizationID)
+}
+
+func (m *MockSolvedACClient) GetUserSolvedProblems(ctx context.Context, handle string) ([]api.ProblemInfo, error) {
+	if m.shouldError {
+		return nil, fmt.Errorf("사용자를 찾을 수 없습니다: %s", handle)
+	}
+	return m.solved, nil
+}
+
+func (m *MockSolvedACClient) GetProblemInfo(ctx context.Context, problemID int) (*api.ProblemInfo, error) {
+	return &api.ProblemInfo{ProblemID: problemID}, nil
+}
+
+func (m *MockSolvedACClient) GetClassProblemIDs(ctx context.Context, class int) ([]int, error) {
+	return []int{}, nil
+}
+
+func (m *MockSolvedACClient) SearchProblems(ctx context.Context, query string, page int) (*api.ProblemSearchResponse, error) {
+	return &api.ProblemSearchResponse{}, nil
+}
+
 func TestNewCommandHandler(t *testing.T) {
 	deps := &CommandDependencies{
 		APIClient: &MockSolvedACClient{},
@@ -273,39 +310,54 @@ func TestExtractSolvedACName(t *testing.T) {
 	}
 }
 
-func TestValidateUniversityAffiliation(t *testing.T) {
-	// 유효한 소속 테스트
-	ch1 := &CommandHandler{
-		deps: &CommandDependencies{
-			APIClient: &MockSolvedACClient{
-				organizations: []api.Organization{
-					{OrganizationID: constants.UniversityID, Name: "숭실대학교"},
-					{OrganizationID: 999, Name: "Other University"},
-				},
-			},
+func TestValidateEligibility(t *testing.T) {
+	client := &MockSolvedACClient{
+		organizations: []api.Organization{
+			{OrganizationID: 999, Name: "Other University"},
+			{OrganizationID: constants.DefaultOrganizationID, Name: "숭실대학교"},
 		},
 	}
-
-	orgID, ok := ch1.validateUniversityAffiliation("testuser", nil)
-	if !ok || orgID != constants.UniversityID {
-		t.Error("올바른 대학교 소속 사용자를 수락해야 합니다")
+	store := storage.NewInMemoryStorage(client)
+	start := time.Now().AddDate(0, 0, -1)
+	if _, err := store.CreateCompetition("test", start, start.AddDate(0, 0, 7)); err != nil {
+		t.Fatalf("Failed to create competition: %v", err)
+	}
+	policy := &models.EligibilityPolicy{OrganizationIDs: []int{constants.DefaultOrganizationID}, MinTier: 6}
+	if err := store.UpdateCompetitionEligibility(policy); err != nil {
+		t.Fatalf("Failed to update eligibility: %v", err)
 	}
 
-	// 잘못된 소속의 경우는 에러 핸들러가 호출되므로 직접 조직 목록 검증
-	invalidOrgs := []api.Organization{
-		{OrganizationID: 999, Name: "Other University"},
+	ch := &CommandHandler{deps: &CommandDependencies{Storage: store, APIClient: client}}
+	orgID, ok := ch.validateEligibility("홍길동", "testuser", &api.UserInfo{Tier: 10}, nil)
+	if !ok || orgID != constants.DefaultOrganizationID {
+		t.Errorf("Expected eligible user with organization %d, got %d (ok=%t)", constants.DefaultOrganizationID, orgID, ok)
 	}
+}
 
-	hasValidOrg := false
-	for _, org := range invalidOrgs {
-		if org.OrganizationID == constants.UniversityID {
-			hasValidOrg = true
-			break
-		}
+func TestFormatEligibilityViolations(t *testing.T) {
+	client := &MockSolvedACClient{
+		organizations: []api.Organization{{OrganizationID: constants.DefaultOrganizationID, Name: "숭실대학교"}},
 	}
+	ch := &CommandHandler{deps: &CommandDependencies{APIClient: client}}
+	policy := &models.EligibilityPolicy{
+		OrganizationIDs:   []int{constants.DefaultOrganizationID, 777},
+		RequiredClass:     3,
+		MinAccountAgeDays: 30,
+		RequireRoster:     true,
+	}
+	profile := models.EligibilityProfile{Class: 1}
+	now := time.Now()
 
-	if hasValidOrg {
-		t.Error("테스트 로직 오류: 유효하지 않은 조직 목록에 숭실대학교가 포함됨")
+	message := ch.formatEligibilityViolations(policy, profile, policy.Violations(profile, now), "홍길동", now)
+	lines := strings.Split(message, "\n")
+	if len(lines) != 4 {
+		t.Fatalf("Expected one reason per violated rule, got %q", message)
+	}
+	if !strings.Contains(lines[0], "숭실대학교, #777") {
+		t.Errorf("Expected organization names with ID fallback, got %q", lines[0])
+	}
+	if !strings.Contains(lines[1], "3") || !strings.Contains(lines[2], "30") || !strings.Contains(lines[3], "홍길동") {
+		t.Errorf("Unexpected reasons: %q", message)
 	}
 }
 
@@ -28,16 +28,10 @@ func NewCompetitionHandler(ch *CommandHandler) *CompetitionHandler {
 func (ch *CompetitionHandler) HandleCompetition(s *discordgo.Session, m *discordgo.MessageCreate, params []string) {
 	errorHandlers := utils.NewErrorHandlerFactory(s, m.ChannelID)
 
-	// DM이 아닌 경우에만 관리자 권한 확인
-	if m.GuildID != "" && !ch.commandHandler.isAdmin(s, m) {
-		errorHandlers.Validation().HandleInsufficientPermissions()
-		return
-	}
-
 	if len(params) == 0 {
 		errorHandlers.Validation().HandleInvalidParams("COMPETITION_INVALID_PARAMS",
 			"Invalid competition parameters",
-			"사용법: `!대회 <create|status|blackout|update>`")
+			"사용법: `!대회 <create|list|select|status|blackout|update|backfill|rules|eligibility|mode|problems|finalize|history|results>`")
 		return
 	}
 
@@ -45,12 +39,32 @@ func (ch *CompetitionHandler) HandleCompetition(s *discordgo.Session, m *discord
 	switch subCommand {
 	case "create":
 		ch.handleCompetitionCreate(s, m, params[1:])
+	case "list":
+		ch.handleCompetitionList(s, m)
+	case "select":
+		ch.handleCompetitionSelect(s, m, params[1:])
 	case "status":
 		ch.handleCompetitionStatus(s, m)
 	case "blackout":
 		ch.handleCompetitionBlackout(s, m, params[1:])
 	case "update":
 		ch.handleCompetitionUpdate(s, m, params[1:])
+	case "backfill":
+		ch.handleCompetitionBackfill(s, m)
+	case "rules":
+		ch.handleCompetitionRules(s, m, params[1:])
+	case "eligibility":
+		ch.handleCompetitionEligibility(s, m, params[1:])
+	case "mode":
+		ch.handleCompetitionMode(s, m, params[1:])
+	case "problems":
+		ch.handleCompetitionProblems(s, m, params[1:])
+	case "finalize":
+		ch.handleCompetitionFinalize(s, m, params[1:])
+	case "history":
+		ch.handleCompetitionHistory(s, m)
+	case "results":
+		ch.handleCompetitionResults(s, m, params[1:])
 	default:
 		err := errors.NewValidationError("COMPETITION_UNKNOWN_COMMAND",
 			fmt.Sprintf("Unknown competition command: %s", subCommand),
@@ -59,6 +73,19 @@ func (ch *CompetitionHandler) HandleCompetition(s *discordgo.Session, m *discord
 	}
 }
 
+// isPublicCompetitionCommand 대회 관리 권한 없이 사용할 수 있는 대회 하위 명령어인지 확인합니다
+func isPublicCompetitionCommand(params []string) bool {
+	if len(params) == 0 {
+		return false
+	}
+	switch params[0] {
+	case "history", "results":
+		return true
+	default:
+		return false
+	}
+}
+
 func (ch *CompetitionHandler) handleCompetitionCreate(s *discordgo.Session, m *discordgo.MessageCreate, params []string) {
 	errorHandlers := utils.NewErrorHandlerFactory(s, m.ChannelID)
 
@@ -82,18 +109,24 @@ func (ch *CompetitionHandler) handleCompetitionCreate(s *discordgo.Session, m *d
 		return
 	}
 
-	err = ch.commandHandler.deps.Storage.CreateCompetition(name, startDate, endDate)
+	competitionID, err := ch.commandHandler.deps.Storage.CreateCompetition(name, startDate, endDate)
 	if err != nil {
 		errorHandlers.System().HandleCompetitionCreateFailed(err)
 		return
 	}
+	ch.commandHandler.recordAudit(s, m, models.AuditEntry{
+		CompetitionID: competitionID,
+		Action:        models.AuditActionCompetitionCreate,
+		Target:        name,
+		After:         fmt.Sprintf(constants.MsgAuditPeriod, utils.FormatDate(startDate), utils.FormatDate(endDate)),
+	})
 
 	// 봇 상태 업데이트
 	ch.commandHandler.deps.UpdateBotStatus()
 
 	// 대회 생성 텔레메트리 전송
 	if ch.commandHandler.deps.MetricsClient != nil {
-		participantCount := len(ch.commandHandler.deps.Storage.GetParticipants())
+		participantCount := len(ch.commandHandler.deps.Storage.ForCompetition(competitionID).GetParticipants())
 		ch.commandHandler.deps.MetricsClient.SendCompetitionMetric("created", participantCount)
 	}
 
@@ -103,6 +136,7 @@ func (ch *CompetitionHandler) handleCompetitionCreate(s *discordgo.Session, m *d
 		utils.FormatDate(startDate),
 		utils.FormatDate(endDate),
 		utils.FormatDate(blackoutStart))
+	response += "\n" + fmt.Sprintf(constants.MsgCompetitionCreateSelectHint, competitionID)
 
 	errors.SendDiscordSuccess(s, m.ChannelID, response)
 }
@@ -137,6 +171,8 @@ func (ch *CompetitionHandler) handleCompetitionStatus(s *discordgo.Session, m *d
 		blackoutStatus,
 		status,
 		len(ch.commandHandler.deps.Storage.GetParticipants()))
+	response += "\n" + fmt.Sprintf(constants.MsgCompetitionStatusScope,
+		competition.ID, formatCompetitionChannel(*competition), formatCompetitionSchedule(*competition))
 
 	if _, err := s.ChannelMessageSend(m.ChannelID, response); err != nil {
 		utils.Error("Failed to send competition status message: %v", err)
@@ -168,6 +204,12 @@ func (ch *CompetitionHandler) handleCompetitionBlackout(s *discordgo.Session, m
 		return
 	}
 
+	competition := ch.commandHandler.deps.Storage.GetCompetition()
+	if competition == nil {
+		utils.NewErrorHandlerFactory(s, m.ChannelID).Data().HandleNoActiveCompetition()
+		return
+	}
+
 	err := ch.commandHandler.deps.Storage.SetScoreboardVisibility(visible)
 	if err != nil {
 		botErr := errors.NewSystemError("BLACKOUT_SETTING_FAILED",
@@ -177,6 +219,14 @@ func (ch *CompetitionHandler) handleCompetitionBlackout(s *discordgo.Session, m
 		return
 	}
 
+	ch.commandHandler.recordAudit(s, m, models.AuditEntry{
+		CompetitionID: competition.ID,
+		Action:        models.AuditActionCompetitionBlackout,
+		Target:        competition.Name,
+		Before:        auditVisibility(competition.ShowScoreboard),
+		After:         auditVisibility(visible),
+	})
+
 	status := "공개"
 	if !visible {
 		status = "비공개"
@@ -190,7 +240,7 @@ func (ch *CompetitionHandler) handleCompetitionUpdate(s *discordgo.Session, m *d
 	if len(params) < 2 {
 		err := errors.NewValidationError("COMPETITION_UPDATE_INVALID_PARAMS",
 			"Invalid competition update parameters",
-			"사용법: `!대회 update <필드> <값>`\n필드: name, start, end\n예시: `!대회 update name 대회명`")
+			"사용법: `!대회 update <필드> <값>`\n필드: name, start, end, schedule\n예시: `!대회 update name 대회명`, `!대회 update schedule 21:00`")
 		errors.HandleDiscordError(s, m.ChannelID, err)
 		return
 	}
@@ -209,20 +259,22 @@ func (ch *CompetitionHandler) handleCompetitionUpdate(s *discordgo.Session, m *d
 
 	switch field {
 	case "name":
-		ch.handleUpdateName(s, m, value, competition.Name)
+		ch.handleUpdateName(s, m, value, competition)
 	case "start":
 		ch.handleUpdateStartDate(s, m, value, competition)
 	case "end":
 		ch.handleUpdateEndDate(s, m, value, competition)
+	case "schedule":
+		ch.handleUpdateSchedule(s, m, value, competition)
 	default:
 		err := errors.NewValidationError("INVALID_UPDATE_FIELD",
 			fmt.Sprintf("Invalid field: %s", field),
-			"올바르지 않은 필드입니다. 사용 가능한 필드: name, start, end")
+			"올바르지 않은 필드입니다. 사용 가능한 필드: name, start, end, schedule")
 		errors.HandleDiscordError(s, m.ChannelID, err)
 	}
 }
 
-func (ch *CompetitionHandler) handleUpdateName(s *discordgo.Session, m *discordgo.MessageCreate, newName, oldName string) {
+func (ch *CompetitionHandler) handleUpdateName(s *discordgo.Session, m *discordgo.MessageCreate, newName string, competition *models.Competition) {
 	if newName == "" {
 		err := errors.NewValidationError("EMPTY_COMPETITION_NAME",
 			"Competition name cannot be empty",
@@ -240,6 +292,14 @@ func (ch *CompetitionHandler) handleUpdateName(s *discordgo.Session, m *discordg
 		return
 	}
 
+	ch.commandHandler.recordAudit(s, m, models.AuditEntry{
+		CompetitionID: competition.ID,
+		Action:        models.AuditActionCompetitionName,
+		Target:        competition.Name,
+		Before:        competition.Name,
+		After:         newName,
+	})
+
 	// 봇 상태 업데이트
 	ch.commandHandler.deps.UpdateBotStatus()
 
@@ -308,7 +368,48 @@ func (ch *CompetitionHandler) updateCompetitionDate(s *discordgo.Session, m *dis
 		return
 	}
 
+	action, before := models.AuditActionCompetitionEnd, competition.EndDate
+	if isStartDate {
+		action, before = models.AuditActionCompetitionStart, competition.StartDate
+	}
+	ch.commandHandler.recordAudit(s, m, models.AuditEntry{
+		CompetitionID: competition.ID,
+		Action:        action,
+		Target:        competition.Name,
+		Before:        utils.FormatDate(before),
+		After:         utils.FormatDate(parsedDate),
+	})
+
 	// Send success message
 	message := fmt.Sprintf(constants.MsgCompetitionUpdateSuccess, formatLabel)
 	errors.SendDiscordSuccess(s, m.ChannelID, message)
 }
+
+// handleCompetitionBackfill 기존 참가자들의 등록 시점 전체 스냅샷을 보완합니다
+func (ch *CompetitionHandler) handleCompetitionBackfill(s *discordgo.Session, m *discordgo.MessageCreate) {
+	errorHandlers := utils.NewErrorHandlerFactory(s, m.ChannelID)
+
+	competition := ch.commandHandler.deps.Storage.GetCompetition()
+	if competition == nil {
+		errorHandlers.Data().HandleNoActiveCompetition()
+		return
+	}
+
+	updated, err := ch.commandHandler.deps.Storage.BackfillStartSnapshots()
+	if err != nil {
+		botErr := errors.NewSystemError("SNAPSHOT_BACKFILL_FAILED",
+			"Failed to backfill start snapshots", err)
+		botErr.UserMsg = fmt.Sprintf(constants.MsgCompetitionBackfillFailed, updated)
+		errors.HandleDiscordError(s, m.ChannelID, botErr)
+		return
+	}
+
+	ch.commandHandler.recordAudit(s, m, models.AuditEntry{
+		CompetitionID: competition.ID,
+		Action:        models.AuditActionCompetitionBackfill,
+		Target:        competition.Name,
+		After:         fmt.Sprintf(constants.MsgAuditBackfill, updated),
+	})
+
+	errors.SendDiscordSuccess(s, m.ChannelID, fmt.Sprintf(constants.MsgCompetitionBackfillSuccess, updated))
+}
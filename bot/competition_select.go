@@ -0,0 +1,190 @@
+package bot
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/ssugameworks/kkemi/constants"
+	"github.com/ssugameworks/kkemi/errors"
+	"github.com/ssugameworks/kkemi/models"
+	"github.com/ssugameworks/kkemi/utils"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+// forChannel 채널에 지정된 대회를 대상으로 하는 핸들러를 반환합니다 (지정이 없으면 기본 대회)
+func (handler *CommandHandler) forChannel(channelID string) *CommandHandler {
+	competitionID := models.CompetitionForChannel(handler.deps.Storage.GetActiveCompetitions(), channelID)
+	return handler.forCompetition(competitionID)
+}
+
+// forCompetition 지정한 대회를 대상으로 동작하는 핸들러 사본을 반환합니다
+func (handler *CommandHandler) forCompetition(competitionID string) *CommandHandler {
+	if competitionID == "" {
+		return handler
+	}
+
+	deps := *handler.deps
+	deps.Storage = handler.deps.Storage.ForCompetition(competitionID)
+	if handler.deps.ScoreboardManager != nil {
+		deps.ScoreboardManager = handler.deps.ScoreboardManager.ForStorage(deps.Storage)
+	}
+
+	scoped := &CommandHandler{deps: &deps}
+	scoped.competitionHandler = NewCompetitionHandler(scoped)
+	return scoped
+}
+
+// handleCompetitionList 진행 중인 대회와 대회별 채널/발송 시각을 보여줍니다
+func (ch *CompetitionHandler) handleCompetitionList(s *discordgo.Session, m *discordgo.MessageCreate) {
+	competitions := ch.commandHandler.deps.Storage.GetActiveCompetitions()
+	if len(competitions) == 0 {
+		utils.NewErrorHandlerFactory(s, m.ChannelID).Data().HandleNoActiveCompetition()
+		return
+	}
+
+	current := ch.commandHandler.deps.Storage.GetCompetition()
+	var builder strings.Builder
+	for _, competition := range competitions {
+		marker := "•"
+		if current != nil && current.ID == competition.ID {
+			marker = "▶"
+		}
+		builder.WriteString(fmt.Sprintf("%s **%s** (`%s`) %s ~ %s\n", marker, competition.Name, competition.ID,
+			utils.FormatDate(competition.StartDate), utils.FormatDate(competition.EndDate)))
+		builder.WriteString(fmt.Sprintf(constants.MsgCompetitionListScope, formatCompetitionChannel(competition), formatCompetitionSchedule(competition)))
+	}
+
+	embed := &discordgo.MessageEmbed{
+		Title:       fmt.Sprintf(constants.MsgCompetitionListTitle, len(competitions)),
+		Description: builder.String(),
+		Color:       constants.ColorTierGold,
+		Footer:      &discordgo.MessageEmbedFooter{Text: constants.MsgCompetitionListFooter},
+	}
+	if _, err := s.ChannelMessageSendEmbed(m.ChannelID, embed); err != nil {
+		utils.Error("DISCORD API ERROR: Failed to send competition list: %v", err)
+	}
+}
+
+// handleCompetitionSelect 현재 채널의 기본 대회를 지정하거나(`select <ID|대회명>`) 해제합니다(`select clear`)
+func (ch *CompetitionHandler) handleCompetitionSelect(s *discordgo.Session, m *discordgo.MessageCreate, params []string) {
+	errorHandlers := utils.NewErrorHandlerFactory(s, m.ChannelID)
+
+	if len(params) == 0 || m.GuildID == "" {
+		errorHandlers.Validation().HandleInvalidParams("COMPETITION_SELECT_INVALID_PARAMS",
+			"Invalid competition select parameters",
+			constants.MsgCompetitionSelectUsage)
+		return
+	}
+
+	storage := ch.commandHandler.deps.Storage
+	competitions := storage.GetActiveCompetitions()
+
+	if len(params) == 1 && params[0] == "clear" {
+		competitionID := models.CompetitionForChannel(competitions, m.ChannelID)
+		if competitionID == "" {
+			errors.SendDiscordInfo(s, m.ChannelID, constants.MsgCompetitionSelectNone)
+			return
+		}
+		if err := storage.ForCompetition(competitionID).UpdateCompetitionChannel(""); err != nil {
+			errorHandlers.System().HandleCompetitionUpdateFailed(err)
+			return
+		}
+		competition, _ := findCompetition(competitions, competitionID)
+		ch.commandHandler.recordAudit(s, m, models.AuditEntry{
+			CompetitionID: competitionID,
+			Action:        models.AuditActionCompetitionChannel,
+			Target:        competition.Name,
+			Before:        formatCompetitionChannel(competition),
+		})
+		errors.SendDiscordSuccess(s, m.ChannelID, constants.MsgCompetitionSelectCleared)
+		return
+	}
+
+	competition, found := findCompetition(competitions, strings.Join(params, " "))
+	if !found {
+		errorHandlers.Validation().HandleInvalidParams("COMPETITION_NOT_FOUND",
+			fmt.Sprintf("Competition not found: %s", strings.Join(params, " ")),
+			constants.MsgCompetitionSelectNotFound)
+		return
+	}
+
+	if err := storage.ForCompetition(competition.ID).UpdateCompetitionChannel(m.ChannelID); err != nil {
+		errorHandlers.System().HandleCompetitionUpdateFailed(err)
+		return
+	}
+	before := ""
+	if competition.ChannelID != "" {
+		before = formatCompetitionChannel(competition)
+	}
+	ch.commandHandler.recordAudit(s, m, models.AuditEntry{
+		CompetitionID: competition.ID,
+		Action:        models.AuditActionCompetitionChannel,
+		Target:        competition.Name,
+		Before:        before,
+		After:         "<#" + m.ChannelID + ">",
+	})
+	errors.SendDiscordSuccess(s, m.ChannelID, fmt.Sprintf(constants.MsgCompetitionSelectSuccess, competition.Name))
+}
+
+// handleUpdateSchedule 대회의 일일 스코어보드 발송 시각을 변경합니다 (`default`면 기본 시각)
+func (ch *CompetitionHandler) handleUpdateSchedule(s *discordgo.Session, m *discordgo.MessageCreate, value string, competition *models.Competition) {
+	schedule := value
+	if strings.EqualFold(value, "default") {
+		schedule = ""
+	} else if _, _, ok := models.ParseScheduleTime(value); !ok {
+		err := errors.NewValidationError("INVALID_SCHEDULE_TIME",
+			fmt.Sprintf("Invalid schedule time: %s", value),
+			constants.MsgCompetitionScheduleInvalid)
+		errors.HandleDiscordError(s, m.ChannelID, err)
+		return
+	}
+
+	if err := ch.commandHandler.deps.Storage.UpdateCompetitionSchedule(schedule); err != nil {
+		utils.NewErrorHandlerFactory(s, m.ChannelID).System().HandleCompetitionUpdateFailed(err)
+		return
+	}
+
+	updated := *competition
+	updated.ScoreboardTime = schedule
+	ch.commandHandler.recordAudit(s, m, models.AuditEntry{
+		CompetitionID: competition.ID,
+		Action:        models.AuditActionCompetitionSchedule,
+		Target:        competition.Name,
+		Before:        formatCompetitionSchedule(*competition),
+		After:         formatCompetitionSchedule(updated),
+	})
+
+	errors.SendDiscordSuccess(s, m.ChannelID, fmt.Sprintf(constants.MsgCompetitionUpdateSuccess, "스코어보드 발송 시각"))
+}
+
+// findCompetition ID 또는 대회명(대소문자 무시)으로 대회를 찾습니다
+func findCompetition(competitions []models.Competition, key string) (models.Competition, bool) {
+	for _, competition := range competitions {
+		if competition.ID == key {
+			return competition, true
+		}
+	}
+	for _, competition := range competitions {
+		if strings.EqualFold(competition.Name, key) {
+			return competition, true
+		}
+	}
+	return models.Competition{}, false
+}
+
+// formatCompetitionChannel 대회 채널을 디스코드 채널 멘션으로 표시합니다
+func formatCompetitionChannel(competition models.Competition) string {
+	if competition.ChannelID == "" {
+		return constants.MsgCompetitionDefaultChannel
+	}
+	return fmt.Sprintf("<#%s>", competition.ChannelID)
+}
+
+// formatCompetitionSchedule 대회의 일일 스코어보드 발송 시각을 표시합니다
+func formatCompetitionSchedule(competition models.Competition) string {
+	if competition.ScoreboardTime == "" {
+		return constants.MsgCompetitionDefaultSchedule
+	}
+	return competition.ScoreboardTime
+}
@@ -0,0 +1,226 @@
+package bot
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/ssugameworks/kkemi/constants"
+	"github.com/ssugameworks/kkemi/errors"
+	"github.com/ssugameworks/kkemi/models"
+	"github.com/ssugameworks/kkemi/utils"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+// handleCompetitionEligibility 대회 참가 자격 요건을 조회/적용/초기화합니다
+func (ch *CompetitionHandler) handleCompetitionEligibility(s *discordgo.Session, m *discordgo.MessageCreate, params []string) {
+	action := "show"
+	if len(params) > 0 {
+		action = params[0]
+	}
+
+	switch action {
+	case "show":
+		ch.handleEligibilityShow(s, m)
+	case "set":
+		ch.handleEligibilitySet(s, m)
+	case "reset":
+		ch.handleEligibilityReset(s, m)
+	default:
+		utils.NewErrorHandlerFactory(s, m.ChannelID).Validation().HandleInvalidParams("ELIGIBILITY_INVALID_PARAMS",
+			fmt.Sprintf("Unknown eligibility action: %s", action),
+			constants.MsgEligibilityUsage)
+	}
+}
+
+// handleEligibilityShow 현재 대회에 적용 중인 참가 자격을 요약과 JSON으로 보여줍니다
+func (ch *CompetitionHandler) handleEligibilityShow(s *discordgo.Session, m *discordgo.MessageCreate) {
+	competition := ch.commandHandler.deps.Storage.GetCompetition()
+	if competition == nil {
+		utils.NewErrorHandlerFactory(s, m.ChannelID).Data().HandleNoActiveCompetition()
+		return
+	}
+
+	source := constants.MsgEligibilitySourceBase
+	if competition.Eligibility != nil {
+		source = constants.MsgEligibilitySourceComp
+	}
+	policy := competition.EffectiveEligibility()
+
+	policyJSON, err := json.MarshalIndent(policy, "", "  ")
+	if err != nil {
+		errors.HandleDiscordError(s, m.ChannelID, errors.NewSystemError("ELIGIBILITY_ENCODE_FAILED", "Failed to encode eligibility policy", err))
+		return
+	}
+
+	embed := &discordgo.MessageEmbed{
+		Title:       fmt.Sprintf(constants.MsgEligibilityTitle, competition.Name),
+		Description: fmt.Sprintf("%s\n%s\n```json\n%s\n```", source, ch.commandHandler.formatEligibilitySummary(policy), string(policyJSON)),
+		Color:       constants.ColorTierGold,
+	}
+	if _, err := s.ChannelMessageSendEmbed(m.ChannelID, embed); err != nil {
+		utils.Error("DISCORD API ERROR: Failed to send eligibility policy: %v", err)
+	}
+}
+
+// handleEligibilitySet 메시지의 JSON 요건을 검증한 뒤 활성 대회에 적용합니다
+func (ch *CompetitionHandler) handleEligibilitySet(s *discordgo.Session, m *discordgo.MessageCreate) {
+	errorHandlers := utils.NewErrorHandlerFactory(s, m.ChannelID)
+	competition := ch.commandHandler.deps.Storage.GetCompetition()
+	if competition == nil {
+		errorHandlers.Data().HandleNoActiveCompetition()
+		return
+	}
+
+	rawJSON := extractJSONObject(m.Content)
+	if rawJSON == "" {
+		errors.HandleDiscordError(s, m.ChannelID, errors.NewValidationError("ELIGIBILITY_JSON_MISSING",
+			"Eligibility JSON not found in message", constants.MsgEligibilityNoJSON))
+		return
+	}
+	policy, err := models.ParseEligibilityPolicy([]byte(rawJSON))
+	if err != nil {
+		errors.HandleDiscordError(s, m.ChannelID, errors.NewValidationError("ELIGIBILITY_INVALID",
+			fmt.Sprintf("Invalid eligibility policy: %v", err),
+			fmt.Sprintf(constants.MsgEligibilityInvalid, err.Error())))
+		return
+	}
+
+	// 단체 ID 오타로 아무도 등록하지 못하는 일이 없도록 solved.ac에서 단체가 있는지 확인합니다
+	for _, id := range policy.OrganizationIDs {
+		if _, err := ch.commandHandler.deps.APIClient.GetOrganization(context.Background(), id); err != nil {
+			errors.HandleDiscordError(s, m.ChannelID, errors.NewValidationError("ELIGIBILITY_UNKNOWN_ORGANIZATION",
+				fmt.Sprintf("Organization %d not found: %v", id, err),
+				fmt.Sprintf(constants.MsgEligibilityUnknownOrg, id)))
+			return
+		}
+	}
+
+	if err := ch.commandHandler.deps.Storage.UpdateCompetitionEligibility(policy); err != nil {
+		errorHandlers.System().HandleCompetitionUpdateFailed(err)
+		return
+	}
+	ch.recordEligibilityAudit(s, m, competition, policy)
+
+	errors.SendDiscordSuccess(s, m.ChannelID, fmt.Sprintf(constants.MsgEligibilitySetSuccess, ch.commandHandler.formatEligibilitySummary(policy)))
+}
+
+// handleEligibilityReset 대회 전용 요건을 제거하고 기본 요건으로 되돌립니다
+func (ch *CompetitionHandler) handleEligibilityReset(s *discordgo.Session, m *discordgo.MessageCreate) {
+	competition := ch.commandHandler.deps.Storage.GetCompetition()
+	if competition == nil {
+		utils.NewErrorHandlerFactory(s, m.ChannelID).Data().HandleNoActiveCompetition()
+		return
+	}
+
+	if err := ch.commandHandler.deps.Storage.UpdateCompetitionEligibility(nil); err != nil {
+		utils.NewErrorHandlerFactory(s, m.ChannelID).System().HandleCompetitionUpdateFailed(err)
+		return
+	}
+	ch.recordEligibilityAudit(s, m, competition, nil)
+
+	errors.SendDiscordSuccess(s, m.ChannelID, fmt.Sprintf(constants.MsgEligibilityReset,
+		ch.commandHandler.formatEligibilitySummary(models.DefaultEligibilityPolicy())))
+}
+
+// recordEligibilityAudit 참가 자격 변경을 감사 로그에 남깁니다
+func (ch *CompetitionHandler) recordEligibilityAudit(s *discordgo.Session, m *discordgo.MessageCreate, competition *models.Competition, policy *models.EligibilityPolicy) {
+	ch.commandHandler.recordAudit(s, m, models.AuditEntry{
+		CompetitionID: competition.ID,
+		Action:        models.AuditActionCompetitionEligibility,
+		Target:        competition.Name,
+		Before:        auditEligibility(competition.Eligibility),
+		After:         auditEligibility(policy),
+	})
+}
+
+// auditEligibility 감사 로그에 남길 참가 자격 요약 (nil이면 기본 요건)
+func auditEligibility(policy *models.EligibilityPolicy) string {
+	if policy == nil {
+		return constants.MsgAuditDefaultEligibility
+	}
+	encoded, err := json.Marshal(policy)
+	if err != nil {
+		return constants.MsgAuditNoValue
+	}
+	return string(encoded)
+}
+
+// formatEligibilitySummary 참가 자격 요건을 한 줄씩 요약합니다
+func (handler *CommandHandler) formatEligibilitySummary(policy *models.EligibilityPolicy) string {
+	tierManager := models.GetTierManager()
+
+	var lines []string
+	if len(policy.OrganizationIDs) > 0 {
+		lines = append(lines, fmt.Sprintf(constants.MsgEligibilitySummaryOrgs, handler.organizationLabels(policy.OrganizationIDs)))
+	}
+	if policy.MinTier > 0 {
+		lines = append(lines, fmt.Sprintf(constants.MsgEligibilitySummaryMinTier, tierManager.GetTierName(policy.MinTier)))
+	}
+	if policy.MaxTier > 0 {
+		lines = append(lines, fmt.Sprintf(constants.MsgEligibilitySummaryMaxTier, tierManager.GetTierName(policy.MaxTier)))
+	}
+	if policy.RequiredClass > 0 {
+		lines = append(lines, fmt.Sprintf(constants.MsgEligibilitySummaryClass, policy.RequiredClass))
+	}
+	if policy.MinSolvedCount > 0 {
+		lines = append(lines, fmt.Sprintf(constants.MsgEligibilitySummarySolved, policy.MinSolvedCount))
+	}
+	if policy.MinAccountAgeDays > 0 {
+		lines = append(lines, fmt.Sprintf(constants.MsgEligibilitySummaryAge, policy.MinAccountAgeDays))
+	}
+	if policy.RequireRoster {
+		lines = append(lines, constants.MsgEligibilitySummaryRoster)
+	}
+	if len(lines) == 0 {
+		return constants.MsgEligibilitySummaryNone
+	}
+	return strings.Join(lines, "\n")
+}
+
+// formatEligibilityViolations 충족하지 못한 요건마다 거절 사유를 한 줄씩 만듭니다
+func (handler *CommandHandler) formatEligibilityViolations(policy *models.EligibilityPolicy, profile models.EligibilityProfile, violations []string, name string, now time.Time) string {
+	tierManager := models.GetTierManager()
+
+	lines := make([]string, 0, len(violations))
+	for _, violation := range violations {
+		switch violation {
+		case models.EligibilityRuleOrganization:
+			lines = append(lines, fmt.Sprintf(constants.MsgEligibilityOrganization, handler.organizationLabels(policy.OrganizationIDs)))
+		case models.EligibilityRuleMinTier:
+			lines = append(lines, fmt.Sprintf(constants.MsgEligibilityMinTier, tierManager.GetTierName(policy.MinTier), tierManager.GetTierName(profile.Tier)))
+		case models.EligibilityRuleMaxTier:
+			lines = append(lines, fmt.Sprintf(constants.MsgEligibilityMaxTier, tierManager.GetTierName(policy.MaxTier), tierManager.GetTierName(profile.Tier)))
+		case models.EligibilityRuleClass:
+			lines = append(lines, fmt.Sprintf(constants.MsgEligibilityClass, policy.RequiredClass, profile.Class))
+		case models.EligibilityRuleSolvedCount:
+			lines = append(lines, fmt.Sprintf(constants.MsgEligibilitySolvedCount, policy.MinSolvedCount, profile.SolvedCount))
+		case models.EligibilityRuleAccountAge:
+			if profile.JoinedAt.IsZero() {
+				lines = append(lines, fmt.Sprintf(constants.MsgEligibilityAccountAgeUnknown, policy.MinAccountAgeDays))
+			} else {
+				lines = append(lines, fmt.Sprintf(constants.MsgEligibilityAccountAge, policy.MinAccountAgeDays, models.AccountAgeDays(profile.JoinedAt, now)))
+			}
+		case models.EligibilityRuleRoster:
+			lines = append(lines, fmt.Sprintf(constants.MsgEligibilityRoster, name))
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+// organizationLabels 단체 ID 목록을 solved.ac 단체 이름으로 바꿉니다 (조회 실패 시 #ID)
+func (handler *CommandHandler) organizationLabels(organizationIDs []int) string {
+	labels := make([]string, 0, len(organizationIDs))
+	for _, id := range organizationIDs {
+		organization, err := handler.deps.APIClient.GetOrganization(context.Background(), id)
+		if err != nil || organization == nil || organization.Name == "" {
+			labels = append(labels, fmt.Sprintf("#%d", id))
+			continue
+		}
+		labels = append(labels, organization.Name)
+	}
+	return strings.Join(labels, ", ")
+}
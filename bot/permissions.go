@@ -0,0 +1,305 @@
+package bot
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/ssugameworks/kkemi/constants"
+	"github.com/ssugameworks/kkemi/errors"
+	"github.com/ssugameworks/kkemi/models"
+	"github.com/ssugameworks/kkemi/utils"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+// capabilityLabels 권한 목록에 표시할 권한 설명
+var capabilityLabels = map[models.Capability]string{
+	models.CapabilityManageCompetition:    constants.MsgCapabilityManageCompetition,
+	models.CapabilityRemoveParticipant:    constants.MsgCapabilityRemoveParticipant,
+	models.CapabilityViewCache:            constants.MsgCapabilityViewCache,
+	models.CapabilityViewHiddenScoreboard: constants.MsgCapabilityViewHiddenScoreboard,
+}
+
+// commandCapability 명령어 실행에 필요한 권한을 반환합니다 (권한이 필요 없으면 false)
+func commandCapability(command string, params []string) (models.Capability, bool) {
+	action := ""
+	if len(params) > 0 {
+		action = params[0]
+	}
+
+	switch command {
+	case "scoreboard", "스코어보드":
+		return models.CapabilityViewHiddenScoreboard, true
+	case "participants", "참가자", "audit", "감사로그", "link", "계정연결", "roster", "명단":
+		return models.CapabilityManageCompetition, true
+	case "remove", "삭제":
+		return models.CapabilityRemoveParticipant, true
+	case "cache", "캐시":
+		return models.CapabilityViewCache, true
+	case "competition", "대회":
+		if !isPublicCompetitionCommand(params) {
+			return models.CapabilityManageCompetition, true
+		}
+	case "season", "시즌":
+		if action == "create" || action == "delete" {
+			return models.CapabilityManageCompetition, true
+		}
+	case "team", "팀":
+		if action == "assign" || action == "unassign" || action == "delete" {
+			return models.CapabilityManageCompetition, true
+		}
+	}
+	return "", false
+}
+
+// authorizationGuild 권한을 확인할 서버를 반환합니다 (DM에서는 설정된 기본 서버)
+func (handler *CommandHandler) authorizationGuild(message *discordgo.MessageCreate) string {
+	if message.GuildID != "" {
+		return message.GuildID
+	}
+	return handler.deps.GuildID
+}
+
+// memberRoles 권한을 확인할 서버에서 작성자의 역할 목록을 가져옵니다
+func (handler *CommandHandler) memberRoles(session *discordgo.Session, guildID string, message *discordgo.MessageCreate) ([]string, bool) {
+	if message.GuildID == guildID && message.Member != nil {
+		return message.Member.Roles, true
+	}
+
+	member, err := session.GuildMember(guildID, message.Author.ID)
+	if err != nil || member == nil {
+		utils.Warn("Cannot get member information for %s in guild %s: %v", message.Author.Username, guildID, err)
+		return nil, false
+	}
+	return member.Roles, true
+}
+
+// isAdmin 사용자가 서버 관리자(소유자 또는 관리자 역할)인지 확인합니다 (DM에서는 설정된 기본 서버 기준)
+func (handler *CommandHandler) isAdmin(session *discordgo.Session, message *discordgo.MessageCreate) bool {
+	guildID := handler.authorizationGuild(message)
+	if guildID == "" {
+		utils.Info("User is in DM without a configured guild, no admin permissions")
+		return false
+	}
+
+	roles, ok := handler.memberRoles(session, guildID, message)
+	if !ok {
+		return false
+	}
+	return isGuildAdmin(session, guildID, message.Author.ID, roles)
+}
+
+// isGuildAdmin 서버 소유자이거나 관리자(ADMINISTRATOR) 권한이 있는 역할을 가졌는지 확인합니다
+func isGuildAdmin(session *discordgo.Session, guildID, userID string, roleIDs []string) bool {
+	guild, err := session.State.Guild(guildID)
+	if err != nil || guild == nil {
+		utils.Warn("Cannot get guild information: %v", err)
+		return false
+	}
+
+	if userID == guild.OwnerID {
+		return true
+	}
+
+	for _, roleID := range roleIDs {
+		role, err := session.State.Role(guildID, roleID)
+		if err != nil {
+			utils.Warn("Cannot get role %s: %v", roleID, err)
+			continue
+		}
+		if role.Permissions&discordgo.PermissionAdministrator != 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// hasCapability 사용자가 권한을 가졌는지 확인합니다 (서버 관리자는 모든 권한을 가짐)
+func (handler *CommandHandler) hasCapability(session *discordgo.Session, message *discordgo.MessageCreate, capability models.Capability) bool {
+	guildID := handler.authorizationGuild(message)
+	if guildID == "" {
+		return false
+	}
+
+	roles, ok := handler.memberRoles(session, guildID, message)
+	if !ok {
+		return false
+	}
+	if isGuildAdmin(session, guildID, message.Author.ID, roles) {
+		return true
+	}
+
+	permissions, err := handler.deps.Storage.GetGuildPermissions(guildID)
+	if err != nil {
+		utils.Warn("Failed to load guild permissions for %s: %v", guildID, err)
+		return false
+	}
+	return permissions.Allows(capability, message.Author.ID, roles)
+}
+
+// authorizeCommand 명령어에 필요한 권한이 있는지 확인하고, 없으면 오류 메시지를 보냅니다
+func (handler *CommandHandler) authorizeCommand(session *discordgo.Session, message *discordgo.MessageCreate, command string, params []string) bool {
+	capability, required := commandCapability(command, params)
+	if !required || handler.hasCapability(session, message, capability) {
+		return true
+	}
+
+	utils.Warn("User %s attempted %s without %s permission", message.Author.Username, command, capability)
+	utils.NewErrorHandlerFactory(session, message.ChannelID).Validation().HandleInsufficientPermissions()
+	return false
+}
+
+// handlePermissions 권한 목록 조회와 역할/사용자별 권한 부여·회수를 처리합니다 (서버 관리자 전용)
+func (handler *CommandHandler) handlePermissions(session *discordgo.Session, message *discordgo.MessageCreate, params []string) {
+	errorHandlers := utils.NewErrorHandlerFactory(session, message.ChannelID)
+
+	// 권한 설정은 권한을 받은 역할이 아니라 서버 관리자만 바꿀 수 있습니다
+	if !handler.isAdmin(session, message) {
+		errorHandlers.Validation().HandleInsufficientPermissions()
+		return
+	}
+	guildID := handler.authorizationGuild(message)
+
+	action := "list"
+	if len(params) > 0 {
+		action = params[0]
+	}
+
+	switch action {
+	case "list":
+		handler.handlePermissionList(session, message, guildID)
+	case "grant", "revoke":
+		handler.handlePermissionUpdate(session, message, guildID, action, params[1:])
+	default:
+		errorHandlers.Validation().HandleInvalidParams("PERMISSION_INVALID_PARAMS",
+			fmt.Sprintf("Unknown permission action: %s", action),
+			constants.MsgPermissionUsage)
+	}
+}
+
+// handlePermissionList 권한별로 부여된 역할과 사용자를 보여줍니다
+func (handler *CommandHandler) handlePermissionList(session *discordgo.Session, message *discordgo.MessageCreate, guildID string) {
+	permissions, err := handler.deps.Storage.GetGuildPermissions(guildID)
+	if err != nil {
+		utils.NewErrorHandlerFactory(session, message.ChannelID).System().HandleSystemError("PERMISSION_LOAD_FAILED",
+			"Failed to load guild permissions", constants.MsgPermissionLoadFailed, err)
+		return
+	}
+
+	var builder strings.Builder
+	for _, capability := range models.Capabilities {
+		targets := formatPermissionTargets(permissions.RoleIDs(capability), permissions.UserIDs(capability))
+		builder.WriteString(fmt.Sprintf(constants.MsgPermissionEntry, capabilityLabels[capability], capability, targets))
+	}
+
+	embed := &discordgo.MessageEmbed{
+		Title:       constants.MsgPermissionListTitle,
+		Description: builder.String(),
+		Color:       constants.ColorTierGold,
+		Footer:      &discordgo.MessageEmbedFooter{Text: constants.MsgPermissionListFooter},
+	}
+	if _, err := session.ChannelMessageSendEmbed(message.ChannelID, embed); err != nil {
+		utils.Error("DISCORD API ERROR: Failed to send permission list: %v", err)
+	}
+}
+
+// handlePermissionUpdate `grant|revoke <권한> <role|user> <ID|멘션>`으로 권한을 부여하거나 회수합니다
+func (handler *CommandHandler) handlePermissionUpdate(session *discordgo.Session, message *discordgo.MessageCreate, guildID, action string, params []string) {
+	errorHandlers := utils.NewErrorHandlerFactory(session, message.ChannelID)
+
+	if len(params) != 3 {
+		errorHandlers.Validation().HandleInvalidParams("PERMISSION_INVALID_PARAMS",
+			"Invalid permission parameters",
+			constants.MsgPermissionUsage)
+		return
+	}
+
+	capability, ok := models.ParseCapability(params[0])
+	if !ok {
+		errorHandlers.Validation().HandleInvalidParams("PERMISSION_INVALID_CAPABILITY",
+			fmt.Sprintf("Unknown capability: %s", params[0]),
+			fmt.Sprintf(constants.MsgPermissionInvalidCapability, params[0]))
+		return
+	}
+
+	target := params[1]
+	id := parseMentionID(params[2])
+	if (target != models.PermissionTargetRole && target != models.PermissionTargetUser) || id == "" {
+		errorHandlers.Validation().HandleInvalidParams("PERMISSION_INVALID_TARGET",
+			fmt.Sprintf("Invalid permission target: %s %s", target, params[2]),
+			constants.MsgPermissionInvalidTarget)
+		return
+	}
+
+	permissions, err := handler.deps.Storage.GetGuildPermissions(guildID)
+	if err != nil {
+		errorHandlers.System().HandleSystemError("PERMISSION_LOAD_FAILED",
+			"Failed to load guild permissions", constants.MsgPermissionLoadFailed, err)
+		return
+	}
+
+	mention := formatPermissionTarget(target, id)
+	if action == "grant" {
+		if !permissions.Grant(capability, target, id) {
+			errors.SendDiscordInfo(session, message.ChannelID, fmt.Sprintf(constants.MsgPermissionAlreadyGranted, mention, capability))
+			return
+		}
+	} else if !permissions.Revoke(capability, target, id) {
+		errors.SendDiscordInfo(session, message.ChannelID, fmt.Sprintf(constants.MsgPermissionNotGranted, mention, capability))
+		return
+	}
+
+	if err := handler.deps.Storage.SaveGuildPermissions(permissions); err != nil {
+		errorHandlers.System().HandleSystemError("PERMISSION_SAVE_FAILED",
+			"Failed to save guild permissions", constants.MsgPermissionUpdateFailed, err)
+		return
+	}
+
+	entry := models.AuditEntry{Action: models.AuditActionPermissionGrant, Target: mention, After: string(capability)}
+	response := fmt.Sprintf(constants.MsgPermissionGranted, mention, capability)
+	if action == "revoke" {
+		entry = models.AuditEntry{Action: models.AuditActionPermissionRevoke, Target: mention, Before: string(capability)}
+		response = fmt.Sprintf(constants.MsgPermissionRevoked, mention, capability)
+	}
+	handler.recordAudit(session, message, entry)
+	errors.SendDiscordSuccess(session, message.ChannelID, response)
+}
+
+// parseMentionID 역할/사용자 멘션(<@&ID>, <@!ID>, <@ID>) 또는 숫자 ID에서 ID를 꺼냅니다 (형식이 틀리면 빈 문자열)
+func parseMentionID(value string) string {
+	if strings.HasPrefix(value, "<@") && strings.HasSuffix(value, ">") {
+		value = strings.TrimPrefix(strings.TrimPrefix(strings.TrimSuffix(value[2:], ">"), "&"), "!")
+	}
+	if value == "" {
+		return ""
+	}
+	for _, r := range value {
+		if r < '0' || r > '9' {
+			return ""
+		}
+	}
+	return value
+}
+
+// formatPermissionTarget 권한 대상을 Discord 멘션으로 표시합니다
+func formatPermissionTarget(target, id string) string {
+	if target == models.PermissionTargetRole {
+		return "<@&" + id + ">"
+	}
+	return "<@" + id + ">"
+}
+
+// formatPermissionTargets 권한을 받은 역할과 사용자를 한 줄로 표시합니다
+func formatPermissionTargets(roleIDs, userIDs []string) string {
+	mentions := make([]string, 0, len(roleIDs)+len(userIDs))
+	for _, id := range roleIDs {
+		mentions = append(mentions, formatPermissionTarget(models.PermissionTargetRole, id))
+	}
+	for _, id := range userIDs {
+		mentions = append(mentions, formatPermissionTarget(models.PermissionTargetUser, id))
+	}
+	if len(mentions) == 0 {
+		return constants.MsgPermissionNone
+	}
+	return strings.Join(mentions, ", ")
+}
@@ -0,0 +1,58 @@
+package bot
+
+import (
+	"testing"
+
+	"github.com/ssugameworks/kkemi/models"
+)
+
+func TestCommandCapability(t *testing.T) {
+	tests := []struct {
+		command    string
+		params     []string
+		capability models.Capability
+		required   bool
+	}{
+		{"스코어보드", nil, models.CapabilityViewHiddenScoreboard, true},
+		{"participants", nil, models.CapabilityManageCompetition, true},
+		{"명단", []string{"add", "홍길동"}, models.CapabilityManageCompetition, true},
+		{"삭제", []string{"user"}, models.CapabilityRemoveParticipant, true},
+		{"cache", nil, models.CapabilityViewCache, true},
+		{"대회", []string{"create"}, models.CapabilityManageCompetition, true},
+		{"대회", nil, models.CapabilityManageCompetition, true},
+		{"대회", []string{"history"}, "", false},
+		{"competition", []string{"results", "1"}, "", false},
+		{"시즌", []string{"create", "2025"}, models.CapabilityManageCompetition, true},
+		{"시즌", []string{"list"}, "", false},
+		{"팀", []string{"assign", "a", "b"}, models.CapabilityManageCompetition, true},
+		{"팀", []string{"join", "a"}, "", false},
+		{"등록", []string{"name", "id"}, "", false},
+		{"권한", nil, "", false},
+	}
+
+	for _, test := range tests {
+		capability, required := commandCapability(test.command, test.params)
+		if capability != test.capability || required != test.required {
+			t.Errorf("%s %v: expected (%q, %t), got (%q, %t)",
+				test.command, test.params, test.capability, test.required, capability, required)
+		}
+	}
+}
+
+func TestParseMentionID(t *testing.T) {
+	tests := map[string]string{
+		"<@&123>":   "123",
+		"<@!456>":   "456",
+		"<@789>":    "789",
+		"101112":    "101112",
+		"<@&abc>":   "",
+		"@everyone": "",
+		"<@>":       "",
+	}
+
+	for input, expected := range tests {
+		if got := parseMentionID(input); got != expected {
+			t.Errorf("parseMentionID(%q): expected %q, got %q", input, expected, got)
+		}
+	}
+}
@@ -0,0 +1,93 @@
+package bot
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/ssugameworks/kkemi/constants"
+	"github.com/ssugameworks/kkemi/models"
+	"github.com/ssugameworks/kkemi/utils"
+)
+
+// sortProblemSetScores 문제 목록 대회 순위대로 정렬된 사본을 반환합니다 (점수, 해결 수, 아이디 순)
+func sortProblemSetScores(scores []models.ScoreData) []models.ScoreData {
+	sorted := make([]models.ScoreData, len(scores))
+	copy(sorted, scores)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		if sorted[i].RawScore != sorted[j].RawScore {
+			return sorted[i].RawScore > sorted[j].RawScore
+		}
+		if len(sorted[i].SolvedTargets) != len(sorted[j].SolvedTargets) {
+			return len(sorted[i].SolvedTargets) > len(sorted[j].SolvedTargets)
+		}
+		return sorted[i].BaekjoonID < sorted[j].BaekjoonID
+	})
+	return sorted
+}
+
+// formatProblemMatrix 참가자별 문제 해결 여부를 ICPC 스타일 표로 만듭니다 (리그 구분 없이 전체 순위)
+func formatProblemMatrix(problems []models.TargetProblem, scores []models.ScoreData) string {
+	columns := problems
+	if len(columns) > constants.MaxMatrixColumns {
+		columns = columns[:constants.MaxMatrixColumns]
+	}
+
+	var builder strings.Builder
+	builder.WriteString("```\n")
+	builder.WriteString(fmt.Sprintf("%-*s %-*s ", constants.ScoreboardRankWidth, "순위", constants.MatrixNameWidth, "아이디"))
+	for _, problem := range columns {
+		builder.WriteString(fmt.Sprintf("%-2s", problem.Label))
+	}
+	builder.WriteString(fmt.Sprintf("%*s\n", constants.ScoreboardScoreWidth, "점수"))
+	builder.WriteString(constants.ScoreboardSeparator + "\n")
+
+	solvedCounts := make(map[int]int, len(problems))
+	sorted := sortProblemSetScores(scores)
+	var lastRawScore float64 = -1.0
+	var rank int
+	for i, score := range sorted {
+		solved := make(map[int]bool, len(score.SolvedTargets))
+		for _, id := range score.SolvedTargets {
+			solved[id] = true
+			solvedCounts[id]++
+		}
+
+		if score.RawScore != lastRawScore {
+			rank = i + 1
+		}
+		lastRawScore = score.RawScore
+		if i >= constants.MaxMatrixRows {
+			continue
+		}
+
+		builder.WriteString(fmt.Sprintf("%-*d  %-*s ",
+			constants.ScoreboardRankWidth, rank,
+			constants.MatrixNameWidth, utils.TruncateString(score.BaekjoonID, constants.MatrixNameWidth)))
+		for _, problem := range columns {
+			mark := "."
+			if solved[problem.ProblemID] {
+				mark = "O"
+			}
+			builder.WriteString(fmt.Sprintf("%-2s", mark))
+		}
+		builder.WriteString(fmt.Sprintf("%*.0f\n", constants.ScoreboardScoreWidth, score.Score))
+	}
+	if len(sorted) > constants.MaxMatrixRows {
+		builder.WriteString(fmt.Sprintf(constants.MsgProblemSetMoreRows, len(sorted)-constants.MaxMatrixRows) + "\n")
+	}
+	builder.WriteString("```\n")
+
+	// 문제별 해결 인원
+	counts := make([]string, 0, len(problems))
+	for _, problem := range problems {
+		counts = append(counts, fmt.Sprintf("%s %d", problem.Label, solvedCounts[problem.ProblemID]))
+	}
+	builder.WriteString(fmt.Sprintf(constants.MsgProblemSetSolvedCounts, strings.Join(counts, " · ")))
+	if len(problems) > len(columns) {
+		builder.WriteString("\n" + fmt.Sprintf(constants.MsgProblemSetMoreColumns, len(problems)-len(columns)))
+	}
+	builder.WriteString("\n")
+
+	return builder.String()
+}
@@ -0,0 +1,197 @@
+package bot
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/ssugameworks/kkemi/constants"
+	"github.com/ssugameworks/kkemi/errors"
+	"github.com/ssugameworks/kkemi/models"
+	"github.com/ssugameworks/kkemi/utils"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+// handleCompetitionProblems 문제 목록 대회의 채점 대상 문제를 조회/설정/가져오기/초기화합니다
+func (ch *CompetitionHandler) handleCompetitionProblems(s *discordgo.Session, m *discordgo.MessageCreate, params []string) {
+	errorHandlers := utils.NewErrorHandlerFactory(s, m.ChannelID)
+
+	competition := ch.commandHandler.deps.Storage.GetCompetition()
+	if competition == nil {
+		errorHandlers.Data().HandleNoActiveCompetition()
+		return
+	}
+
+	action := "show"
+	if len(params) > 0 {
+		action = params[0]
+	}
+
+	switch action {
+	case "show":
+		ch.handleProblemsShow(s, m, competition)
+	case "set":
+		problemIDs, points, err := parseProblemSpecs(params[1:])
+		if err != nil {
+			errorHandlers.Validation().HandleInvalidParams("PROBLEMS_INVALID_PARAMS",
+				fmt.Sprintf("Invalid problem list: %v", err),
+				fmt.Sprintf(constants.MsgProblemSetInvalid, err.Error()))
+			return
+		}
+		ch.saveProblemSet(s, m, models.NewTargetProblems(problemIDs, points))
+	case "query":
+		ch.handleProblemsQuery(s, m, strings.Join(params[1:], " "))
+	case "clear":
+		if err := ch.commandHandler.deps.Storage.UpdateCompetitionProblems(nil); err != nil {
+			errorHandlers.System().HandleCompetitionUpdateFailed(err)
+			return
+		}
+		ch.recordProblemsAudit(s, m, competition, nil)
+		errors.SendDiscordSuccess(s, m.ChannelID, constants.MsgProblemSetCleared)
+	default:
+		errorHandlers.Validation().HandleInvalidParams("PROBLEMS_INVALID_PARAMS",
+			fmt.Sprintf("Unknown problems action: %s", action),
+			constants.MsgProblemSetUsage)
+	}
+}
+
+// handleProblemsShow 현재 문제 목록을 열 이름, 문제 번호, 배점 순으로 보여줍니다
+func (ch *CompetitionHandler) handleProblemsShow(s *discordgo.Session, m *discordgo.MessageCreate, competition *models.Competition) {
+	if !competition.IsProblemSet() {
+		errors.SendDiscordInfo(s, m.ChannelID, constants.MsgProblemSetEmpty)
+		return
+	}
+
+	var builder strings.Builder
+	for _, problem := range competition.Problems {
+		builder.WriteString(fmt.Sprintf("%-3s %-6d %5.1f점\n", problem.Label, problem.ProblemID, problem.Points))
+	}
+
+	embed := &discordgo.MessageEmbed{
+		Title:       fmt.Sprintf(constants.MsgProblemSetTitle, len(competition.Problems)),
+		Description: "```\n" + builder.String() + "```",
+		Color:       constants.ColorTierGold,
+	}
+	if _, err := s.ChannelMessageSendEmbed(m.ChannelID, embed); err != nil {
+		utils.Error("DISCORD API ERROR: Failed to send problem set: %v", err)
+	}
+}
+
+// handleProblemsQuery solved.ac 검색 쿼리 결과로 문제 목록을 만듭니다 (문제 번호 오름차순, 기본 배점)
+func (ch *CompetitionHandler) handleProblemsQuery(s *discordgo.Session, m *discordgo.MessageCreate, query string) {
+	errorHandlers := utils.NewErrorHandlerFactory(s, m.ChannelID)
+
+	if strings.TrimSpace(query) == "" {
+		errorHandlers.Validation().HandleInvalidParams("PROBLEMS_QUERY_EMPTY",
+			"Empty problem search query",
+			constants.MsgProblemSetUsage)
+		return
+	}
+
+	ctx := context.Background()
+	var problemIDs []int
+	for page := 1; len(problemIDs) < constants.MaxProblemSetSize; page++ {
+		result, err := ch.commandHandler.deps.APIClient.SearchProblems(ctx, query, page)
+		if err != nil {
+			errorHandlers.System().HandleSystemError("PROBLEMS_QUERY_FAILED",
+				fmt.Sprintf("Failed to search problems: %s", query),
+				constants.MsgProblemSetQueryFailed, err)
+			return
+		}
+		for _, problem := range result.Items {
+			problemIDs = append(problemIDs, problem.ProblemID)
+		}
+		if len(result.Items) == 0 || len(problemIDs) >= result.Count {
+			break
+		}
+	}
+
+	if len(problemIDs) == 0 {
+		errorHandlers.Validation().HandleInvalidParams("PROBLEMS_QUERY_NO_RESULT",
+			fmt.Sprintf("No problems found for query: %s", query),
+			fmt.Sprintf(constants.MsgProblemSetQueryEmpty, query))
+		return
+	}
+	if len(problemIDs) > constants.MaxProblemSetSize {
+		problemIDs = problemIDs[:constants.MaxProblemSetSize]
+	}
+
+	points := make([]float64, len(problemIDs))
+	for i := range points {
+		points[i] = constants.DefaultProblemPoints
+	}
+	ch.saveProblemSet(s, m, models.NewTargetProblems(problemIDs, points))
+}
+
+// saveProblemSet 문제 목록을 활성 대회에 저장하고 결과를 알립니다
+func (ch *CompetitionHandler) saveProblemSet(s *discordgo.Session, m *discordgo.MessageCreate, problems []models.TargetProblem) {
+	competition := ch.commandHandler.deps.Storage.GetCompetition()
+	if competition == nil {
+		utils.NewErrorHandlerFactory(s, m.ChannelID).Data().HandleNoActiveCompetition()
+		return
+	}
+
+	if err := ch.commandHandler.deps.Storage.UpdateCompetitionProblems(problems); err != nil {
+		utils.NewErrorHandlerFactory(s, m.ChannelID).System().HandleCompetitionUpdateFailed(err)
+		return
+	}
+	ch.recordProblemsAudit(s, m, competition, problems)
+
+	labels := make([]string, 0, len(problems))
+	for _, problem := range problems {
+		labels = append(labels, fmt.Sprintf("%s=%d", problem.Label, problem.ProblemID))
+	}
+	errors.SendDiscordSuccess(s, m.ChannelID,
+		fmt.Sprintf(constants.MsgProblemSetSaved, len(problems), utils.TruncateString(strings.Join(labels, " "), constants.MaxRulesJSONLength)))
+}
+
+// recordProblemsAudit 문제 목록 변경을 감사 로그에 남깁니다
+func (ch *CompetitionHandler) recordProblemsAudit(s *discordgo.Session, m *discordgo.MessageCreate, competition *models.Competition, problems []models.TargetProblem) {
+	ch.commandHandler.recordAudit(s, m, models.AuditEntry{
+		CompetitionID: competition.ID,
+		Action:        models.AuditActionCompetitionProblems,
+		Target:        competition.Name,
+		Before:        auditProblems(competition.Problems),
+		After:         auditProblems(problems),
+	})
+}
+
+// parseProblemSpecs `<문제번호>[:배점]` 목록을 문제 번호와 배점으로 해석합니다
+func parseProblemSpecs(specs []string) ([]int, []float64, error) {
+	if len(specs) == 0 {
+		return nil, nil, fmt.Errorf("문제 번호를 하나 이상 입력해주세요")
+	}
+	if len(specs) > constants.MaxProblemSetSize {
+		return nil, nil, fmt.Errorf("문제는 최대 %d개까지 지정할 수 있습니다", constants.MaxProblemSetSize)
+	}
+
+	problemIDs := make([]int, 0, len(specs))
+	points := make([]float64, 0, len(specs))
+	seen := make(map[int]bool, len(specs))
+	for _, spec := range specs {
+		idPart, pointsPart, hasPoints := strings.Cut(spec, ":")
+
+		id, err := strconv.Atoi(idPart)
+		if err != nil || id <= 0 {
+			return nil, nil, fmt.Errorf("잘못된 문제 번호: %s", idPart)
+		}
+		if seen[id] {
+			return nil, nil, fmt.Errorf("중복된 문제 번호: %d", id)
+		}
+		seen[id] = true
+
+		value := constants.DefaultProblemPoints
+		if hasPoints {
+			value, err = strconv.ParseFloat(pointsPart, 64)
+			if err != nil || value <= 0 {
+				return nil, nil, fmt.Errorf("잘못된 배점: %s", spec)
+			}
+		}
+
+		problemIDs = append(problemIDs, id)
+		points = append(points, value)
+	}
+	return problemIDs, points, nil
+}
@@ -0,0 +1,55 @@
+package bot
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/ssugameworks/kkemi/constants"
+	"github.com/ssugameworks/kkemi/models"
+)
+
+func TestParseProblemSpecs(t *testing.T) {
+	ids, points, err := parseProblemSpecs([]string{"1000", "2557:3", "1001:0.5"})
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if len(ids) != 3 || ids[1] != 2557 || points[0] != constants.DefaultProblemPoints || points[1] != 3 || points[2] != 0.5 {
+		t.Errorf("Unexpected parse result: %v %v", ids, points)
+	}
+
+	invalid := [][]string{
+		{},
+		{"abc"},
+		{"1000:-1"},
+		{"1000", "1000:2"},
+	}
+	for _, specs := range invalid {
+		if _, _, err := parseProblemSpecs(specs); err == nil {
+			t.Errorf("Expected error for %v", specs)
+		}
+	}
+}
+
+func TestFormatProblemMatrix(t *testing.T) {
+	problems := models.NewTargetProblems([]int{1000, 2000, 3000}, []float64{1, 1, 1})
+	scores := []models.ScoreData{
+		{BaekjoonID: "bravo", Score: 1, RawScore: 1, SolvedTargets: []int{2000}},
+		{BaekjoonID: "alpha", Score: 2, RawScore: 2, SolvedTargets: []int{1000, 3000}},
+	}
+
+	matrix := formatProblemMatrix(problems, scores)
+	lines := strings.Split(matrix, "\n")
+
+	if !strings.Contains(lines[1], "A B C") {
+		t.Errorf("Expected problem labels in header, got %q", lines[1])
+	}
+	if !strings.Contains(lines[3], "alpha") || !strings.Contains(lines[3], "O . O") {
+		t.Errorf("Expected alpha first with A and C solved, got %q", lines[3])
+	}
+	if !strings.Contains(lines[4], "bravo") || !strings.Contains(lines[4], ". O .") {
+		t.Errorf("Expected bravo second with B solved, got %q", lines[4])
+	}
+	if !strings.Contains(matrix, "A 1 · B 1 · C 1") {
+		t.Errorf("Expected per-problem solve counts, got %q", matrix)
+	}
+}
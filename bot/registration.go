@@ -0,0 +1,166 @@
+package bot
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/ssugameworks/kkemi/api"
+	"github.com/ssugameworks/kkemi/constants"
+	"github.com/ssugameworks/kkemi/errors"
+	"github.com/ssugameworks/kkemi/models"
+	"github.com/ssugameworks/kkemi/utils"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+// issueRegistrationChallenge 검증을 통과한 등록·백준 ID 변경 신청에 본인 확인 토큰을 발급합니다 (같은 사용자의 이전 토큰은 교체)
+func (handler *CommandHandler) issueRegistrationChallenge(session *discordgo.Session, message *discordgo.MessageCreate, challenge models.RegistrationChallenge) {
+	token, err := newRegistrationToken()
+	if err != nil {
+		utils.NewErrorHandlerFactory(session, message.ChannelID).System().HandleSystemError("REGISTER_TOKEN_FAILED",
+			"Failed to generate registration token", constants.MsgRegisterChallengeFailed, err)
+		return
+	}
+
+	now := utils.GetCurrentTimeKST()
+	challenge.DiscordUserID = message.Author.ID
+	challenge.Token = token
+	challenge.CreatedAt = now
+	challenge.ExpiresAt = now.Add(constants.RegistrationTokenTTL)
+	if err := handler.deps.Storage.SaveRegistrationChallenge(challenge); err != nil {
+		utils.NewErrorHandlerFactory(session, message.ChannelID).System().HandleSystemError("REGISTER_CHALLENGE_SAVE_FAILED",
+			"Failed to save registration challenge", constants.MsgRegisterChallengeFailed, err)
+		return
+	}
+
+	utils.Info("Issued registration challenge for %s (%s) to discord user %s", challenge.Name, challenge.BaekjoonID, message.Author.ID)
+	response := constants.MsgRegisterChallengeIssued
+	if challenge.ReplacesBaekjoonID != "" {
+		response = constants.MsgHandleChangeChallengeIssued
+	}
+	errors.SendDiscordInfo(session, message.ChannelID, fmt.Sprintf(response,
+		challenge.BaekjoonID, token, utils.FormatDateTime(challenge.ExpiresAt)))
+}
+
+// handleVerify solved.ac 자기소개에 본인 확인 토큰이 들어 있으면 등록 또는 백준 ID 변경을 완료합니다 (`!인증`)
+func (handler *CommandHandler) handleVerify(session *discordgo.Session, message *discordgo.MessageCreate) {
+	errorHandlers := utils.NewErrorHandlerFactory(session, message.ChannelID)
+
+	if !handler.validateCompetitionStatus(errorHandlers) {
+		return
+	}
+
+	challenge, err := handler.deps.Storage.GetRegistrationChallenge(message.Author.ID)
+	if err != nil {
+		errorHandlers.System().HandleSystemError("REGISTER_CHALLENGE_LOAD_FAILED",
+			"Failed to load registration challenge", constants.MsgRegisterChallengeFailed, err)
+		return
+	}
+	if challenge == nil {
+		errors.SendDiscordInfo(session, message.ChannelID, constants.MsgVerifyNoChallenge)
+		return
+	}
+
+	if challenge.IsExpired(utils.GetCurrentTimeKST()) {
+		handler.deleteRegistrationChallenge(message.Author.ID)
+		errors.SendDiscordInfo(session, message.ChannelID, constants.MsgVerifyExpired)
+		return
+	}
+
+	// 자기소개를 방금 바꿨을 수 있으므로 캐시를 거치지 않고 조회합니다
+	userInfo, err := handler.fetchFreshUserInfo(challenge.BaekjoonID)
+	if err != nil {
+		errorHandlers.API().HandleBaekjoonUserNotFound(challenge.BaekjoonID, err)
+		return
+	}
+	if !challenge.IsSatisfiedBy(userInfo.Bio) {
+		errors.SendDiscordInfo(session, message.ChannelID, fmt.Sprintf(constants.MsgVerifyTokenMissing,
+			challenge.BaekjoonID, challenge.Token, utils.FormatDateTime(challenge.ExpiresAt)))
+		return
+	}
+
+	if challenge.ReplacesBaekjoonID != "" {
+		handler.completeHandleChange(session, message, challenge)
+		return
+	}
+
+	// 토큰 발급 후 다른 참가자로 등록되었을 수 있으므로 다시 확인합니다
+	if linked, found := models.FindParticipantByDiscordUser(handler.deps.Storage.GetParticipants(), message.Author.ID); found {
+		handler.deleteRegistrationChallenge(message.Author.ID)
+		errorHandlers.Validation().HandleInvalidParams("REGISTER_ALREADY_LINKED",
+			fmt.Sprintf("Discord user %s is already registered as %s", message.Author.ID, linked.BaekjoonID),
+			fmt.Sprintf(constants.MsgRegisterAlreadyLinked, linked.BaekjoonID))
+		return
+	}
+
+	if existing, exists := findParticipant(handler.deps.Storage.GetParticipants(), challenge.BaekjoonID); exists {
+		handler.claimParticipant(session, message, existing)
+		return
+	}
+
+	if !handler.registerParticipant(challenge.Name, challenge.BaekjoonID, message.Author.ID, userInfo, challenge.OrganizationID, errorHandlers) {
+		return
+	}
+	handler.deleteRegistrationChallenge(message.Author.ID)
+
+	handler.sendRegistrationSuccess(session, message.ChannelID, challenge.Name, userInfo)
+	errors.SendDiscordInfo(session, message.ChannelID, constants.MsgVerifyCleanup)
+}
+
+// claimParticipant 계정 연결 전에 등록한 참가자를 본인 확인이 끝난 디스코드 계정과 연결합니다
+func (handler *CommandHandler) claimParticipant(session *discordgo.Session, message *discordgo.MessageCreate, participant models.Participant) {
+	errorHandlers := utils.NewErrorHandlerFactory(session, message.ChannelID)
+
+	// 토큰 발급 후 관리자가 다른 계정과 연결했을 수 있으므로 다시 확인합니다
+	handler.deleteRegistrationChallenge(message.Author.ID)
+	if participant.DiscordUserID != "" {
+		errorHandlers.Data().HandleParticipantAlreadyExists(participant.BaekjoonID)
+		return
+	}
+	if err := handler.deps.Storage.LinkDiscordUser(participant.BaekjoonID, message.Author.ID); err != nil {
+		errorHandlers.System().HandleSystemError("REGISTER_LINK_FAILED",
+			"Failed to link existing participant", constants.MsgRegisterChallengeFailed, err)
+		return
+	}
+
+	entry := models.AuditEntry{
+		Action: models.AuditActionParticipantLink,
+		Target: participant.BaekjoonID,
+		Before: formatOptionalDiscordUser(""),
+		After:  formatOptionalDiscordUser(message.Author.ID),
+	}
+	if competition := handler.deps.Storage.GetCompetition(); competition != nil {
+		entry.CompetitionID = competition.ID
+	}
+	handler.recordAudit(session, message, entry)
+
+	errors.SendDiscordSuccess(session, message.ChannelID, fmt.Sprintf(constants.MsgVerifyLinked, participant.Name, participant.BaekjoonID))
+	errors.SendDiscordInfo(session, message.ChannelID, constants.MsgVerifyCleanup)
+}
+
+// fetchFreshUserInfo 캐시를 사용하는 클라이언트라면 캐시를 건너뛰고 사용자 정보를 조회합니다
+func (handler *CommandHandler) fetchFreshUserInfo(baekjoonID string) (*api.UserInfo, error) {
+	ctx := context.Background()
+	if cachedClient, ok := handler.deps.APIClient.(*api.CachedSolvedACClient); ok {
+		return cachedClient.RefreshUserInfo(ctx, baekjoonID)
+	}
+	return handler.deps.APIClient.GetUserInfo(ctx, baekjoonID)
+}
+
+// deleteRegistrationChallenge 사용이 끝난 본인 확인 토큰을 지웁니다 (실패해도 만료되면 다시 발급 가능)
+func (handler *CommandHandler) deleteRegistrationChallenge(discordUserID string) {
+	if err := handler.deps.Storage.DeleteRegistrationChallenge(discordUserID); err != nil {
+		utils.Warn("Failed to delete registration challenge for %s: %v", discordUserID, err)
+	}
+}
+
+// newRegistrationToken 추측할 수 없는 일회용 본인 확인 토큰을 만듭니다
+func newRegistrationToken() (string, error) {
+	buffer := make([]byte, constants.RegistrationTokenBytes)
+	if _, err := rand.Read(buffer); err != nil {
+		return "", err
+	}
+	return constants.RegistrationTokenPrefix + hex.EncodeToString(buffer), nil
+}
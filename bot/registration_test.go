@@ -0,0 +1,151 @@
+package bot
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ssugameworks/kkemi/api"
+	"github.com/ssugameworks/kkemi/constants"
+	"github.com/ssugameworks/kkemi/interfaces"
+	"github.com/ssugameworks/kkemi/models"
+	"github.com/ssugameworks/kkemi/storage"
+)
+
+func TestNewRegistrationToken(t *testing.T) {
+	first, err := newRegistrationToken()
+	if err != nil {
+		t.Fatalf("Failed to generate token: %v", err)
+	}
+	second, err := newRegistrationToken()
+	if err != nil {
+		t.Fatalf("Failed to generate token: %v", err)
+	}
+
+	if !strings.HasPrefix(first, constants.RegistrationTokenPrefix) {
+		t.Errorf("Expected token prefix %q, got %q", constants.RegistrationTokenPrefix, first)
+	}
+	if expected := len(constants.RegistrationTokenPrefix) + constants.RegistrationTokenBytes*2; len(first) != expected {
+		t.Errorf("Expected token length %d, got %d", expected, len(first))
+	}
+	if first == second {
+		t.Error("Expected tokens to differ")
+	}
+}
+
+func TestRegistrationChallengeStorage(t *testing.T) {
+	client := &MockSolvedACClient{userInfo: &api.UserInfo{Handle: "alice", Bio: "hello " + constants.RegistrationTokenPrefix + "abc"}}
+	store := storage.NewInMemoryStorage(client)
+	start := time.Now().AddDate(0, 0, -1)
+	competitionID, err := store.CreateCompetition("test", start, start.AddDate(0, 0, 7))
+	if err != nil {
+		t.Fatalf("Failed to create competition: %v", err)
+	}
+
+	challenge := models.RegistrationChallenge{
+		DiscordUserID: "7",
+		Name:          "앨리스",
+		BaekjoonID:    "alice",
+		Token:         constants.RegistrationTokenPrefix + "abc",
+		ExpiresAt:     time.Now().Add(constants.RegistrationTokenTTL),
+	}
+	if err := store.SaveRegistrationChallenge(challenge); err != nil {
+		t.Fatalf("Failed to save challenge: %v", err)
+	}
+
+	saved, err := store.ForCompetition(competitionID).GetRegistrationChallenge("7")
+	if err != nil || saved == nil || saved.BaekjoonID != "alice" {
+		t.Fatalf("Expected saved challenge for alice, got %+v (%v)", saved, err)
+	}
+
+	handler := &CommandHandler{deps: &CommandDependencies{Storage: store, APIClient: client}}
+	userInfo, err := handler.fetchFreshUserInfo("alice")
+	if err != nil || !saved.IsSatisfiedBy(userInfo.Bio) {
+		t.Errorf("Expected bio to satisfy challenge, got %+v (%v)", userInfo, err)
+	}
+
+	handler.deleteRegistrationChallenge("7")
+	if deleted, _ := store.GetRegistrationChallenge("7"); deleted != nil {
+		t.Errorf("Expected challenge to be deleted, got %+v", deleted)
+	}
+}
+
+func TestAddParticipantRequiresStartSnapshot(t *testing.T) {
+	client := &MockSolvedACClient{shouldError: true}
+	store := storage.NewInMemoryStorage(client)
+	start := time.Now().AddDate(0, 0, -1)
+	if _, err := store.CreateCompetition("test", start, start.AddDate(0, 0, 7)); err != nil {
+		t.Fatalf("Failed to create competition: %v", err)
+	}
+
+	// solved.ac 조회에 실패하면 스냅샷 없는 참가자를 저장하지 않습니다
+	err := store.AddParticipant("홍길동", "player", 6, 0, 0, "7")
+	if !errors.Is(err, interfaces.ErrStartSnapshotUnavailable) {
+		t.Fatalf("Expected start snapshot error, got %v", err)
+	}
+	if participants := store.GetParticipants(); len(participants) != 0 {
+		t.Fatalf("Expected participant not to be saved, got %+v", participants)
+	}
+
+	client.shouldError = false
+	if err := store.AddParticipant("홍길동", "player", 6, 0, 0, "7"); err != nil {
+		t.Fatalf("Failed to add participant: %v", err)
+	}
+
+	client.shouldError = true
+	change := models.HandleChange{OldBaekjoonID: "player", NewBaekjoonID: "renamed", Policy: models.HandleChangeResnapshot}
+	if err := store.ChangeParticipantHandle(change); !errors.Is(err, interfaces.ErrStartSnapshotUnavailable) {
+		t.Fatalf("Expected start snapshot error on resnapshot, got %v", err)
+	}
+	if _, found := findParticipant(store.GetParticipants(), "player"); !found {
+		t.Error("Expected participant to keep the old handle when resnapshot fails")
+	}
+}
+
+// blockingSolvedClient 해결 문제 조회가 release될 때까지 멈추는 solved.ac 클라이언트
+type blockingSolvedClient struct {
+	*MockSolvedACClient
+	started chan struct{}
+	release chan struct{}
+}
+
+func (c *blockingSolvedClient) GetUserSolvedProblems(ctx context.Context, handle string) ([]api.ProblemInfo, error) {
+	close(c.started)
+	<-c.release
+	return c.MockSolvedACClient.GetUserSolvedProblems(ctx, handle)
+}
+
+func TestAddParticipantFetchesSnapshotWithoutLock(t *testing.T) {
+	client := &blockingSolvedClient{MockSolvedACClient: &MockSolvedACClient{}, started: make(chan struct{}), release: make(chan struct{})}
+	store := storage.NewInMemoryStorage(client)
+	start := time.Now().AddDate(0, 0, -1)
+	if _, err := store.CreateCompetition("test", start, start.AddDate(0, 0, 7)); err != nil {
+		t.Fatalf("Failed to create competition: %v", err)
+	}
+
+	done := make(chan error, 1)
+	go func() { done <- store.AddParticipant("홍길동", "player", 6, 0, 0, "7") }()
+	<-client.started
+
+	// 스냅샷을 가져오는 동안에도 다른 저장소 호출은 막히지 않아야 합니다
+	read := make(chan struct{})
+	go func() {
+		store.GetParticipants()
+		close(read)
+	}()
+	select {
+	case <-read:
+	case <-time.After(time.Second):
+		t.Fatal("Storage was locked while fetching the start snapshot")
+	}
+
+	close(client.release)
+	if err := <-done; err != nil {
+		t.Fatalf("Failed to add participant: %v", err)
+	}
+	if _, found := findParticipant(store.GetParticipants(), "player"); !found {
+		t.Error("Expected participant to be saved after the snapshot was fetched")
+	}
+}
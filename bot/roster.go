@@ -0,0 +1,436 @@
+package bot
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/ssugameworks/kkemi/constants"
+	"github.com/ssugameworks/kkemi/errors"
+	"github.com/ssugameworks/kkemi/interfaces"
+	"github.com/ssugameworks/kkemi/models"
+	"github.com/ssugameworks/kkemi/utils"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+// handleRoster 대회 참가 명단을 조회/추가/삭제/가져오기 합니다
+// `!명단 [list]`, `!명단 add|remove <이름> ...`, `!명단 import csv`, `!명단 import sheets [스프레드시트]`, `!명단 sheets off`
+func (handler *CommandHandler) handleRoster(session *discordgo.Session, message *discordgo.MessageCreate, params []string) {
+	errorHandlers := utils.NewErrorHandlerFactory(session, message.ChannelID)
+
+	competition := handler.deps.Storage.GetCompetition()
+	if competition == nil {
+		errorHandlers.Data().HandleNoActiveCompetition()
+		return
+	}
+
+	action := "list"
+	if len(params) > 0 {
+		action = params[0]
+	}
+
+	switch {
+	case action == "list" && len(params) <= 1:
+		handler.handleRosterList(session, message, competition)
+	case action == "add" && len(params) > 1:
+		handler.handleRosterAdd(session, message, competition, params[1:])
+	case action == "remove" && len(params) > 1:
+		handler.handleRosterRemove(session, message, competition, params[1:])
+	case action == "import" && len(params) >= 2 && params[1] == models.RosterSourceCSV:
+		// 붙여넣은 CSV 내용도 매개변수로 나뉘므로 개수는 따지지 않습니다
+		handler.handleRosterImportCSV(session, message, competition)
+	case action == "import" && (len(params) == 2 || len(params) == 3) && params[1] == models.RosterSourceSheets:
+		handler.handleRosterImportSheets(session, message, competition, params[2:])
+	case action == models.RosterSourceSheets && len(params) == 2 && params[1] == "off":
+		handler.handleRosterSheetOff(session, message, competition)
+	default:
+		errorHandlers.Validation().HandleInvalidParams("ROSTER_INVALID_PARAMS",
+			"Invalid roster parameters",
+			constants.MsgRosterUsage)
+	}
+}
+
+// handleRosterList 명단 인원과 출처별 인원, 이름 목록을 보여줍니다
+func (handler *CommandHandler) handleRosterList(session *discordgo.Session, message *discordgo.MessageCreate, competition *models.Competition) {
+	entries, ok := handler.loadRoster(session, message)
+	if !ok {
+		return
+	}
+	if len(entries) == 0 {
+		errors.SendDiscordInfo(session, message.ChannelID, fmt.Sprintf(constants.MsgRosterEmpty, competition.Name))
+		return
+	}
+
+	counts := make(map[string]int)
+	names := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		counts[entry.Source]++
+		names = append(names, entry.Name)
+	}
+
+	embed := &discordgo.MessageEmbed{
+		Title: fmt.Sprintf(constants.MsgRosterTitle, competition.Name, len(entries)),
+		Description: fmt.Sprintf(constants.MsgRosterSourceCounts,
+			counts[models.RosterSourceManual], counts[models.RosterSourceCSV], counts[models.RosterSourceSheets]) +
+			"\n" + formatRosterSheetStatus(competition) +
+			"\n\n" + joinRosterNames(names, constants.MaxRosterListNames),
+		Color: constants.ColorTierGold,
+	}
+	if _, err := session.ChannelMessageSendEmbed(message.ChannelID, embed); err != nil {
+		utils.Error("DISCORD API ERROR: Failed to send roster: %v", err)
+	}
+}
+
+// handleRosterAdd 관리자가 직접 이름을 명단에 추가합니다
+func (handler *CommandHandler) handleRosterAdd(session *discordgo.Session, message *discordgo.MessageCreate, competition *models.Competition, names []string) {
+	entries, ok := handler.loadRoster(session, message)
+	if !ok {
+		return
+	}
+
+	valid := handler.validRosterNames(session, message, names)
+	diff := models.DiffRosterSource(entries, models.RosterSourceManual, valid)
+	diff.Removed = nil
+	handler.applyRosterChange(session, message, competition, models.AuditActionRosterAdd, models.RosterSourceManual, diff)
+}
+
+// handleRosterRemove 명단에서 이름을 삭제합니다 (출처와 관계없이 삭제)
+func (handler *CommandHandler) handleRosterRemove(session *discordgo.Session, message *discordgo.MessageCreate, competition *models.Competition, names []string) {
+	entries, ok := handler.loadRoster(session, message)
+	if !ok {
+		return
+	}
+
+	var diff models.RosterDiff
+	for _, name := range names {
+		for _, entry := range entries {
+			if entry.Key() == models.NormalizeRosterName(name) {
+				diff.Removed = append(diff.Removed, entry.Name)
+				break
+			}
+		}
+	}
+	handler.applyRosterChange(session, message, competition, models.AuditActionRosterRemove, models.RosterSourceManual, diff)
+}
+
+// handleRosterImportCSV 첨부한 CSV 파일이나 메시지에 붙여넣은 CSV의 이름을 명단에 추가합니다
+func (handler *CommandHandler) handleRosterImportCSV(session *discordgo.Session, message *discordgo.MessageCreate, competition *models.Competition) {
+	content, err := rosterCSVContent(message)
+	if err != nil {
+		errors.HandleDiscordError(session, message.ChannelID, errors.NewValidationError("ROSTER_CSV_DOWNLOAD_FAILED",
+			fmt.Sprintf("Failed to download roster CSV: %v", err),
+			fmt.Sprintf(constants.MsgRosterCSVInvalid, err.Error())))
+		return
+	}
+	if strings.TrimSpace(content) == "" {
+		errors.HandleDiscordError(session, message.ChannelID, errors.NewValidationError("ROSTER_CSV_MISSING",
+			"Roster CSV not found in message", constants.MsgRosterCSVMissing))
+		return
+	}
+
+	names, err := models.ParseRosterCSV(content)
+	if err != nil {
+		errors.HandleDiscordError(session, message.ChannelID, errors.NewValidationError("ROSTER_CSV_INVALID",
+			fmt.Sprintf("Invalid roster CSV: %v", err),
+			fmt.Sprintf(constants.MsgRosterCSVInvalid, err.Error())))
+		return
+	}
+
+	entries, ok := handler.loadRoster(session, message)
+	if !ok {
+		return
+	}
+
+	// CSV 가져오기는 추가만 합니다 (빠진 이름은 `!명단 remove`로 직접 삭제)
+	diff := models.DiffRosterSource(entries, models.RosterSourceCSV, handler.validRosterNames(session, message, names))
+	diff.Removed = nil
+	handler.applyRosterChange(session, message, competition, models.AuditActionRosterImport, models.RosterSourceCSV, diff)
+}
+
+// handleRosterImportSheets 스프레드시트와 명단을 바로 동기화하고, 이후 자동 동기화할 시트로 지정합니다
+// 시트를 생략하면 이미 지정한 시트를, 지정한 적이 없으면 기본 참가자 명단 시트를 사용합니다
+func (handler *CommandHandler) handleRosterImportSheets(session *discordgo.Session, message *discordgo.MessageCreate, competition *models.Competition, params []string) {
+	errorHandlers := utils.NewErrorHandlerFactory(session, message.ChannelID)
+
+	if handler.deps.SheetsClient == nil {
+		errors.SendDiscordInfo(session, message.ChannelID, constants.MsgRosterSheetsUnavailable)
+		return
+	}
+
+	spreadsheetID := competition.RosterSheetID
+	if len(params) == 1 {
+		parsed, ok := utils.ParseSpreadsheetID(params[0])
+		if !ok {
+			errorHandlers.Validation().HandleInvalidParams("ROSTER_INVALID_SHEET",
+				fmt.Sprintf("Invalid spreadsheet ID: %s", params[0]),
+				constants.MsgRosterInvalidSheet)
+			return
+		}
+		spreadsheetID = parsed
+	}
+	if spreadsheetID == "" {
+		spreadsheetID = constants.GetParticipantSpreadsheetID()
+	}
+
+	names, err := handler.deps.SheetsClient.GetParticipantNames(spreadsheetID)
+	if err == nil && len(names) == 0 {
+		// 시트를 잘못 읽어 명단이 통째로 지워지는 일을 막습니다
+		err = fmt.Errorf("participant spreadsheet returned no names")
+	}
+	if err != nil {
+		errorHandlers.System().HandleSystemError("ROSTER_SHEETS_FAILED",
+			"Failed to read participant spreadsheet", constants.MsgRosterSheetsFailed, err)
+		return
+	}
+
+	diff, err := SyncRoster(handler.deps.Storage, models.RosterSourceSheets, names, message.Author.Username)
+	if err != nil {
+		errorHandlers.System().HandleSystemError("ROSTER_SAVE_FAILED",
+			"Failed to sync roster", constants.MsgRosterSaveFailed, err)
+		return
+	}
+	handler.reportRosterChange(session, message, competition, models.AuditActionRosterImport, models.RosterSourceSheets, diff)
+
+	if spreadsheetID != competition.RosterSheetID {
+		handler.updateRosterSheet(session, message, competition, spreadsheetID)
+	}
+}
+
+// handleRosterSheetOff 스프레드시트 자동 동기화를 끕니다 (이미 가져온 이름은 그대로 유지)
+func (handler *CommandHandler) handleRosterSheetOff(session *discordgo.Session, message *discordgo.MessageCreate, competition *models.Competition) {
+	if competition.RosterSheetID == "" {
+		errors.SendDiscordInfo(session, message.ChannelID, constants.MsgRosterSheetNotSet)
+		return
+	}
+	handler.updateRosterSheet(session, message, competition, "")
+}
+
+// updateRosterSheet 자동 동기화할 스프레드시트를 저장하고 감사 로그에 남깁니다
+func (handler *CommandHandler) updateRosterSheet(session *discordgo.Session, message *discordgo.MessageCreate, competition *models.Competition, spreadsheetID string) {
+	if err := handler.deps.Storage.UpdateCompetitionRosterSheet(spreadsheetID); err != nil {
+		utils.NewErrorHandlerFactory(session, message.ChannelID).System().HandleCompetitionUpdateFailed(err)
+		return
+	}
+
+	handler.recordAudit(session, message, models.AuditEntry{
+		CompetitionID: competition.ID,
+		Action:        models.AuditActionRosterSheet,
+		Target:        competition.Name,
+		Before:        competition.RosterSheetID,
+		After:         spreadsheetID,
+	})
+
+	response := fmt.Sprintf(constants.MsgRosterSheetEnabled, competition.Name, spreadsheetID)
+	if spreadsheetID == "" {
+		response = fmt.Sprintf(constants.MsgRosterSheetDisabled, competition.Name)
+	}
+	errors.SendDiscordSuccess(session, message.ChannelID, response)
+}
+
+// formatRosterSheetStatus 명단 자동 동기화 설정을 한 줄로 보여줍니다
+func formatRosterSheetStatus(competition *models.Competition) string {
+	if competition.RosterSheetID == "" {
+		return constants.MsgRosterSheetStatusOff
+	}
+	return fmt.Sprintf(constants.MsgRosterSheetStatusOn, competition.RosterSheetID)
+}
+
+// applyRosterChange 명단 변경을 저장하고 감사 로그와 결과를 남깁니다
+func (handler *CommandHandler) applyRosterChange(session *discordgo.Session, message *discordgo.MessageCreate, competition *models.Competition, action, source string, diff models.RosterDiff) {
+	if err := saveRosterDiff(handler.deps.Storage, source, diff, message.Author.Username); err != nil {
+		utils.NewErrorHandlerFactory(session, message.ChannelID).System().HandleSystemError("ROSTER_SAVE_FAILED",
+			"Failed to save roster", constants.MsgRosterSaveFailed, err)
+		return
+	}
+	handler.reportRosterChange(session, message, competition, action, source, diff)
+}
+
+// reportRosterChange 명단 변경 결과를 알리고 변경이 있으면 감사 로그에 남깁니다
+func (handler *CommandHandler) reportRosterChange(session *discordgo.Session, message *discordgo.MessageCreate, competition *models.Competition, action, source string, diff models.RosterDiff) {
+	if diff.IsEmpty() {
+		errors.SendDiscordInfo(session, message.ChannelID, constants.MsgRosterNoChange)
+		return
+	}
+
+	handler.recordAudit(session, message, models.AuditEntry{
+		CompetitionID: competition.ID,
+		Action:        action,
+		Target:        source,
+		After:         FormatRosterDiff(diff),
+	})
+	errors.SendDiscordSuccess(session, message.ChannelID, fmt.Sprintf(constants.MsgRosterUpdated, competition.Name, FormatRosterDiff(diff)))
+}
+
+// loadRoster 현재 대회의 명단을 불러오고, 실패하면 오류를 알립니다
+func (handler *CommandHandler) loadRoster(session *discordgo.Session, message *discordgo.MessageCreate) ([]models.RosterEntry, bool) {
+	entries, err := handler.deps.Storage.GetRoster()
+	if err != nil {
+		utils.NewErrorHandlerFactory(session, message.ChannelID).System().HandleSystemError("ROSTER_LOAD_FAILED",
+			"Failed to load roster", constants.ErrorRosterCheckFailed, err)
+		return nil, false
+	}
+	return entries, true
+}
+
+// validRosterNames 이름 형식이 올바른 항목만 남기고, 건너뛴 이름은 알려줍니다
+func (handler *CommandHandler) validRosterNames(session *discordgo.Session, message *discordgo.MessageCreate, names []string) []string {
+	valid, invalid := splitRosterNames(names)
+	if len(invalid) > 0 {
+		errors.SendDiscordInfo(session, message.ChannelID, fmt.Sprintf(constants.MsgRosterInvalidNames,
+			joinRosterNames(invalid, constants.MaxRosterDiffNames)))
+	}
+	return valid
+}
+
+// isNameInRoster 현재 대회의 참가 명단에 이름이 있는지 확인합니다
+func (handler *CommandHandler) isNameInRoster(name string) (bool, error) {
+	entries, err := handler.deps.Storage.GetRoster()
+	if err != nil {
+		return false, err
+	}
+	return models.RosterContains(entries, name), nil
+}
+
+// SyncRoster 한 출처의 이름 목록으로 명단을 맞춥니다 (같은 출처에서 빠진 이름은 삭제, 다른 출처 항목은 유지)
+func SyncRoster(storage interfaces.StorageRepository, source string, names []string, actor string) (models.RosterDiff, error) {
+	entries, err := storage.GetRoster()
+	if err != nil {
+		return models.RosterDiff{}, err
+	}
+
+	valid, invalid := splitRosterNames(names)
+	if len(invalid) > 0 {
+		utils.Warn("Skipped %d invalid roster names from %s: %v", len(invalid), source, invalid)
+	}
+	diff := models.DiffRosterSource(entries, source, valid)
+	if err := saveRosterDiff(storage, source, diff, actor); err != nil {
+		return models.RosterDiff{}, err
+	}
+	return diff, nil
+}
+
+// RecordRosterSync 자동 동기화로 바뀐 명단을 감사 로그에 남기고, 감사 로그 채널이 있으면 함께 알립니다
+func RecordRosterSync(session *discordgo.Session, storage interfaces.StorageRepository, auditChannelID string, competition models.Competition, diff models.RosterDiff) {
+	entry := models.AuditEntry{
+		CompetitionID: competition.ID,
+		ActorName:     constants.SystemComponentName,
+		Action:        models.AuditActionRosterSync,
+		Target:        models.RosterSourceSheets,
+		After:         truncateAuditValue(FormatRosterDiff(diff)),
+		Timestamp:     utils.GetCurrentTimeKST(),
+	}
+	if err := storage.AppendAuditEntry(entry); err != nil {
+		utils.Error("Failed to record roster sync for %s: %v", competition.Name, err)
+	}
+	utils.Info("Roster synced for %s: %d added, %d removed", competition.Name, len(diff.Added), len(diff.Removed))
+
+	if auditChannelID == "" || session == nil {
+		return
+	}
+	embed := &discordgo.MessageEmbed{
+		Title:       fmt.Sprintf(constants.MsgRosterSyncTitle, competition.Name),
+		Description: FormatRosterDiff(diff),
+		Color:       constants.ColorTierGold,
+	}
+	if _, err := session.ChannelMessageSendEmbed(auditChannelID, embed); err != nil {
+		utils.Warn("Failed to report roster sync to channel %s: %v", auditChannelID, err)
+	}
+}
+
+// FormatRosterDiff 추가·삭제된 이름을 한 줄씩 요약합니다
+func FormatRosterDiff(diff models.RosterDiff) string {
+	var lines []string
+	if len(diff.Added) > 0 {
+		lines = append(lines, fmt.Sprintf(constants.MsgRosterDiffAdded, len(diff.Added), joinRosterNames(diff.Added, constants.MaxRosterDiffNames)))
+	}
+	if len(diff.Removed) > 0 {
+		lines = append(lines, fmt.Sprintf(constants.MsgRosterDiffRemoved, len(diff.Removed), joinRosterNames(diff.Removed, constants.MaxRosterDiffNames)))
+	}
+	return strings.Join(lines, "\n")
+}
+
+// saveRosterDiff 변경 내역대로 명단 항목을 추가하고 삭제합니다
+func saveRosterDiff(storage interfaces.StorageRepository, source string, diff models.RosterDiff, actor string) error {
+	if len(diff.Added) > 0 {
+		now := utils.GetCurrentTimeKST()
+		entries := make([]models.RosterEntry, 0, len(diff.Added))
+		for _, name := range diff.Added {
+			entries = append(entries, models.RosterEntry{Name: name, Source: source, AddedBy: actor, AddedAt: now})
+		}
+		if err := storage.SaveRosterEntries(entries); err != nil {
+			return err
+		}
+	}
+	if len(diff.Removed) > 0 {
+		return storage.RemoveRosterEntries(diff.Removed)
+	}
+	return nil
+}
+
+// splitRosterNames 등록 이름 규칙을 통과하는 이름과 그렇지 않은 이름을 나눕니다
+func splitRosterNames(names []string) (valid, invalid []string) {
+	for _, name := range names {
+		name = strings.TrimSpace(name)
+		if utils.IsValidUsername(name) {
+			valid = append(valid, name)
+		} else if name != "" {
+			invalid = append(invalid, name)
+		}
+	}
+	return valid, invalid
+}
+
+// joinRosterNames 이름 목록을 쉼표로 잇고, 너무 많으면 나머지 인원 수만 표시합니다
+func joinRosterNames(names []string, limit int) string {
+	if len(names) <= limit {
+		return strings.Join(names, ", ")
+	}
+	return strings.Join(names[:limit], ", ") + " " + fmt.Sprintf(constants.MsgRosterMore, len(names)-limit)
+}
+
+// rosterCSVContent 첨부한 CSV 파일 내용을, 없으면 명령어 다음 줄부터의 메시지 내용을 반환합니다
+func rosterCSVContent(message *discordgo.MessageCreate) (string, error) {
+	for _, attachment := range message.Attachments {
+		if strings.HasSuffix(strings.ToLower(attachment.Filename), ".csv") {
+			return downloadRosterCSV(attachment.URL)
+		}
+	}
+
+	_, body, found := strings.Cut(message.Content, "\n")
+	if !found {
+		return "", nil
+	}
+	// 코드 블록(```csv ... ```)으로 감싼 경우 울타리 줄은 버립니다
+	lines := strings.Split(body, "\n")
+	kept := lines[:0]
+	for _, line := range lines {
+		if !strings.HasPrefix(strings.TrimSpace(line), "```") {
+			kept = append(kept, line)
+		}
+	}
+	return strings.Join(kept, "\n"), nil
+}
+
+// downloadRosterCSV 디스코드 첨부 파일을 크기 제한 안에서 내려받습니다
+func downloadRosterCSV(url string) (string, error) {
+	client := &http.Client{Timeout: constants.RosterDownloadTimeout}
+	resp, err := client.Get(url)
+	if err != nil {
+		return "", fmt.Errorf("첨부 파일을 내려받을 수 없습니다: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("첨부 파일 응답 코드 %d", resp.StatusCode)
+	}
+
+	data, err := io.ReadAll(io.LimitReader(resp.Body, constants.MaxRosterCSVBytes+1))
+	if err != nil {
+		return "", fmt.Errorf("첨부 파일을 읽을 수 없습니다: %w", err)
+	}
+	if len(data) > constants.MaxRosterCSVBytes {
+		return "", fmt.Errorf("첨부 파일이 %dKB를 넘습니다", constants.MaxRosterCSVBytes/1024)
+	}
+	return string(data), nil
+}
@@ -0,0 +1,113 @@
+package bot
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ssugameworks/kkemi/constants"
+	"github.com/ssugameworks/kkemi/models"
+	"github.com/ssugameworks/kkemi/storage"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+func TestSyncRoster(t *testing.T) {
+	store := storage.NewInMemoryStorage(&MockSolvedACClient{})
+	start := time.Now().AddDate(0, 0, -1)
+	if _, err := store.CreateCompetition("test", start, start.AddDate(0, 0, 7)); err != nil {
+		t.Fatalf("Failed to create competition: %v", err)
+	}
+	if err := store.SaveRosterEntries([]models.RosterEntry{{Name: "박민수", Source: models.RosterSourceManual}}); err != nil {
+		t.Fatalf("Failed to save roster: %v", err)
+	}
+
+	diff, err := SyncRoster(store, models.RosterSourceSheets, []string{"김철수", "이영희", "<script>"}, "tester")
+	if err != nil {
+		t.Fatalf("Failed to sync roster: %v", err)
+	}
+	if len(diff.Added) != 2 || len(diff.Removed) != 0 {
+		t.Errorf("Expected two valid names to be added, got %+v", diff)
+	}
+
+	diff, err = SyncRoster(store, models.RosterSourceSheets, []string{"김철수"}, "tester")
+	if err != nil {
+		t.Fatalf("Failed to sync roster: %v", err)
+	}
+	if len(diff.Added) != 0 || len(diff.Removed) != 1 || diff.Removed[0] != "이영희" {
+		t.Errorf("Expected 이영희 to be removed, got %+v", diff)
+	}
+
+	handler := &CommandHandler{deps: &CommandDependencies{Storage: store}}
+	for name, expected := range map[string]bool{"김철수": true, "박민수": true, "이영희": false} {
+		inRoster, err := handler.isNameInRoster(name)
+		if err != nil || inRoster != expected {
+			t.Errorf("isNameInRoster(%q) = %t, %v; expected %t", name, inRoster, err, expected)
+		}
+	}
+}
+
+func TestRosterCSVContent(t *testing.T) {
+	message := &discordgo.MessageCreate{Message: &discordgo.Message{
+		Content: "!명단 import csv\n```csv\n이름\n홍길동\n```",
+	}}
+	content, err := rosterCSVContent(message)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if content != "이름\n홍길동" {
+		t.Errorf("Expected code fences to be stripped, got %q", content)
+	}
+
+	message.Content = "!명단 import csv"
+	if content, _ := rosterCSVContent(message); content != "" {
+		t.Errorf("Expected empty content without CSV body, got %q", content)
+	}
+}
+
+func TestFormatRosterDiff(t *testing.T) {
+	names := make([]string, 25)
+	for i := range names {
+		names[i] = "참가자"
+	}
+	formatted := FormatRosterDiff(models.RosterDiff{Added: names, Removed: []string{"이영희"}})
+
+	lines := strings.Split(formatted, "\n")
+	if len(lines) != 2 {
+		t.Fatalf("Expected added and removed lines, got %q", formatted)
+	}
+	if !strings.Contains(lines[0], "25명") || !strings.Contains(lines[0], "외 5명") {
+		t.Errorf("Expected long list to be truncated, got %q", lines[0])
+	}
+	if !strings.Contains(lines[1], "이영희") {
+		t.Errorf("Expected removed name, got %q", lines[1])
+	}
+}
+
+func TestRosterSheetOptIn(t *testing.T) {
+	store := storage.NewInMemoryStorage(&MockSolvedACClient{})
+	start := time.Now().AddDate(0, 0, -1)
+	if _, err := store.CreateCompetition("club", start, start.AddDate(0, 0, 7)); err != nil {
+		t.Fatalf("Failed to create competition: %v", err)
+	}
+
+	// 명단 시트는 대회마다 지정하며, 지정하지 않은 대회는 동기화하지 않습니다
+	competition := store.GetCompetition()
+	if competition.RosterSheetID != "" || formatRosterSheetStatus(competition) != constants.MsgRosterSheetStatusOff {
+		t.Fatalf("Expected roster sync to be off by default, got %q", competition.RosterSheetID)
+	}
+
+	const sheetID = "1wwjn1hApSINnYsQGbEe5OdpYWvMfsfHC1ftoyR65IDM"
+	if err := store.UpdateCompetitionRosterSheet(sheetID); err != nil {
+		t.Fatalf("Failed to set roster sheet: %v", err)
+	}
+	if competition := store.GetCompetition(); competition.RosterSheetID != sheetID {
+		t.Errorf("Expected roster sheet %s, got %q", sheetID, competition.RosterSheetID)
+	}
+	if err := store.UpdateCompetitionRosterSheet(""); err != nil {
+		t.Fatalf("Failed to clear roster sheet: %v", err)
+	}
+	if competition := store.GetCompetition(); competition.RosterSheetID != "" {
+		t.Errorf("Expected roster sheet to be cleared, got %q", competition.RosterSheetID)
+	}
+}
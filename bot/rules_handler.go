@@ -0,0 +1,323 @@
+package bot
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/ssugameworks/kkemi/constants"
+	"github.com/ssugameworks/kkemi/errors"
+	"github.com/ssugameworks/kkemi/models"
+	"github.com/ssugameworks/kkemi/utils"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+// handleCompetitionRules 대회 점수 규칙을 조회/검증/적용/초기화합니다
+func (ch *CompetitionHandler) handleCompetitionRules(s *discordgo.Session, m *discordgo.MessageCreate, params []string) {
+	errorHandlers := utils.NewErrorHandlerFactory(s, m.ChannelID)
+
+	action := "show"
+	if len(params) > 0 {
+		action = params[0]
+	}
+
+	switch action {
+	case "show":
+		ch.handleRulesShow(s, m)
+	case "validate":
+		if rules, ok := ch.parseRulesFromMessage(s, m); ok {
+			errors.SendDiscordSuccess(s, m.ChannelID, fmt.Sprintf(constants.MsgCompetitionRulesValid, rules.Name, len(rules.Leagues)))
+		}
+	case "set":
+		ch.handleRulesSet(s, m)
+	case "reset":
+		ch.handleRulesReset(s, m)
+	default:
+		errorHandlers.Validation().HandleInvalidParams("RULES_INVALID_PARAMS",
+			fmt.Sprintf("Unknown rules action: %s", action),
+			constants.MsgCompetitionRulesUsage)
+	}
+}
+
+// handleRulesShow 현재 대회에 적용 중인 규칙을 요약과 JSON으로 보여줍니다
+func (ch *CompetitionHandler) handleRulesShow(s *discordgo.Session, m *discordgo.MessageCreate) {
+	competition := ch.commandHandler.deps.Storage.GetCompetition()
+	if competition == nil {
+		utils.NewErrorHandlerFactory(s, m.ChannelID).Data().HandleNoActiveCompetition()
+		return
+	}
+
+	source := constants.MsgCompetitionRulesSourceBase
+	if competition.ScoringRules != nil {
+		source = constants.MsgCompetitionRulesSourceComp
+	}
+	rules := ch.commandHandler.deps.ScoreCalculator.ForCompetition(competition).GetRules()
+
+	rulesJSON, err := json.MarshalIndent(rules, "", "  ")
+	if err != nil {
+		errors.HandleDiscordError(s, m.ChannelID, errors.NewSystemError("RULES_ENCODE_FAILED", "Failed to encode scoring rules", err))
+		return
+	}
+
+	embed := &discordgo.MessageEmbed{
+		Title:       fmt.Sprintf("📐 점수 규칙: %s", rules.Name),
+		Description: fmt.Sprintf("%s\n```\n%s```\n```json\n%s\n```", source, formatLeagueRules(rules), utils.TruncateString(string(rulesJSON), constants.MaxRulesJSONLength)),
+		Color:       constants.ColorTierGold,
+	}
+
+	if _, err := s.ChannelMessageSendEmbed(m.ChannelID, embed); err != nil {
+		utils.Error("DISCORD API ERROR: Failed to send scoring rules: %v", err)
+	}
+}
+
+// handleRulesSet 메시지의 JSON 규칙을 검증한 뒤 활성 대회에 적용합니다
+func (ch *CompetitionHandler) handleRulesSet(s *discordgo.Session, m *discordgo.MessageCreate) {
+	competition := ch.commandHandler.deps.Storage.GetCompetition()
+	if competition == nil {
+		utils.NewErrorHandlerFactory(s, m.ChannelID).Data().HandleNoActiveCompetition()
+		return
+	}
+
+	rules, ok := ch.parseRulesFromMessage(s, m)
+	if !ok {
+		return
+	}
+
+	if err := ch.commandHandler.deps.Storage.UpdateCompetitionScoringRules(rules); err != nil {
+		utils.NewErrorHandlerFactory(s, m.ChannelID).System().HandleCompetitionUpdateFailed(err)
+		return
+	}
+	ch.recordRulesAudit(s, m, competition, rules)
+
+	errors.SendDiscordSuccess(s, m.ChannelID, fmt.Sprintf(constants.MsgCompetitionRulesSetSuccess, rules.Name, len(rules.Leagues)))
+}
+
+// handleRulesReset 대회 전용 규칙을 제거합니다
+func (ch *CompetitionHandler) handleRulesReset(s *discordgo.Session, m *discordgo.MessageCreate) {
+	competition := ch.commandHandler.deps.Storage.GetCompetition()
+	if competition == nil {
+		utils.NewErrorHandlerFactory(s, m.ChannelID).Data().HandleNoActiveCompetition()
+		return
+	}
+
+	if err := ch.commandHandler.deps.Storage.UpdateCompetitionScoringRules(nil); err != nil {
+		utils.NewErrorHandlerFactory(s, m.ChannelID).System().HandleCompetitionUpdateFailed(err)
+		return
+	}
+	ch.recordRulesAudit(s, m, competition, nil)
+
+	errors.SendDiscordSuccess(s, m.ChannelID, constants.MsgCompetitionRulesReset)
+}
+
+// recordRulesAudit 점수 규칙 변경을 감사 로그에 남깁니다
+func (ch *CompetitionHandler) recordRulesAudit(s *discordgo.Session, m *discordgo.MessageCreate, competition *models.Competition, rules *models.ScoringRules) {
+	ch.commandHandler.recordAudit(s, m, models.AuditEntry{
+		CompetitionID: competition.ID,
+		Action:        models.AuditActionCompetitionRules,
+		Target:        competition.Name,
+		Before:        auditRules(competition.ScoringRules),
+		After:         auditRules(rules),
+	})
+}
+
+// parseRulesFromMessage 메시지 본문에서 JSON 규칙을 추출하여 검증합니다
+func (ch *CompetitionHandler) parseRulesFromMessage(s *discordgo.Session, m *discordgo.MessageCreate) (*models.ScoringRules, bool) {
+	rawJSON := extractJSONObject(m.Content)
+	if rawJSON == "" {
+		errors.HandleDiscordError(s, m.ChannelID, errors.NewValidationError("RULES_JSON_MISSING",
+			"Scoring rules JSON not found in message", constants.MsgCompetitionRulesNoJSON))
+		return nil, false
+	}
+
+	rules, err := models.ParseScoringRules([]byte(rawJSON))
+	if err != nil {
+		errors.HandleDiscordError(s, m.ChannelID, errors.NewValidationError("RULES_INVALID",
+			fmt.Sprintf("Invalid scoring rules: %v", err),
+			fmt.Sprintf(constants.MsgCompetitionRulesInvalid, err.Error())))
+		return nil, false
+	}
+	return rules, true
+}
+
+// extractJSONObject 코드 블록 등에 감싸진 메시지에서 가장 바깥 JSON 객체를 잘라냅니다
+func extractJSONObject(content string) string {
+	start := strings.Index(content, "{")
+	end := strings.LastIndex(content, "}")
+	if start < 0 || end <= start {
+		return ""
+	}
+	return content[start : end+1]
+}
+
+// formatLeagueRules 리그별 티어 범위와 가중치를 표 형태로 만듭니다
+func formatLeagueRules(rules *models.ScoringRules) string {
+	tierManager := models.GetTierManager()
+
+	var builder strings.Builder
+	builder.WriteString("리그      티어 범위                 상위 / 동일 / 하위\n")
+	for _, league := range rules.SortedLeagues() {
+		builder.WriteString(fmt.Sprintf("%-8s %-12s ~ %-12s ×%.1f / ×%.1f / ×%.1f\n",
+			league.Name,
+			tierManager.GetTierName(league.MinTier),
+			tierManager.GetTierName(league.MaxTier),
+			league.UpperMultiplier, league.SameMultiplier, league.LowerMultiplier))
+	}
+	mode := rules.EffectiveMode()
+	if mode == models.ScoringModeCustom {
+		builder.WriteString(fmt.Sprintf("점수 방식: %s (%d개 레벨)\n", models.ScoringModeLabel(mode), len(rules.TierPoints)))
+	} else {
+		builder.WriteString(fmt.Sprintf("점수 방식: %s\n", models.ScoringModeLabel(mode)))
+	}
+	for _, bonus := range rules.Bonuses {
+		builder.WriteString(fmt.Sprintf("보너스: %s ×%.2f\n", bonus.Label(), bonus.Multiplier))
+	}
+	for _, streak := range rules.StreakBonuses {
+		builder.WriteString(fmt.Sprintf("잔디 보너스: %d일 연속 +%.0f점\n", streak.Days, streak.Points))
+	}
+	if rules.Growth != nil {
+		builder.WriteString(fmt.Sprintf("성장 점수: 레이팅 1당 %.2f점, 티어 상승당 +%.0f점\n",
+			rules.Growth.PointsPerRating, rules.Growth.TierUpBonus))
+	}
+	return builder.String()
+}
+
+// handleCompetitionMode 점수 산정 방식을 바꾸고 기존 순위를 새 방식으로 재계산한 결과를 보여줍니다
+// preview를 붙이면 저장하지 않고 변경 전후 비교만 출력합니다
+func (ch *CompetitionHandler) handleCompetitionMode(s *discordgo.Session, m *discordgo.MessageCreate, params []string) {
+	errorHandlers := utils.NewErrorHandlerFactory(s, m.ChannelID)
+	deps := ch.commandHandler.deps
+
+	preview := len(params) > 0 && params[0] == "preview"
+	if preview {
+		params = params[1:]
+	}
+	if len(params) != 1 || !models.IsValidScoringMode(params[0]) {
+		errorHandlers.Validation().HandleInvalidParams("MODE_INVALID_PARAMS",
+			"Invalid scoring mode parameters", constants.MsgCompetitionModeUsage)
+		return
+	}
+
+	competition := deps.Storage.GetCompetition()
+	if competition == nil {
+		errorHandlers.Data().HandleNoActiveCompetition()
+		return
+	}
+
+	currentRules := deps.ScoreCalculator.ForCompetition(competition).GetRules()
+	newRules := currentRules.WithMode(params[0])
+	if err := newRules.Validate(); err != nil {
+		errors.HandleDiscordError(s, m.ChannelID, errors.NewValidationError("MODE_INVALID_RULES",
+			fmt.Sprintf("Invalid rules for mode %s: %v", params[0], err),
+			fmt.Sprintf(constants.MsgCompetitionRulesInvalid, err.Error())))
+		return
+	}
+
+	before, err := deps.ScoreboardManager.CollectScoreData()
+	if err != nil {
+		errorHandlers.System().HandleScoreboardGenerationFailed(err)
+		return
+	}
+	after, err := deps.ScoreboardManager.CollectScoreDataWithRules(newRules)
+	if err != nil {
+		errorHandlers.System().HandleScoreboardGenerationFailed(err)
+		return
+	}
+
+	title := constants.MsgCompetitionModePreview
+	if !preview {
+		if err := deps.Storage.UpdateCompetitionScoringRules(newRules); err != nil {
+			errorHandlers.System().HandleCompetitionUpdateFailed(err)
+			return
+		}
+		ch.recordRulesAudit(s, m, competition, newRules)
+		title = constants.MsgCompetitionModeApplied
+
+		// 스프레드시트 순위도 새 방식으로 즉시 갱신
+		if deps.SheetsClient != nil {
+			teamScores, _ := deps.ScoreboardManager.TeamScores(after)
+			if err := deps.SheetsClient.UpdateScoreboardSheet(constants.GetScoreboardSpreadsheetID(), after, teamScores); err != nil {
+				utils.Warn("Failed to update sheets after scoring mode change: %v", err)
+			}
+		}
+	}
+
+	embed := &discordgo.MessageEmbed{
+		Title: fmt.Sprintf(title,
+			models.ScoringModeLabel(currentRules.EffectiveMode()),
+			models.ScoringModeLabel(newRules.EffectiveMode())),
+		Description: ch.formatStandingsDiff(before, after),
+		Color:       constants.ColorTierGold,
+	}
+	if _, err := s.ChannelMessageSendEmbed(m.ChannelID, embed); err != nil {
+		utils.Error("DISCORD API ERROR: Failed to send scoring mode result: %v", err)
+	}
+}
+
+// formatStandingsDiff 점수 방식 변경 전후의 점수와 리그 내 순위 변화를 표로 만듭니다
+func (ch *CompetitionHandler) formatStandingsDiff(before, after []models.ScoreData) string {
+	manager := ch.commandHandler.deps.ScoreboardManager
+	beforeScores := make(map[string]models.ScoreData, len(before))
+	for _, score := range before {
+		beforeScores[score.BaekjoonID] = score
+	}
+	beforeRanks := manager.rankScores(before)
+	afterRanks := manager.rankScores(after)
+
+	// 새 방식 기준 리그 → 순위 순서로 정렬
+	sorted := make([]models.ScoreData, len(after))
+	copy(sorted, after)
+	sort.Slice(sorted, func(i, j int) bool {
+		if sorted[i].League != sorted[j].League {
+			return sorted[i].League < sorted[j].League
+		}
+		if afterRanks[sorted[i].BaekjoonID] != afterRanks[sorted[j].BaekjoonID] {
+			return afterRanks[sorted[i].BaekjoonID] < afterRanks[sorted[j].BaekjoonID]
+		}
+		return sorted[i].BaekjoonID < sorted[j].BaekjoonID
+	})
+
+	lines := make([]string, 0, len(sorted))
+	for _, score := range sorted {
+		old, ok := beforeScores[score.BaekjoonID]
+		if !ok {
+			continue
+		}
+		oldRank, newRank := beforeRanks[score.BaekjoonID], afterRanks[score.BaekjoonID]
+		if old.Score == score.Score && oldRank == newRank {
+			continue
+		}
+		lines = append(lines, fmt.Sprintf("%-*s %5.0f → %5.0f  %2d위 → %2d위 %s",
+			constants.ScoreboardNameWidth, utils.TruncateString(score.BaekjoonID, constants.ScoreboardNameWidth),
+			old.Score, score.Score, oldRank, newRank, rankChangeMarker(oldRank, newRank)))
+	}
+
+	if len(lines) == 0 {
+		return constants.MsgCompetitionModeNoChange
+	}
+
+	var builder strings.Builder
+	builder.WriteString("```\n")
+	for i, line := range lines {
+		if i == constants.MaxModeDiffLines {
+			builder.WriteString(fmt.Sprintf(constants.MsgCompetitionModeMoreChanges+"\n", len(lines)-i))
+			break
+		}
+		builder.WriteString(line + "\n")
+	}
+	builder.WriteString("```")
+	return builder.String()
+}
+
+// rankChangeMarker 순위 변화를 화살표로 표시합니다
+func rankChangeMarker(oldRank, newRank int) string {
+	switch {
+	case newRank < oldRank:
+		return fmt.Sprintf("▲%d", oldRank-newRank)
+	case newRank > oldRank:
+		return fmt.Sprintf("▼%d", newRank-oldRank)
+	default:
+		return "-"
+	}
+}
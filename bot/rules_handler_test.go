@@ -0,0 +1,56 @@
+package bot
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/ssugameworks/kkemi/models"
+)
+
+func TestExtractJSONObject(t *testing.T) {
+	tests := []struct {
+		name     string
+		content  string
+		expected string
+	}{
+		{"inline", `!대회 rules set {"name": "a"}`, `{"name": "a"}`},
+		{"code block", "!대회 rules validate ```json\n{\"leagues\": [{\"id\": 0}]}\n```", `{"leagues": [{"id": 0}]}`},
+		{"missing", "!대회 rules set", ""},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := extractJSONObject(test.content); got != test.expected {
+				t.Errorf("Expected %q, got %q", test.expected, got)
+			}
+		})
+	}
+}
+
+func TestFormatStandingsDiff(t *testing.T) {
+	ch := &CompetitionHandler{commandHandler: &CommandHandler{deps: &CommandDependencies{
+		ScoreboardManager: &ScoreboardManager{},
+	}}}
+
+	before := []models.ScoreData{
+		{BaekjoonID: "alice", League: 0, Score: 50, RawScore: 50},
+		{BaekjoonID: "bob", League: 0, Score: 40, RawScore: 40},
+		{BaekjoonID: "carol", League: 1, Score: 30, RawScore: 30},
+	}
+	after := []models.ScoreData{
+		{BaekjoonID: "alice", League: 0, Score: 60, RawScore: 60},
+		{BaekjoonID: "bob", League: 0, Score: 80, RawScore: 80},
+		{BaekjoonID: "carol", League: 1, Score: 30, RawScore: 30},
+	}
+
+	diff := ch.formatStandingsDiff(before, after)
+	if !strings.Contains(diff, "bob") || !strings.Contains(diff, "▲1") {
+		t.Errorf("Expected bob to move up one rank, got:\n%s", diff)
+	}
+	if !strings.Contains(diff, "alice") || !strings.Contains(diff, "▼1") {
+		t.Errorf("Expected alice to move down one rank, got:\n%s", diff)
+	}
+	if strings.Contains(diff, "carol") {
+		t.Errorf("Unchanged participant should be omitted, got:\n%s", diff)
+	}
+}
@@ -0,0 +1,228 @@
+package bot
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/ssugameworks/kkemi/constants"
+	"github.com/ssugameworks/kkemi/models"
+	"github.com/ssugameworks/kkemi/utils"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+// handleScoreBreakdown 참가자의 문제별 점수 계산 내역을 보여줍니다
+func (handler *CommandHandler) handleScoreBreakdown(session *discordgo.Session, message *discordgo.MessageCreate, params []string) {
+	errorHandlers := utils.NewErrorHandlerFactory(session, message.ChannelID)
+
+	competition := handler.deps.Storage.GetCompetition()
+	if competition == nil || !competition.IsActive {
+		errorHandlers.Data().HandleNoActiveCompetition()
+		return
+	}
+
+	handle, page, ok := parseScoreParams(params)
+	if !ok {
+		errorHandlers.Validation().HandleInvalidParams("SCORE_INVALID_PARAMS",
+			"Invalid score parameters",
+			constants.MsgScoreUsage)
+		return
+	}
+
+	if handle == "" {
+		handle = handler.resolveAuthorHandle(message)
+	}
+	if handle == "" {
+		handle = message.Author.Username
+	}
+
+	if _, found := findParticipant(handler.deps.Storage.GetParticipants(), handle); !found {
+		errorHandlers.Validation().HandleInvalidParams("SCORE_PARTICIPANT_NOT_FOUND",
+			fmt.Sprintf("Participant not found: %s", handle),
+			fmt.Sprintf(constants.MsgScoreNotRegistered, handle))
+		return
+	}
+
+	// 블랙아웃 기간에는 스코어보드와 동일하게 점수를 숨깁니다
+	if handler.deps.ScoreboardManager.IsScoreHidden(handler.hasCapability(session, message, models.CapabilityViewHiddenScoreboard)) {
+		embed := &discordgo.MessageEmbed{
+			Title:       constants.MsgScoreboardBlackout,
+			Description: constants.MsgScoreboardBlackoutDesc,
+			Color:       handler.deps.TierManager.GetTierColor(0),
+		}
+		if _, err := session.ChannelMessageSendEmbed(message.ChannelID, embed); err != nil {
+			utils.Error("DISCORD API ERROR: Failed to send blackout notice: %v", err)
+		}
+		return
+	}
+
+	breakdown, err := handler.deps.ScoreboardManager.GetScoreBreakdown(handle)
+	if err != nil {
+		utils.Error("Failed to calculate score breakdown for %s: %v", handle, err)
+		errorHandlers.System().HandleScoreboardGenerationFailed(err)
+		return
+	}
+
+	if _, err := session.ChannelMessageSendEmbed(message.ChannelID, handler.formatScoreBreakdown(breakdown, page)); err != nil {
+		utils.Error("DISCORD API ERROR: Failed to send score breakdown: %v", err)
+	}
+}
+
+// parseScoreParams `[백준ID] [페이지]` 형식의 매개변수를 해석합니다 (숫자만 있으면 페이지로 취급)
+func parseScoreParams(params []string) (handle string, page int, ok bool) {
+	page = 1
+	if len(params) == 0 {
+		return "", page, true
+	}
+
+	// 백준 ID는 영문으로 시작하므로 숫자로 시작하는 첫 매개변수는 페이지로 해석
+	rest := params
+	if _, err := strconv.Atoi(params[0]); err != nil {
+		if !utils.IsValidBaekjoonID(params[0]) {
+			return "", 0, false
+		}
+		handle = params[0]
+		rest = params[1:]
+	}
+
+	if len(rest) > 0 {
+		parsed, err := strconv.Atoi(rest[0])
+		if err != nil || parsed < 1 {
+			return "", 0, false
+		}
+		page = parsed
+	}
+	return handle, page, true
+}
+
+// resolveAuthorHandle 조회 명령어에서 메시지 작성자의 백준 ID를 찾습니다 (저장소를 바꾸지 않음)
+// 연결된 참가자가 없으면 계정 연결 전에 등록한 참가자 중 디스코드 이름이 같은 참가자를 보여주기만 합니다
+func (handler *CommandHandler) resolveAuthorHandle(message *discordgo.MessageCreate) string {
+	if handle := handler.linkedAuthorHandle(message); handle != "" {
+		return handle
+	}
+
+	nick := ""
+	if message.Member != nil {
+		nick = message.Member.Nick
+	}
+	for _, participant := range handler.deps.Storage.GetParticipants() {
+		// 다른 사용자와 연결된 참가자는 이름이 같아도 가져가지 않습니다
+		if participant.DiscordUserID == "" && memberMatchesParticipant(message.Author, nick, participant) {
+			return participant.BaekjoonID
+		}
+	}
+	return ""
+}
+
+// linkedAuthorHandle 메시지 작성자의 디스코드 ID로 연결된 참가자의 백준 ID를 반환합니다
+// 별명은 누구나 바꿀 수 있으므로 탈퇴, 백준 ID 변경, 팀 가입처럼 참가자를 바꾸는 명령어는 이 연결만 사용합니다
+func (handler *CommandHandler) linkedAuthorHandle(message *discordgo.MessageCreate) string {
+	if message.Author == nil || message.Author.ID == "" {
+		return ""
+	}
+	if participant, found := models.FindParticipantByDiscordUser(handler.deps.Storage.GetParticipants(), message.Author.ID); found {
+		return participant.BaekjoonID
+	}
+	return ""
+}
+
+// formatScoreBreakdown 점수 내역을 페이지 단위 embed로 변환합니다
+func (handler *CommandHandler) formatScoreBreakdown(breakdown *models.ScoreBreakdown, page int) *discordgo.MessageEmbed {
+	counts, points := breakdown.CategoryTotals()
+
+	var description strings.Builder
+	description.WriteString(fmt.Sprintf(constants.MsgScoreSummary,
+		breakdown.LeagueName, models.ScoringModeLabel(breakdown.Mode), breakdown.Total, len(breakdown.Entries)))
+	description.WriteString("\n")
+	for _, category := range []string{models.ProblemCategoryChallenge, models.ProblemCategoryBase, models.ProblemCategoryPractice} {
+		description.WriteString(fmt.Sprintf("• %s: %d문제 / %.1f점\n", models.CategoryLabel(category), counts[category], points[category]))
+	}
+	if breakdown.Streak.Longest > 0 {
+		description.WriteString(fmt.Sprintf(constants.MsgScoreStreak,
+			breakdown.Streak.Current, breakdown.Streak.Longest, breakdown.Streak.Bonus))
+		description.WriteString("\n")
+	}
+	if breakdown.Growth.Improved() || breakdown.Growth.Bonus > 0 {
+		description.WriteString(fmt.Sprintf(constants.MsgScoreGrowth,
+			breakdown.Growth.RatingGain, breakdown.Growth.TierGain, breakdown.Growth.Bonus))
+		description.WriteString("\n")
+	}
+	if bonusCount, bonusExtra := breakdown.BonusTotals(); bonusCount > 0 {
+		description.WriteString(fmt.Sprintf(constants.MsgScoreBonus, bonusCount, bonusExtra))
+		description.WriteString("\n")
+	}
+	description.WriteString(fmt.Sprintf(constants.MsgScoreExcluded,
+		breakdown.ExcludedPreRegistration, breakdown.ExcludedOutsideWindow, breakdown.ExcludedUnranked))
+	if breakdown.ExcludedNotInSet > 0 {
+		description.WriteString(fmt.Sprintf(constants.MsgScoreExcludedNotInSet, breakdown.ExcludedNotInSet))
+	}
+
+	entries, page, totalPages := paginateBreakdown(breakdown.Entries, page, constants.BreakdownPageSize)
+
+	fieldValue := constants.MsgScoreNoEntries
+	if len(entries) > 0 {
+		fieldValue = "```\n" + handler.formatBreakdownEntries(entries) + "```"
+	}
+
+	footer := fmt.Sprintf(constants.MsgScorePageFooterLast, page, totalPages)
+	if page < totalPages {
+		footer = fmt.Sprintf(constants.MsgScorePageFooter, page, totalPages, breakdown.BaekjoonID, page+1)
+	}
+
+	return &discordgo.MessageEmbed{
+		Title:       fmt.Sprintf(constants.MsgScoreTitle, breakdown.BaekjoonID),
+		Description: description.String(),
+		Color:       constants.ColorTierGold,
+		Fields: []*discordgo.MessageEmbedField{
+			{Name: "문제별 내역", Value: fieldValue},
+		},
+		Footer: &discordgo.MessageEmbedFooter{Text: footer},
+	}
+}
+
+// formatBreakdownEntries 문제별 내역을 고정폭 표로 변환합니다
+func (handler *CommandHandler) formatBreakdownEntries(entries []models.BreakdownEntry) string {
+	var builder strings.Builder
+	for _, entry := range entries {
+		if entry.Label != "" {
+			builder.WriteString(fmt.Sprintf("%-3s ", entry.Label))
+		}
+		builder.WriteString(fmt.Sprintf("%-6d %-13s %s %5.1f × %.2f",
+			entry.ProblemID,
+			handler.deps.TierManager.GetTierName(entry.Level),
+			models.CategoryLabel(entry.Category),
+			entry.BasePoints,
+			entry.Weight))
+		if entry.Bonus != "" {
+			builder.WriteString(fmt.Sprintf(" × %.2f(%s)", entry.BonusMultiplier, entry.Bonus))
+		}
+		builder.WriteString(fmt.Sprintf(" = %6.2f\n", entry.Contribution))
+	}
+	return builder.String()
+}
+
+// paginateBreakdown 요청한 페이지의 문제 내역을 잘라 반환합니다 (범위를 벗어나면 마지막 페이지)
+func paginateBreakdown(entries []models.BreakdownEntry, page, pageSize int) ([]models.BreakdownEntry, int, int) {
+	totalPages := (len(entries) + pageSize - 1) / pageSize
+	if totalPages == 0 {
+		totalPages = 1
+	}
+	if page < 1 {
+		page = 1
+	}
+	if page > totalPages {
+		page = totalPages
+	}
+
+	start := (page - 1) * pageSize
+	if start >= len(entries) {
+		return nil, page, totalPages
+	}
+	end := start + pageSize
+	if end > len(entries) {
+		end = len(entries)
+	}
+	return entries[start:end], page, totalPages
+}
@@ -0,0 +1,91 @@
+package bot
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ssugameworks/kkemi/api"
+	"github.com/ssugameworks/kkemi/models"
+	"github.com/ssugameworks/kkemi/scoring"
+	"github.com/ssugameworks/kkemi/storage"
+)
+
+func TestParseScoreParams(t *testing.T) {
+	tests := []struct {
+		name       string
+		params     []string
+		wantHandle string
+		wantPage   int
+		wantOK     bool
+	}{
+		{"no params", nil, "", 1, true},
+		{"page only", []string{"2"}, "", 2, true},
+		{"handle only", []string{"testuser"}, "testuser", 1, true},
+		{"handle and page", []string{"testuser", "3"}, "testuser", 3, true},
+		{"invalid page", []string{"testuser", "0"}, "", 0, false},
+		{"invalid handle", []string{"bad-handle!"}, "", 0, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			handle, page, ok := parseScoreParams(tt.params)
+			if ok != tt.wantOK || handle != tt.wantHandle || page != tt.wantPage {
+				t.Errorf("parseScoreParams(%v) = (%q, %d, %t), want (%q, %d, %t)",
+					tt.params, handle, page, ok, tt.wantHandle, tt.wantPage, tt.wantOK)
+			}
+		})
+	}
+}
+
+func TestPaginateBreakdown(t *testing.T) {
+	entries := make([]models.BreakdownEntry, 7)
+	for i := range entries {
+		entries[i].ProblemID = 1000 + i
+	}
+
+	page, current, total := paginateBreakdown(entries, 2, 3)
+	if current != 2 || total != 3 || len(page) != 3 || page[0].ProblemID != 1003 {
+		t.Errorf("Unexpected second page: %v (page %d/%d)", page, current, total)
+	}
+
+	page, current, _ = paginateBreakdown(entries, 10, 3)
+	if current != 3 || len(page) != 1 {
+		t.Errorf("Out of range page should clamp to last page, got %d with %d entries", current, len(page))
+	}
+
+	page, current, total = paginateBreakdown(nil, 1, 3)
+	if current != 1 || total != 1 || len(page) != 0 {
+		t.Errorf("Empty breakdown should yield single empty page, got %d/%d", current, total)
+	}
+}
+
+func TestGetScoreBreakdownCountsPreRegistration(t *testing.T) {
+	client := &MockSolvedACClient{
+		userInfo: &api.UserInfo{Handle: "player", Tier: 6},
+		solved:   []api.ProblemInfo{{ProblemID: 1000, Level: 6}, {ProblemID: 1001, Level: 6}},
+	}
+	store := storage.NewInMemoryStorage(client)
+	start := time.Now().AddDate(0, 0, -1)
+	if _, err := store.CreateCompetition("test", start, start.AddDate(0, 0, 7)); err != nil {
+		t.Fatalf("Failed to create competition: %v", err)
+	}
+	if err := store.AddParticipant("홍길동", "player", 6, 0, 0, ""); err != nil {
+		t.Fatalf("Failed to add participant: %v", err)
+	}
+
+	// 등록 후 새로 푼 문제 하나
+	client.solved = append(client.solved, api.ProblemInfo{ProblemID: 1002, Level: 6})
+	tierManager := models.GetTierManager()
+	manager := NewScoreboardManager(store, scoring.NewScoreCalculator(client, tierManager), client, tierManager)
+
+	breakdown, err := manager.GetScoreBreakdown("player")
+	if err != nil {
+		t.Fatalf("Failed to get score breakdown: %v", err)
+	}
+	if breakdown.ExcludedPreRegistration != 2 {
+		t.Errorf("Expected 2 pre-registration problems, got %d", breakdown.ExcludedPreRegistration)
+	}
+	if len(breakdown.Entries) != 1 || breakdown.Entries[0].ProblemID != 1002 {
+		t.Errorf("Expected only the new problem to be scored, got %+v", breakdown.Entries)
+	}
+}
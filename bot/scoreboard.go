@@ -14,6 +14,7 @@ import (
 	"github.com/ssugameworks/kkemi/interfaces"
 	"github.com/ssugameworks/kkemi/models"
 	"github.com/ssugameworks/kkemi/performance"
+	"github.com/ssugameworks/kkemi/tracker"
 	"github.com/ssugameworks/kkemi/utils"
 
 	"github.com/bwmarrin/discordgo"
@@ -25,6 +26,8 @@ type ScoreboardManager struct {
 	client             interfaces.APIClient
 	tierManager        *models.TierManager
 	concurrencyManager *performance.AdaptiveConcurrencyManager
+	solveTracker       *tracker.SolveTracker
+	views              *scoreboardViewStore // 버튼으로 넘겨 보는 스코어보드 (대회 사본끼리 공유)
 }
 
 func NewScoreboardManager(storage interfaces.StorageRepository, calculator interfaces.ScoreCalculator, client interfaces.APIClient, tierManager *models.TierManager) *ScoreboardManager {
@@ -34,44 +37,59 @@ func NewScoreboardManager(storage interfaces.StorageRepository, calculator inter
 		client:             client,
 		tierManager:        tierManager,
 		concurrencyManager: performance.NewAdaptiveConcurrencyManager(),
+		solveTracker:       tracker.NewSolveTracker(storage, client),
+		views:              newScoreboardViewStore(),
 	}
 }
 
+// ForStorage 다른 대회를 대상으로 하는 저장소 뷰로 동작하는 사본을 반환합니다
+func (manager *ScoreboardManager) ForStorage(storage interfaces.StorageRepository) *ScoreboardManager {
+	scoped := *manager
+	scoped.storage = storage
+	scoped.solveTracker = tracker.NewSolveTracker(storage, manager.client)
+	return &scoped
+}
+
 func (manager *ScoreboardManager) GetStorage() interfaces.StorageRepository {
 	return manager.storage
 }
 
+// GetSolveTracker 해결 기록 수집기를 반환합니다 (스케줄러용)
+func (manager *ScoreboardManager) GetSolveTracker() *tracker.SolveTracker {
+	return manager.solveTracker
+}
+
 func (manager *ScoreboardManager) GenerateScoreboard(isAdmin bool) (*discordgo.MessageEmbed, error) {
+	embed, _, err := manager.generateScoreboard(isAdmin)
+	return embed, err
+}
+
+// generateScoreboard 스코어보드 embed와 함께 계산된 점수 데이터를 반환합니다 (점수를 계산하지 않았으면 nil)
+func (manager *ScoreboardManager) generateScoreboard(isAdmin bool) (*discordgo.MessageEmbed, []models.ScoreData, error) {
 	competition := manager.storage.GetCompetition()
 	if competition == nil || !competition.IsActive {
-		return nil, fmt.Errorf("활성화된 대회가 없습니다")
+		return nil, nil, fmt.Errorf("활성화된 대회가 없습니다")
 	}
 
-	// 마지막날 체크
-	now := utils.GetCurrentTimeKST()
-	isLastDay := now.Year() == competition.EndDate.Year() &&
-		now.Month() == competition.EndDate.Month() &&
-		now.Day() == competition.EndDate.Day()
-
 	// 블랙아웃 체크 (마지막날에는 공개)
-	if embed := manager.checkBlackoutPeriod(competition, isAdmin || isLastDay); embed != nil {
-		return embed, nil
+	if embed := manager.checkBlackoutPeriod(competition, isAdmin || isLastDay(competition)); embed != nil {
+		return embed, nil, nil
 	}
 
 	// 참가자 체크
 	participants := manager.storage.GetParticipants()
 	if embed := manager.checkEmptyParticipants(competition, participants); embed != nil {
-		return embed, nil
+		return embed, nil, nil
 	}
 
 	// 점수 데이터 수집
-	scores, err := manager.collectScoreData(participants)
+	scores, err := manager.collectScoreData(competition, participants)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	// 포맷팅
-	return manager.formatScoreboard(competition, scores, isAdmin), nil
+	// 포맷팅 (마지막 스냅샷 대비 순위 변화 표시)
+	return manager.formatScoreboard(competition, scores, manager.latestSnapshot()), scores, nil
 }
 
 // CollectScoreData 참가자들의 점수 데이터를 수집하여 반환합니다 (외부 접근용)
@@ -86,7 +104,102 @@ func (manager *ScoreboardManager) CollectScoreData() ([]models.ScoreData, error)
 		return []models.ScoreData{}, nil
 	}
 
-	return manager.collectScoreData(participants)
+	return manager.collectScoreData(competition, participants)
+}
+
+// CollectScoreDataWithRules 지정한 규칙으로 점수를 다시 계산합니다 (규칙 변경 미리보기용)
+func (manager *ScoreboardManager) CollectScoreDataWithRules(rules *models.ScoringRules) ([]models.ScoreData, error) {
+	competition := manager.storage.GetCompetition()
+	if competition == nil || !competition.IsActive {
+		return nil, fmt.Errorf("활성화된 대회가 없습니다")
+	}
+
+	participants := manager.storage.GetParticipants()
+	if len(participants) == 0 {
+		return []models.ScoreData{}, nil
+	}
+
+	preview := *competition
+	preview.ScoringRules = rules
+	return manager.collectScoreData(&preview, participants)
+}
+
+// GetScoreBreakdown 참가자의 문제별 점수 계산 내역을 반환합니다
+func (manager *ScoreboardManager) GetScoreBreakdown(baekjoonID string) (*models.ScoreBreakdown, error) {
+	competition := manager.storage.GetCompetition()
+	if competition == nil || !competition.IsActive {
+		return nil, fmt.Errorf("활성화된 대회가 없습니다")
+	}
+
+	participant, found := findParticipant(manager.storage.GetParticipants(), baekjoonID)
+	if !found {
+		return nil, fmt.Errorf("참가자를 찾을 수 없습니다: %s", baekjoonID)
+	}
+
+	_, breakdown, err := manager.scoreParticipant(competition, participant)
+	if err != nil {
+		return nil, err
+	}
+	return breakdown, nil
+}
+
+// IsScoreHidden 블랙아웃으로 점수를 숨겨야 하는지 확인합니다 (관리자와 마지막날은 공개)
+func (manager *ScoreboardManager) IsScoreHidden(isAdmin bool) bool {
+	competition := manager.storage.GetCompetition()
+	if competition == nil || isAdmin || isLastDay(competition) {
+		return false
+	}
+	return manager.storage.IsBlackoutPeriod()
+}
+
+// streakReferenceTime 연속 해결 기록을 판단할 기준 시각입니다 (대회 종료 후에는 마지막날로 고정)
+func streakReferenceTime(competition *models.Competition) time.Time {
+	now := utils.GetCurrentTimeKST()
+	if !now.Before(competition.ScoringWindowEnd()) {
+		return competition.EndDate
+	}
+	return now
+}
+
+// isLastDay 오늘이 대회 마지막날인지 확인합니다
+func isLastDay(competition *models.Competition) bool {
+	now := utils.GetCurrentTimeKST()
+	return now.Year() == competition.EndDate.Year() &&
+		now.Month() == competition.EndDate.Month() &&
+		now.Day() == competition.EndDate.Day()
+}
+
+// findParticipant 백준 ID로 참가자를 찾습니다 (대소문자 무시)
+func findParticipant(participants []models.Participant, baekjoonID string) (models.Participant, bool) {
+	for _, participant := range participants {
+		if strings.EqualFold(participant.BaekjoonID, baekjoonID) {
+			return participant, true
+		}
+	}
+	return models.Participant{}, false
+}
+
+// solvedTargets 문제 목록 대회에서 해결한 대상 문제 번호를 반환합니다
+func solvedTargets(competition *models.Competition, breakdown *models.ScoreBreakdown) []int {
+	if !competition.IsProblemSet() {
+		return nil
+	}
+	solved := make([]int, 0, len(breakdown.Entries))
+	for _, entry := range breakdown.Entries {
+		solved = append(solved, entry.ProblemID)
+	}
+	return solved
+}
+
+// formatGrowth 참가자의 레이팅 상승폭과 티어 변화를 한 줄로 표시합니다
+func (manager *ScoreboardManager) formatGrowth(score models.ScoreData) string {
+	text := fmt.Sprintf("`%s` %+d", score.BaekjoonID, score.Growth.RatingGain)
+	if score.Growth.TierGain > 0 {
+		text += fmt.Sprintf(" (%s → %s)",
+			manager.tierManager.GetTierName(score.StartTier),
+			manager.tierManager.GetTierName(score.CurrentTier))
+	}
+	return text
 }
 
 // checkBlackoutPeriod 블랙아웃 기간인지 확인하고 해당 embed 반환
@@ -114,7 +227,7 @@ func (manager *ScoreboardManager) checkEmptyParticipants(competition *models.Com
 }
 
 // collectScoreData 참가자들의 점수 데이터를 병렬로 수집합니다
-func (manager *ScoreboardManager) collectScoreData(participants []models.Participant) ([]models.ScoreData, error) {
+func (manager *ScoreboardManager) collectScoreData(competition *models.Competition, participants []models.Participant) ([]models.ScoreData, error) {
 	if len(participants) == 0 {
 		return []models.ScoreData{}, nil
 	}
@@ -142,7 +255,7 @@ func (manager *ScoreboardManager) collectScoreData(participants []models.Partici
 			defer func() { <-semaphore }()
 
 			startTime := time.Now()
-			scoreData, err := manager.calculateParticipantScore(p)
+			scoreData, err := manager.calculateParticipantScore(competition, p)
 			responseTime := time.Since(startTime)
 
 			// 응답 시간을 적응형 동시성 관리자에 기록
@@ -173,29 +286,81 @@ func (manager *ScoreboardManager) collectScoreData(participants []models.Partici
 	// 결과 복사본 생성 (메모리 풀의 슬라이스는 재사용되므로)
 	result := make([]models.ScoreData, len(scores))
 	copy(result, scores)
+
+	manager.assignTeams(result)
 	return result, nil
 }
 
+// assignTeams 점수 데이터에 소속 팀 이름을 표시합니다
+func (manager *ScoreboardManager) assignTeams(scores []models.ScoreData) {
+	teams := manager.storage.GetTeams()
+	if len(teams) == 0 {
+		return
+	}
+	for i := range scores {
+		if team, ok := models.TeamOf(teams, scores[i].BaekjoonID); ok {
+			scores[i].Team = team.Name
+		}
+	}
+}
+
+// TeamScores 현재 대회의 팀 집계 규칙으로 팀 점수를 계산합니다 (팀이 없으면 nil)
+func (manager *ScoreboardManager) TeamScores(scores []models.ScoreData) ([]models.TeamScore, models.TeamRule) {
+	competition := manager.storage.GetCompetition()
+	rule := manager.calculator.ForCompetition(competition).GetRules().TeamRuleOrDefault()
+
+	teams := manager.storage.GetTeams()
+	if len(teams) == 0 {
+		return nil, rule
+	}
+	return models.AggregateTeamScores(teams, scores, rule), rule
+}
+
 // calculateParticipantScore 개별 참가자의 점수를 계산합니다
-func (manager *ScoreboardManager) calculateParticipantScore(participant models.Participant) (models.ScoreData, error) {
+// 최초 발견 시각이 대회 기간 안에 있는 문제만 점수에 반영합니다
+func (manager *ScoreboardManager) calculateParticipantScore(competition *models.Competition, participant models.Participant) (models.ScoreData, error) {
+	scoreData, _, err := manager.scoreParticipant(competition, participant)
+	return scoreData, err
+}
+
+// scoreParticipant 참가자의 점수 데이터와 문제별 계산 내역을 함께 계산합니다
+func (manager *ScoreboardManager) scoreParticipant(competition *models.Competition, participant models.Participant) (models.ScoreData, *models.ScoreBreakdown, error) {
 	ctx := context.Background()
 	userInfo, err := manager.client.GetUserInfo(ctx, participant.BaekjoonID)
 	if err != nil {
-		return models.ScoreData{}, err
+		return models.ScoreData{}, nil, err
 	}
 
-	top100, err := manager.client.GetUserTop100(ctx, participant.BaekjoonID)
+	// 스코어보드 조회도 하나의 관측이므로 처음 보는 문제는 지금 시각으로 기록합니다
+	allSolved, firstSeen, err := manager.solveTracker.Track(ctx, participant)
 	if err != nil {
-		return models.ScoreData{}, err
+		return models.ScoreData{}, nil, err
 	}
-
-	rawScore := manager.calculator.CalculateScoreWithTop100(top100, participant.StartTier, participant.StartProblemIDs)
+	calculator := manager.calculator.ForCompetition(competition)
+	solved := calculator.FilterSolvesInWindow(allSolved, firstSeen, competition)
+
+	startProblemIDs := participant.GetStartProblemIDs()
+	breakdown := calculator.CalculateBreakdown(solved, participant.StartTier, startProblemIDs)
+	breakdown.BaekjoonID = participant.BaekjoonID
+	rawScore := breakdown.Total
+	// 연속 해결 보너스는 문제 점수에 그대로 더합니다
+	streak := calculator.CalculateStreak(solved, firstSeen, startProblemIDs, streakReferenceTime(competition))
+	rawScore += streak.Bonus
+	// 레이팅 성장 점수 (규칙에 growth가 있을 때만 0이 아님)
+	growth := calculator.CalculateGrowth(participant.StartTier, participant.StartRating, userInfo.Tier, userInfo.Rating)
+	rawScore += growth.Bonus
 	roundedScore := math.Round(rawScore)
 
-	newProblemCount := top100.Count - participant.StartProblemCount
-	if newProblemCount < 0 {
-		newProblemCount = 0
-	}
+	newProblemCount := calculator.CountNewProblems(solved, startProblemIDs)
+	league := calculator.GetUserLeague(participant.StartTier)
+
+	// 대회 기간 밖에서 처음 발견되어 제외된 문제 수
+	breakdown.ExcludedOutsideWindow = calculator.CountNewProblems(allSolved, startProblemIDs) - newProblemCount
+	// 스냅샷 문제는 기간 필터에서 이미 빠지므로 필터링 전 목록으로 셉니다
+	breakdown.ExcludedPreRegistration = calculator.CountPreRegistration(allSolved, startProblemIDs)
+	breakdown.Streak = streak
+	breakdown.Growth = growth
+	breakdown.Total = math.Round(breakdown.RawTotal + streak.Bonus + growth.Bonus)
 
 	return models.ScoreData{
 		ParticipantID: participant.ID,
@@ -203,11 +368,17 @@ func (manager *ScoreboardManager) calculateParticipantScore(participant models.P
 		BaekjoonID:    participant.BaekjoonID,
 		Score:         roundedScore,
 		RawScore:      rawScore,
-		League:        manager.calculator.GetUserLeague(participant.StartTier),
+		League:        league,
+		LeagueName:    calculator.GetLeagueName(league),
 		CurrentTier:   userInfo.Tier,
 		CurrentRating: userInfo.Rating,
 		ProblemCount:  newProblemCount,
-	}, nil
+		Streak:        streak,
+		StartTier:     participant.StartTier,
+		StartRating:   participant.StartRating,
+		Growth:        growth,
+		SolvedTargets: solvedTargets(competition, breakdown),
+	}, breakdown, nil
 }
 
 // groupScoresByLeague 참가자들을 리그별로 분류하고 점수 순으로 정렬합니다
@@ -233,8 +404,26 @@ func (manager *ScoreboardManager) groupScoresByLeague(scores []models.ScoreData)
 	return leagueScores
 }
 
+// rankScores 리그별 순위를 계산합니다 (동점자는 같은 순위, key: BaekjoonID)
+func (manager *ScoreboardManager) rankScores(scores []models.ScoreData) map[string]int {
+	ranks := make(map[string]int, len(scores))
+	for _, leagueScores := range manager.groupScoresByLeague(scores) {
+		var lastRawScore float64 = -1.0
+		var rank int
+		for i, score := range leagueScores {
+			if score.RawScore != lastRawScore {
+				rank = i + 1
+			}
+			ranks[score.BaekjoonID] = rank
+			lastRawScore = score.RawScore
+		}
+	}
+	return ranks
+}
+
 // formatScoreboard 점수 데이터를 포맷팅하여 Discord 임베드 메시지로 반환합니다
-func (manager *ScoreboardManager) formatScoreboard(competition *models.Competition, scores []models.ScoreData, isAdmin bool) *discordgo.MessageEmbed {
+// previous가 있으면 그 스냅샷 대비 순위 변화를 화살표로 표시합니다
+func (manager *ScoreboardManager) formatScoreboard(competition *models.Competition, scores []models.ScoreData, previous *models.ScoreSnapshot) *discordgo.MessageEmbed {
 	embed := &discordgo.MessageEmbed{
 		Title: fmt.Sprintf(constants.MsgScoreboardTitle, competition.Name),
 		Description: fmt.Sprintf("%s ~ %s",
@@ -248,18 +437,26 @@ func (manager *ScoreboardManager) formatScoreboard(competition *models.Competiti
 		return embed
 	}
 
+	// 문제 목록 대회는 리그 대신 문제별 해결 표로 표시
+	if competition.IsProblemSet() {
+		embed.Description += "\n" + formatProblemMatrix(competition.Problems, scores)
+		embed.Description += manager.formatTeamSection(scores)
+		embed.Footer = &discordgo.MessageEmbedFooter{Text: manager.scoreboardFooter(competition)}
+		return embed
+	}
+
 	leagueScores := manager.groupScoresByLeague(scores)
 
 	var builder strings.Builder
 
-	leagueOrder := []int{constants.LeagueRookie, constants.LeaguePro, constants.LeagueMaster}
-
-	for _, league := range leagueOrder {
+	// 대회 규칙에 정의된 리그를 낮은 티어부터 표시
+	for _, rule := range manager.calculator.ForCompetition(competition).GetRules().SortedLeagues() {
+		league := rule.ID
 		if len(leagueScores[league]) == 0 {
 			continue
 		}
 
-		leagueName := manager.calculator.GetLeagueName(league)
+		leagueName := rule.Name
 		builder.WriteString(fmt.Sprintf("\n**🏆 %s 리그**\n", leagueName))
 		builder.WriteString("```\n")
 		builder.WriteString(fmt.Sprintf("%-*s %-*s %*s\n",
@@ -274,38 +471,81 @@ func (manager *ScoreboardManager) formatScoreboard(competition *models.Competiti
 			if score.RawScore != lastRawScore {
 				rank = i + 1
 			}
-			builder.WriteString(fmt.Sprintf("%-*d  %-*s %*.0f\n",
-				constants.ScoreboardRankWidth, rank,
-				constants.ScoreboardNameWidth, utils.TruncateString(score.BaekjoonID, constants.ScoreboardNameWidth),
-				constants.ScoreboardScoreWidth, score.Score))
+			builder.WriteString(formatLeagueRow(rank, score, formatRankChange(previous, score.BaekjoonID, league, rank)) + "\n")
 			lastRawScore = score.RawScore
 		}
 		builder.WriteString("```\n")
+
+		// 리그별 성장 순위 (티어 상승 우선)
+		if improved := models.MostImproved(leagueScores[league], constants.MaxMostImprovedPerLeague); len(improved) > 0 {
+			builder.WriteString(constants.MsgScoreboardMostImproved)
+			for _, score := range improved {
+				builder.WriteString(" " + manager.formatGrowth(score))
+			}
+			builder.WriteString("\n")
+		}
 	}
 
+	builder.WriteString(manager.formatTeamSection(scores))
 	embed.Description += builder.String()
 
+	embed.Footer = &discordgo.MessageEmbedFooter{Text: manager.scoreboardFooter(competition)}
+
+	return embed
+}
+
+// formatTeamSection 팀이 있으면 리그 순위 아래에 붙일 팀 순위 섹션을 만듭니다
+func (manager *ScoreboardManager) formatTeamSection(scores []models.ScoreData) string {
+	teamScores, rule := manager.TeamScores(scores)
+	if len(teamScores) == 0 {
+		return ""
+	}
+	return fmt.Sprintf(constants.MsgScoreboardTeamHeader, rule.Label()) + formatTeamScoreboard(teamScores)
+}
+
+// scoreboardFooter 점수 산정 방식과 블랙아웃 예고를 담은 푸터 문구를 만듭니다
+func (manager *ScoreboardManager) scoreboardFooter(competition *models.Competition) string {
+	var footer string
+	if competition.IsProblemSet() {
+		footer = fmt.Sprintf(constants.MsgScoreboardModeFooter, fmt.Sprintf(constants.MsgProblemSetModeLabel, len(competition.Problems)))
+	} else {
+		mode := manager.calculator.ForCompetition(competition).GetRules().EffectiveMode()
+		footer = fmt.Sprintf(constants.MsgScoreboardModeFooter, models.ScoringModeLabel(mode))
+	}
+
 	now := utils.GetCurrentTimeKST()
 	if now.Before(competition.BlackoutStartDate) {
 		daysLeft := int(competition.BlackoutStartDate.Sub(now).Hours() / 24)
-		embed.Footer = &discordgo.MessageEmbedFooter{
-			Text: fmt.Sprintf(constants.MsgScoreboardBlackoutWarning, daysLeft),
-		}
+		footer += " · " + fmt.Sprintf(constants.MsgScoreboardBlackoutWarning, daysLeft)
 	}
-
-	return embed
+	return footer
 }
 
 // SendDailyScoreboard 매일 스코어보드를 지정된 채널에 전송합니다
 func (manager *ScoreboardManager) SendDailyScoreboard(session *discordgo.Session, channelID string) error {
-	embed, err := manager.GenerateScoreboard(false) // 자동 스코어보드는 관리자 권한 없음
+	embed, scores, err := manager.generateScoreboard(false) // 자동 스코어보드는 관리자 권한 없음
 	if err != nil {
 		return err
 	}
 
-	_, err = session.ChannelMessageSendEmbed(channelID, embed)
+	// 다음 스코어보드의 순위 변화와 점수 추이를 위해 스냅샷 저장 (방금 저장한 스냅샷까지 차트에 포함)
+	var chart []byte
+	if scores != nil {
+		manager.saveSnapshot(scores)
+		chart = manager.scoreboardChart(nil)
+	}
+
+	err = SendScoreboardEmbed(session, channelID, embed, chart)
 	if err != nil {
 		utils.Error("DISCORD API ERROR: Failed to send daily scoreboard: %v", err)
+		return err
 	}
-	return err
+
+	// 어제 끊긴 잔디 알림
+	if brokenEmbed := formatBrokenStreaks(scores); brokenEmbed != nil {
+		if _, err := session.ChannelMessageSendEmbed(channelID, brokenEmbed); err != nil {
+			utils.Error("DISCORD API ERROR: Failed to send broken streaks: %v", err)
+		}
+	}
+	return nil
 }
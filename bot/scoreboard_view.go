@@ -0,0 +1,543 @@
+package bot
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+	"unicode/utf8"
+
+	"github.com/ssugameworks/kkemi/constants"
+	"github.com/ssugameworks/kkemi/models"
+	"github.com/ssugameworks/kkemi/utils"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+// splitLineMargin 설명을 나눌 때 코드 블록을 다시 열고 닫는 데 남겨 두는 글자 수
+const splitLineMargin = 32
+
+// scoreboardComponentPrefix 스코어보드 버튼과 선택 메뉴의 CustomID 접두사 (`sb|<뷰ID>|<동작>|...`)
+const scoreboardComponentPrefix = "sb"
+
+// scoreboardView 버튼으로 넘겨 보는 스코어보드 한 건의 상태입니다 (만료 후에는 버튼이 동작하지 않음)
+type scoreboardView struct {
+	id            string
+	competitionID string
+	title         string
+	dateRange     string
+	footer        string
+	leagues       []scoreboardLeague
+	teamPages     []string // 팀이 있으면 리그 뒤의 마지막 구역으로 표시 (설명 길이 제한에 맞춰 나눈 페이지)
+	expiresAt     time.Time
+}
+
+// scoreboardLeague 스코어보드 뷰의 리그 한 구역입니다 (rows는 순위순으로 미리 만든 표 행)
+type scoreboardLeague struct {
+	name     string
+	ids      []string
+	rows     []string
+	improved string
+}
+
+// scoreboardViewStore 만료 시간이 있는 스코어보드 뷰 저장소입니다 (메모리에만 보관)
+type scoreboardViewStore struct {
+	mu     sync.Mutex
+	views  map[string]*scoreboardView
+	nextID int64
+}
+
+// newScoreboardViewStore 빈 스코어보드 뷰 저장소를 만듭니다
+func newScoreboardViewStore() *scoreboardViewStore {
+	return &scoreboardViewStore{views: make(map[string]*scoreboardView)}
+}
+
+// add 뷰에 ID와 만료 시각을 붙여 저장하고, 만료된 뷰는 정리합니다
+func (store *scoreboardViewStore) add(view *scoreboardView, now time.Time) {
+	store.mu.Lock()
+	defer store.mu.Unlock()
+
+	for id, existing := range store.views {
+		if now.After(existing.expiresAt) {
+			delete(store.views, id)
+		}
+	}
+	store.nextID++
+	view.id = strconv.FormatInt(now.UnixNano(), 36) + strconv.FormatInt(store.nextID, 36)
+	view.expiresAt = now.Add(constants.ScoreboardViewTTL)
+	store.views[view.id] = view
+}
+
+// get 만료되지 않은 뷰를 반환합니다
+func (store *scoreboardViewStore) get(id string, now time.Time) (*scoreboardView, bool) {
+	store.mu.Lock()
+	defer store.mu.Unlock()
+
+	view, ok := store.views[id]
+	if !ok || now.After(view.expiresAt) {
+		return nil, false
+	}
+	return view, true
+}
+
+// GenerateScoreboardMessages 전송할 스코어보드 메시지를 만듭니다
+// 리그 대회는 페이지 버튼과 리그 선택 메뉴가 달린 첫 페이지를, 그 외(블랙아웃 안내, 문제 목록 대회)는 길이 제한에 맞게 나눈 embed를 반환합니다
+func (manager *ScoreboardManager) GenerateScoreboardMessages(isAdmin bool) ([]*discordgo.MessageSend, error) {
+	embed, scores, err := manager.generateScoreboard(isAdmin)
+	if err != nil {
+		return nil, err
+	}
+
+	competition := manager.storage.GetCompetition()
+	if len(scores) == 0 || competition == nil || competition.IsProblemSet() || manager.views == nil {
+		return embedMessages(embed, nil), nil
+	}
+
+	view := manager.newScoreboardView(competition, scores, manager.latestSnapshot())
+	if view.sectionCount() == 0 {
+		return embedMessages(embed, nil), nil
+	}
+	manager.views.add(view, time.Now())
+	chart := manager.scoreboardChart(scores)
+
+	pageEmbed, components := view.render(0, 0, "")
+	message := &discordgo.MessageSend{
+		Embeds:     []*discordgo.MessageEmbed{pageEmbed},
+		Components: components,
+	}
+	attachChart(message, chart)
+	return []*discordgo.MessageSend{message}, nil
+}
+
+// newScoreboardView 리그별 순위표를 페이지로 나눌 수 있게 미리 만들어 둡니다
+func (manager *ScoreboardManager) newScoreboardView(competition *models.Competition, scores []models.ScoreData, previous *models.ScoreSnapshot) *scoreboardView {
+	view := &scoreboardView{
+		competitionID: competition.ID,
+		title:         fmt.Sprintf(constants.MsgScoreboardTitle, competition.Name),
+		dateRange: fmt.Sprintf("%s ~ %s",
+			competition.StartDate.Format(constants.DateFormat),
+			competition.EndDate.Format(constants.DateFormat)),
+		footer: manager.scoreboardFooter(competition),
+	}
+	view.teamPages = splitTeamSection(view.dateRange, manager.formatTeamSection(scores))
+
+	leagueScores := manager.groupScoresByLeague(scores)
+	for _, rule := range manager.calculator.ForCompetition(competition).GetRules().SortedLeagues() {
+		if len(leagueScores[rule.ID]) == 0 {
+			continue
+		}
+
+		league := scoreboardLeague{name: rule.Name}
+		var lastRawScore float64 = -1.0
+		var rank int
+		for i, score := range leagueScores[rule.ID] {
+			if score.RawScore != lastRawScore {
+				rank = i + 1
+			}
+			lastRawScore = score.RawScore
+			league.ids = append(league.ids, score.BaekjoonID)
+			league.rows = append(league.rows, formatLeagueRow(rank, score, formatRankChange(previous, score.BaekjoonID, rule.ID, rank)))
+		}
+		if improved := models.MostImproved(leagueScores[rule.ID], constants.MaxMostImprovedPerLeague); len(improved) > 0 {
+			league.improved = constants.MsgScoreboardMostImproved
+			for _, score := range improved {
+				league.improved += " " + manager.formatGrowth(score)
+			}
+		}
+		view.leagues = append(view.leagues, league)
+	}
+	return view
+}
+
+// splitTeamSection 팀 순위 구역을 기간 줄과 함께 embed 설명 길이 제한을 넘지 않는 페이지로 나눕니다 (팀이 없으면 nil)
+func splitTeamSection(dateRange, section string) []string {
+	if section == "" {
+		return nil
+	}
+	return splitEmbedDescription(section, constants.MaxEmbedDescriptionLength-utf8.RuneCountInString(dateRange+"\n"))
+}
+
+// formatLeagueRow 리그 순위표의 한 행을 만듭니다
+func formatLeagueRow(rank int, score models.ScoreData, change string) string {
+	return fmt.Sprintf("%-*d  %-*s %*.0f%s",
+		constants.ScoreboardRankWidth, rank,
+		constants.ScoreboardNameWidth, utils.TruncateString(score.BaekjoonID, constants.ScoreboardNameWidth),
+		constants.ScoreboardScoreWidth, score.Score,
+		change)
+}
+
+// sectionCount 리그 구역 수에 팀 구역을 더한 전체 구역 수를 반환합니다
+func (view *scoreboardView) sectionCount() int {
+	if len(view.teamPages) > 0 {
+		return len(view.leagues) + 1
+	}
+	return len(view.leagues)
+}
+
+// pageCount 구역의 페이지 수를 반환합니다
+func (view *scoreboardView) pageCount(section int) int {
+	if section >= len(view.leagues) {
+		return len(view.teamPages)
+	}
+	return (len(view.leagues[section].rows) + constants.ScoreboardPageSize - 1) / constants.ScoreboardPageSize
+}
+
+// find 백준 ID가 있는 리그 구역과 페이지를 찾습니다 (대소문자 무시)
+func (view *scoreboardView) find(baekjoonID string) (section, page int, ok bool) {
+	for i, league := range view.leagues {
+		for j, id := range league.ids {
+			if strings.EqualFold(id, baekjoonID) {
+				return i, j / constants.ScoreboardPageSize, true
+			}
+		}
+	}
+	return 0, 0, false
+}
+
+// render 구역의 한 페이지를 embed와 버튼·선택 메뉴로 만듭니다 (highlight 참가자의 행에 표시)
+func (view *scoreboardView) render(section, page int, highlight string) (*discordgo.MessageEmbed, []discordgo.MessageComponent) {
+	section = clamp(section, 0, view.sectionCount()-1)
+	pages := view.pageCount(section)
+	page = clamp(page, 0, pages-1)
+
+	var builder strings.Builder
+	builder.WriteString(view.dateRange + "\n")
+	sectionName := constants.MsgScoreboardTeamSection
+	if section < len(view.leagues) {
+		league := view.leagues[section]
+		sectionName = fmt.Sprintf(constants.MsgScoreboardLeagueSection, league.name)
+
+		builder.WriteString(fmt.Sprintf(constants.MsgScoreboardLeagueHeader, league.name, len(league.rows)))
+		builder.WriteString("```\n")
+		builder.WriteString(fmt.Sprintf("%-*s %-*s %*s\n",
+			constants.ScoreboardRankWidth, "순위",
+			constants.ScoreboardNameWidth, "아이디",
+			constants.ScoreboardScoreWidth, "점수"))
+		builder.WriteString(constants.ScoreboardSeparator + "\n")
+		end := (page + 1) * constants.ScoreboardPageSize
+		if end > len(league.rows) {
+			end = len(league.rows)
+		}
+		for i := page * constants.ScoreboardPageSize; i < end; i++ {
+			builder.WriteString(league.rows[i])
+			if highlight != "" && strings.EqualFold(league.ids[i], highlight) {
+				builder.WriteString(constants.MsgScoreboardHighlight)
+			}
+			builder.WriteString("\n")
+		}
+		builder.WriteString("```\n")
+		if league.improved != "" {
+			builder.WriteString(league.improved + "\n")
+		}
+	} else {
+		builder.WriteString(view.teamPages[page])
+	}
+
+	embed := &discordgo.MessageEmbed{
+		Title:       view.title,
+		Description: builder.String(),
+		Color:       constants.ColorTierGold,
+		Footer: &discordgo.MessageEmbedFooter{Text: fmt.Sprintf(constants.MsgScoreboardPageFooter,
+			sectionName, page+1, pages, view.footer)},
+	}
+	return embed, view.components(section, page, pages)
+}
+
+// components 리그 선택 메뉴와 이전/다음/내 순위 버튼을 만듭니다
+func (view *scoreboardView) components(section, page, pages int) []discordgo.MessageComponent {
+	options := make([]discordgo.SelectMenuOption, 0, view.sectionCount())
+	for i, league := range view.leagues {
+		options = append(options, discordgo.SelectMenuOption{
+			Label:   fmt.Sprintf(constants.MsgScoreboardLeagueSection, league.name),
+			Value:   strconv.Itoa(i),
+			Default: i == section,
+		})
+	}
+	if len(view.teamPages) > 0 {
+		options = append(options, discordgo.SelectMenuOption{
+			Label:   constants.MsgScoreboardTeamSection,
+			Value:   strconv.Itoa(len(view.leagues)),
+			Default: section == len(view.leagues),
+		})
+	}
+
+	return []discordgo.MessageComponent{
+		discordgo.ActionsRow{Components: []discordgo.MessageComponent{
+			discordgo.SelectMenu{
+				CustomID:    view.customID("section"),
+				Placeholder: constants.MsgScoreboardSelectPlaceholder,
+				Options:     options,
+			},
+		}},
+		discordgo.ActionsRow{Components: []discordgo.MessageComponent{
+			discordgo.Button{
+				Label:    constants.MsgScoreboardPrevButton,
+				Style:    discordgo.SecondaryButton,
+				CustomID: view.customID("page", strconv.Itoa(section), strconv.Itoa(page-1)),
+				Disabled: page == 0,
+			},
+			discordgo.Button{
+				Label:    constants.MsgScoreboardNextButton,
+				Style:    discordgo.SecondaryButton,
+				CustomID: view.customID("page", strconv.Itoa(section), strconv.Itoa(page+1)),
+				Disabled: page >= pages-1,
+			},
+			discordgo.Button{
+				Label:    constants.MsgScoreboardFindMeButton,
+				Style:    discordgo.PrimaryButton,
+				CustomID: view.customID("me"),
+			},
+		}},
+	}
+}
+
+// customID 뷰의 컴포넌트 CustomID를 만듭니다
+func (view *scoreboardView) customID(action string, args ...string) string {
+	return strings.Join(append([]string{scoreboardComponentPrefix, view.id, action}, args...), "|")
+}
+
+// isScoreboardComponent 스코어보드 뷰의 버튼이나 선택 메뉴인지 확인합니다
+func isScoreboardComponent(customID string) bool {
+	return strings.HasPrefix(customID, scoreboardComponentPrefix+"|")
+}
+
+// handleScoreboardComponent 스코어보드 페이지 이동, 리그 전환, 내 순위 찾기를 처리합니다
+func (handler *CommandHandler) handleScoreboardComponent(session *discordgo.Session, interaction *discordgo.InteractionCreate) {
+	data := interaction.MessageComponentData()
+	parts := strings.Split(data.CustomID, "|")
+	if len(parts) < 3 || handler.deps.ScoreboardManager == nil || handler.deps.ScoreboardManager.views == nil {
+		return
+	}
+
+	view, ok := handler.deps.ScoreboardManager.views.get(parts[1], time.Now())
+	if !ok {
+		respondEphemeral(session, interaction, constants.MsgScoreboardViewExpired)
+		return
+	}
+
+	var section, page int
+	switch parts[2] {
+	case "page":
+		if len(parts) != 5 {
+			return
+		}
+		section, _ = strconv.Atoi(parts[3])
+		page, _ = strconv.Atoi(parts[4])
+	case "section":
+		if len(data.Values) == 0 {
+			return
+		}
+		section, _ = strconv.Atoi(data.Values[0])
+	case "me":
+		// 다른 사람이 보는 화면은 그대로 두고, 내 순위가 있는 페이지를 본인에게만 보여줍니다
+		message := interactionMessage(interaction, "", nil)
+		if message.Author == nil {
+			return
+		}
+		handle := handler.forCompetition(view.competitionID).resolveAuthorHandle(message)
+		section, page, found := view.find(handle)
+		if handle == "" || !found {
+			respondEphemeral(session, interaction, constants.MsgScoreboardViewNotFound)
+			return
+		}
+		embed, _ := view.render(section, page, handle)
+		err := session.InteractionRespond(interaction.Interaction, &discordgo.InteractionResponse{
+			Type: discordgo.InteractionResponseChannelMessageWithSource,
+			Data: &discordgo.InteractionResponseData{
+				Embeds: []*discordgo.MessageEmbed{embed},
+				Flags:  discordgo.MessageFlagsEphemeral,
+			},
+		})
+		if err != nil {
+			utils.Error("DISCORD API ERROR: Failed to send scoreboard row: %v", err)
+		}
+		return
+	default:
+		return
+	}
+
+	embed, components := view.render(section, page, "")
+	err := session.InteractionRespond(interaction.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseUpdateMessage,
+		Data: &discordgo.InteractionResponseData{
+			Embeds:     []*discordgo.MessageEmbed{embed},
+			Components: components,
+		},
+	})
+	if err != nil {
+		utils.Error("DISCORD API ERROR: Failed to update scoreboard page: %v", err)
+	}
+}
+
+// respondEphemeral 상호작용에 본인에게만 보이는 메시지로 응답합니다
+func respondEphemeral(session *discordgo.Session, interaction *discordgo.InteractionCreate, content string) {
+	err := session.InteractionRespond(interaction.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseChannelMessageWithSource,
+		Data: &discordgo.InteractionResponseData{Content: content, Flags: discordgo.MessageFlagsEphemeral},
+	})
+	if err != nil {
+		utils.Error("DISCORD API ERROR: Failed to respond to interaction: %v", err)
+	}
+}
+
+// SendScoreboardEmbed 긴 embed를 디스코드 길이 제한에 맞게 나눠 전송합니다 (차트가 있으면 첫 메시지에 첨부)
+func SendScoreboardEmbed(session *discordgo.Session, channelID string, embed *discordgo.MessageEmbed, chart []byte) error {
+	messages := embedMessages(embed, nil)
+	attachChart(messages[0], chart)
+	return sendMessages(session, channelID, messages)
+}
+
+// sendMessages 메시지를 순서대로 전송합니다 (하나라도 실패하면 중단)
+func sendMessages(session *discordgo.Session, channelID string, messages []*discordgo.MessageSend) error {
+	for _, message := range messages {
+		if _, err := session.ChannelMessageSendComplex(channelID, message); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// attachChart 차트가 있으면 메시지에 첨부하고 첫 embed의 이미지로 표시합니다
+func attachChart(message *discordgo.MessageSend, chart []byte) {
+	if chart == nil {
+		return
+	}
+	if len(message.Embeds) > 0 {
+		message.Embeds[0].Image = &discordgo.MessageEmbedImage{URL: "attachment://" + constants.ChartFileName}
+	}
+	message.Files = append(message.Files, &discordgo.File{
+		Name:        constants.ChartFileName,
+		ContentType: "image/png",
+		Reader:      bytes.NewReader(chart),
+	})
+}
+
+// embedMessages embed 설명을 MaxEmbedDescriptionLength 단위로 나누고, 메시지당 embed 수와 전체 글자 수 제한에 맞게 묶습니다
+// 제목은 첫 embed에, 푸터는 마지막 embed에만 붙입니다
+func embedMessages(embed *discordgo.MessageEmbed, components []discordgo.MessageComponent) []*discordgo.MessageSend {
+	parts := splitEmbedDescription(embed.Description, constants.MaxEmbedDescriptionLength)
+	embeds := make([]*discordgo.MessageEmbed, 0, len(parts))
+	for i, part := range parts {
+		chunk := &discordgo.MessageEmbed{Description: part, Color: embed.Color}
+		if i == 0 {
+			chunk.Title = embed.Title
+			chunk.URL = embed.URL
+			chunk.Fields = embed.Fields
+		}
+		if i == len(parts)-1 {
+			chunk.Footer = embed.Footer
+		}
+		embeds = append(embeds, chunk)
+	}
+
+	var messages []*discordgo.MessageSend
+	var current *discordgo.MessageSend
+	var total int
+	for _, chunk := range embeds {
+		length := embedLength(chunk)
+		if current == nil || len(current.Embeds) >= constants.MaxEmbedsPerMessage || total+length > constants.MaxMessageEmbedLength {
+			current = &discordgo.MessageSend{}
+			messages = append(messages, current)
+			total = 0
+		}
+		current.Embeds = append(current.Embeds, chunk)
+		total += length
+	}
+	messages[len(messages)-1].Components = components
+	return messages
+}
+
+// embedLength 디스코드가 메시지 전체 제한(6000자)에 합산하는 embed 글자 수를 반환합니다
+func embedLength(embed *discordgo.MessageEmbed) int {
+	length := utf8.RuneCountInString(embed.Title) + utf8.RuneCountInString(embed.Description)
+	if embed.Footer != nil {
+		length += utf8.RuneCountInString(embed.Footer.Text)
+	}
+	for _, field := range embed.Fields {
+		length += utf8.RuneCountInString(field.Name) + utf8.RuneCountInString(field.Value)
+	}
+	return length
+}
+
+// splitEmbedDescription 설명을 줄 단위로 limit 글자 이하 조각으로 나눕니다
+// 코드 블록 중간에서 나뉘면 앞 조각을 닫고 다음 조각에서 같은 코드 블록을 다시 엽니다
+func splitEmbedDescription(description string, limit int) []string {
+	if utf8.RuneCountInString(description) <= limit {
+		return []string{description}
+	}
+
+	const fence = "```"
+	var parts []string
+	var builder strings.Builder
+	length := 0
+	openFence := "" // 현재 열려 있는 코드 블록의 시작 줄 (예: ```ansi)
+
+	flush := func() {
+		part := builder.String()
+		if openFence != "" {
+			part += fence
+		}
+		parts = append(parts, part)
+		builder.Reset()
+		length = 0
+		if openFence != "" {
+			builder.WriteString(openFence + "\n")
+			length = utf8.RuneCountInString(openFence) + 1
+		}
+	}
+
+	// 다시 여는 코드 블록 시작 줄과 닫는 줄이 들어갈 여유를 남기고 긴 줄은 글자 단위로 자릅니다
+	for _, line := range splitLongLines(strings.SplitAfter(description, "\n"), limit-splitLineMargin) {
+		reserve := 0
+		if openFence != "" {
+			reserve = len(fence)
+		}
+		lineLength := utf8.RuneCountInString(line)
+		if length > 0 && length+lineLength+reserve > limit {
+			flush()
+		}
+		builder.WriteString(line)
+		length += lineLength
+
+		if trimmed := strings.TrimSpace(line); strings.HasPrefix(trimmed, fence) {
+			if openFence == "" {
+				openFence = trimmed
+			} else {
+				openFence = ""
+			}
+		}
+	}
+	if length > 0 {
+		parts = append(parts, builder.String())
+	}
+	return parts
+}
+
+// splitLongLines maxLength 글자보다 긴 줄을 여러 줄로 자릅니다
+func splitLongLines(lines []string, maxLength int) []string {
+	result := make([]string, 0, len(lines))
+	for _, line := range lines {
+		runes := []rune(line)
+		for len(runes) > maxLength {
+			result = append(result, string(runes[:maxLength]))
+			runes = runes[maxLength:]
+		}
+		if len(runes) > 0 {
+			result = append(result, string(runes))
+		}
+	}
+	return result
+}
+
+// clamp 값을 [low, high] 범위로 제한합니다
+func clamp(value, low, high int) int {
+	if value > high {
+		value = high
+	}
+	if value < low {
+		value = low
+	}
+	return value
+}
@@ -0,0 +1,191 @@
+package bot
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+	"unicode/utf8"
+
+	"github.com/ssugameworks/kkemi/constants"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+func TestSplitEmbedDescription(t *testing.T) {
+	var builder strings.Builder
+	builder.WriteString("2025-03-01 ~ 2025-03-31\n")
+	for league := 0; league < 3; league++ {
+		builder.WriteString(fmt.Sprintf("\n**🏆 리그 %d**\n```\n", league))
+		for i := 0; i < 60; i++ {
+			builder.WriteString(fmt.Sprintf("%-4d  %-15s %6d ▲1\n", i+1, fmt.Sprintf("user%d_%d", league, i), 100-i))
+		}
+		builder.WriteString("```\n")
+	}
+	description := builder.String()
+
+	parts := splitEmbedDescription(description, 1000)
+	if len(parts) < 2 {
+		t.Fatalf("Expected description to be split, got %d part", len(parts))
+	}
+	var rows int
+	for i, part := range parts {
+		if length := utf8.RuneCountInString(part); length > 1000 {
+			t.Errorf("Part %d exceeds limit: %d", i, length)
+		}
+		if strings.Count(part, "```")%2 != 0 {
+			t.Errorf("Part %d has unbalanced code fences:\n%s", i, part)
+		}
+		rows += strings.Count(part, "▲1")
+	}
+	if rows != 180 {
+		t.Errorf("Expected all 180 rows to be kept, got %d", rows)
+	}
+
+	if parts := splitEmbedDescription("short", 1000); len(parts) != 1 || parts[0] != "short" {
+		t.Errorf("Expected short description untouched, got %q", parts)
+	}
+	if parts := splitEmbedDescription(strings.Repeat("가", 2500), 1000); len(parts) != 3 {
+		t.Errorf("Expected a long single line to be cut into 3 parts, got %d", len(parts))
+	}
+}
+
+func TestEmbedMessages(t *testing.T) {
+	embed := &discordgo.MessageEmbed{
+		Title:       "스코어보드",
+		Description: strings.Repeat(strings.Repeat("x", 99)+"\n", 200),
+		Footer:      &discordgo.MessageEmbedFooter{Text: "footer"},
+	}
+
+	messages := embedMessages(embed, nil)
+	var embeds []*discordgo.MessageEmbed
+	for _, message := range messages {
+		total := 0
+		for _, chunk := range message.Embeds {
+			total += embedLength(chunk)
+		}
+		if total > constants.MaxMessageEmbedLength || len(message.Embeds) > constants.MaxEmbedsPerMessage {
+			t.Errorf("Message exceeds embed limits: %d chars in %d embeds", total, len(message.Embeds))
+		}
+		embeds = append(embeds, message.Embeds...)
+	}
+	if len(messages) < 2 || len(embeds) < 5 {
+		t.Fatalf("Expected 20000 characters to span several messages, got %d messages / %d embeds", len(messages), len(embeds))
+	}
+	if embeds[0].Title != "스코어보드" || embeds[1].Title != "" {
+		t.Error("Expected title only on the first embed")
+	}
+	if embeds[len(embeds)-1].Footer == nil || embeds[0].Footer != nil {
+		t.Error("Expected footer only on the last embed")
+	}
+}
+
+func testScoreboardView(rows int) *scoreboardView {
+	league := scoreboardLeague{name: "루키"}
+	for i := 0; i < rows; i++ {
+		id := fmt.Sprintf("user%02d", i)
+		league.ids = append(league.ids, id)
+		league.rows = append(league.rows, fmt.Sprintf("%-4d  %-15s", i+1, id))
+	}
+	return &scoreboardView{
+		id:        "view",
+		title:     "스코어보드",
+		leagues:   []scoreboardLeague{league, {name: "프로", ids: []string{"pro"}, rows: []string{"1     pro"}}},
+		teamPages: []string{"팀 순위 표"},
+	}
+}
+
+func TestScoreboardView_Render(t *testing.T) {
+	view := testScoreboardView(45)
+
+	if view.sectionCount() != 3 || view.pageCount(0) != 3 || view.pageCount(2) != 1 {
+		t.Fatalf("Unexpected sections/pages: %d / %d", view.sectionCount(), view.pageCount(0))
+	}
+
+	embed, components := view.render(0, 9, "user41")
+	if !strings.Contains(embed.Description, "user44") || strings.Contains(embed.Description, "user39") {
+		t.Errorf("Expected last page to be clamped to rows 41-45, got:\n%s", embed.Description)
+	}
+	if !strings.Contains(embed.Description, "user41"+strings.Repeat(" ", 9)+constants.MsgScoreboardHighlight) {
+		t.Errorf("Expected highlighted row, got:\n%s", embed.Description)
+	}
+	if !strings.Contains(embed.Footer.Text, "3/3") {
+		t.Errorf("Unexpected footer %q", embed.Footer.Text)
+	}
+
+	buttons := components[1].(discordgo.ActionsRow).Components
+	if prev := buttons[0].(discordgo.Button); prev.Disabled || prev.CustomID != "sb|view|page|0|1" {
+		t.Errorf("Unexpected previous button %+v", prev)
+	}
+	if next := buttons[1].(discordgo.Button); !next.Disabled {
+		t.Error("Expected next button disabled on the last page")
+	}
+	menu := components[0].(discordgo.ActionsRow).Components[0].(discordgo.SelectMenu)
+	if len(menu.Options) != 3 || !menu.Options[0].Default || menu.Options[2].Label != constants.MsgScoreboardTeamSection {
+		t.Errorf("Unexpected league menu %+v", menu.Options)
+	}
+
+	if team, _ := view.render(2, 0, ""); !strings.Contains(team.Description, "팀 순위 표") {
+		t.Errorf("Expected team section, got %q", team.Description)
+	}
+}
+
+func TestSplitTeamSectionFitsEmbedLimit(t *testing.T) {
+	var builder strings.Builder
+	builder.WriteString("**팀 순위**\n```\n")
+	for i := 0; i < 300; i++ {
+		builder.WriteString(fmt.Sprintf("%-4d  %-20s %6d\n", i+1, fmt.Sprintf("team%03d", i), 1000-i))
+	}
+	builder.WriteString("```")
+	dateRange := "2025-03-01 ~ 2025-03-31"
+
+	view := &scoreboardView{id: "view", dateRange: dateRange, teamPages: splitTeamSection(dateRange, builder.String())}
+	if view.pageCount(0) < 2 {
+		t.Fatalf("Expected a large team board to be split, got %d pages", view.pageCount(0))
+	}
+	for page := 0; page < view.pageCount(0); page++ {
+		embed, _ := view.render(0, page, "")
+		if length := utf8.RuneCountInString(embed.Description); length > constants.MaxEmbedDescriptionLength {
+			t.Errorf("Team page %d is %d characters long", page+1, length)
+		}
+	}
+	if last, _ := view.render(0, view.pageCount(0)-1, ""); !strings.Contains(last.Description, "team299") {
+		t.Error("Expected the last team page to hold the last team")
+	}
+}
+
+func TestScoreboardView_Find(t *testing.T) {
+	view := testScoreboardView(45)
+
+	if section, page, ok := view.find("USER25"); !ok || section != 0 || page != 1 {
+		t.Errorf("Expected user25 on league 0 page 1, got %d/%d (%v)", section, page, ok)
+	}
+	if section, _, ok := view.find("pro"); !ok || section != 1 {
+		t.Errorf("Expected pro in second league, got %d (%v)", section, ok)
+	}
+	if _, _, ok := view.find("nobody"); ok {
+		t.Error("Expected unknown handle not to be found")
+	}
+}
+
+func TestScoreboardViewStore_Expiry(t *testing.T) {
+	store := newScoreboardViewStore()
+	now := time.Date(2025, 3, 1, 9, 0, 0, 0, time.UTC)
+	view := testScoreboardView(1)
+	store.add(view, now)
+
+	if _, ok := store.get(view.id, now.Add(constants.ScoreboardViewTTL-time.Second)); !ok {
+		t.Error("Expected view to be available before expiry")
+	}
+	if _, ok := store.get(view.id, now.Add(constants.ScoreboardViewTTL+time.Second)); ok {
+		t.Error("Expected view to expire")
+	}
+
+	store.add(testScoreboardView(1), now.Add(2*constants.ScoreboardViewTTL))
+	if len(store.views) != 1 {
+		t.Errorf("Expected expired views to be pruned, got %d", len(store.views))
+	}
+	if !isScoreboardComponent(view.customID("me")) || isScoreboardComponent("other|x") {
+		t.Error("Unexpected component prefix check")
+	}
+}
@@ -0,0 +1,242 @@
+package bot
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/ssugameworks/kkemi/constants"
+	"github.com/ssugameworks/kkemi/errors"
+	"github.com/ssugameworks/kkemi/models"
+	"github.com/ssugameworks/kkemi/utils"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+// SeasonStandings 시즌에 포함된 확정 대회들의 보관 결과로 시즌 순위를 계산합니다 (대회 수도 함께 반환)
+func (manager *ScoreboardManager) SeasonStandings(season models.Season) ([]models.SeasonStanding, int, error) {
+	competitions := manager.storage.GetArchivedCompetitions()
+
+	// 최근 대회의 이름이 남도록 오래된 대회부터 합산
+	archives := make([]models.CompetitionArchive, 0, len(competitions))
+	for i := len(competitions) - 1; i >= 0; i-- {
+		competition := competitions[i]
+		if !season.Includes(&competition) {
+			continue
+		}
+		results, err := manager.storage.ForCompetition(competition.ID).GetArchivedResults()
+		if err != nil {
+			return nil, 0, fmt.Errorf("%s 결과 조회 실패: %w", competition.Name, err)
+		}
+		archives = append(archives, models.CompetitionArchive{Competition: competition, Results: results})
+	}
+
+	return models.AggregateSeason(season.Scoring, archives), len(archives), nil
+}
+
+// handleSeason 시즌 순위 조회와 관리자 시즌 관리를 처리합니다
+func (handler *CommandHandler) handleSeason(session *discordgo.Session, message *discordgo.MessageCreate, params []string) {
+	action := ""
+	if len(params) > 0 {
+		action = params[0]
+	}
+
+	switch action {
+	case "list":
+		handler.handleSeasonList(session, message)
+	case "create", "delete":
+		if action == "create" {
+			handler.handleSeasonCreate(session, message, params[1:])
+		} else {
+			handler.handleSeasonDelete(session, message, params[1:])
+		}
+	default:
+		handler.handleSeasonStandings(session, message, action)
+	}
+}
+
+// handleSeasonStandings 지정한 시즌(없으면 현재 시즌)의 순위를 보여줍니다
+func (handler *CommandHandler) handleSeasonStandings(session *discordgo.Session, message *discordgo.MessageCreate, name string) {
+	errorHandlers := utils.NewErrorHandlerFactory(session, message.ChannelID)
+
+	seasons := handler.deps.Storage.GetSeasons()
+	var season models.Season
+	var found bool
+	if name == "" {
+		season, found = models.CurrentSeason(seasons, utils.GetCurrentTimeKST())
+		if !found {
+			errors.SendDiscordInfo(session, message.ChannelID, constants.MsgSeasonEmpty)
+			return
+		}
+	} else if season, found = findSeason(seasons, name); !found {
+		errorHandlers.Validation().HandleInvalidParams("SEASON_NOT_FOUND",
+			fmt.Sprintf("Season not found: %s", name),
+			fmt.Sprintf(constants.MsgSeasonNotFound, name))
+		return
+	}
+
+	standings, competitionCount, err := handler.deps.ScoreboardManager.SeasonStandings(season)
+	if err != nil {
+		errorHandlers.System().HandleSystemError("SEASON_STANDINGS_FAILED",
+			"Failed to aggregate season standings",
+			"시즌 순위를 계산하는 중 오류가 발생했습니다.", err)
+		return
+	}
+
+	if _, err := session.ChannelMessageSendEmbed(message.ChannelID, formatSeasonStandings(season, competitionCount, standings)); err != nil {
+		utils.Error("DISCORD API ERROR: Failed to send season standings: %v", err)
+	}
+}
+
+// handleSeasonList 등록된 시즌 목록을 보여줍니다
+func (handler *CommandHandler) handleSeasonList(session *discordgo.Session, message *discordgo.MessageCreate) {
+	seasons := handler.deps.Storage.GetSeasons()
+	if len(seasons) == 0 {
+		errors.SendDiscordInfo(session, message.ChannelID, constants.MsgSeasonEmpty)
+		return
+	}
+
+	var builder strings.Builder
+	for _, season := range seasons {
+		builder.WriteString(fmt.Sprintf("• **%s** %s ~ %s · %s\n", season.Name,
+			utils.FormatDate(season.StartDate), utils.FormatDate(season.EndDate),
+			models.SeasonScoringLabel(season.Scoring)))
+	}
+
+	embed := &discordgo.MessageEmbed{
+		Title:       fmt.Sprintf(constants.MsgSeasonListTitle, len(seasons)),
+		Description: builder.String(),
+		Color:       constants.ColorTierGold,
+	}
+	if _, err := session.ChannelMessageSendEmbed(message.ChannelID, embed); err != nil {
+		utils.Error("DISCORD API ERROR: Failed to send season list: %v", err)
+	}
+}
+
+// handleSeasonCreate `create <이름> <시작일> <종료일> [placement|normalized]` 형식으로 시즌을 만듭니다
+func (handler *CommandHandler) handleSeasonCreate(session *discordgo.Session, message *discordgo.MessageCreate, params []string) {
+	errorHandlers := utils.NewErrorHandlerFactory(session, message.ChannelID)
+
+	if len(params) < 3 || len(params) > 4 || !utils.IsValidSeasonName(params[0]) {
+		errorHandlers.Validation().HandleInvalidParams("SEASON_CREATE_INVALID_PARAMS",
+			"Invalid season create parameters",
+			constants.MsgSeasonCreateUsage)
+		return
+	}
+
+	startDate, endDate, err := utils.ParseDateRange(params[1], params[2])
+	if err != nil {
+		errorHandlers.Validation().HandleInvalidParams("INVALID_SEASON_DATES",
+			fmt.Sprintf("Invalid season dates: %v", err),
+			fmt.Sprintf("날짜 오류: %v", err))
+		return
+	}
+
+	scoring := models.SeasonScoringPlacement
+	if len(params) == 4 {
+		scoring = params[3]
+	}
+	if !models.IsValidSeasonScoring(scoring) {
+		errorHandlers.Validation().HandleInvalidParams("INVALID_SEASON_SCORING",
+			fmt.Sprintf("Invalid season scoring: %s", scoring),
+			constants.MsgSeasonCreateUsage)
+		return
+	}
+
+	if _, exists := findSeason(handler.deps.Storage.GetSeasons(), params[0]); exists {
+		errorHandlers.Validation().HandleInvalidParams("SEASON_ALREADY_EXISTS",
+			fmt.Sprintf("Season already exists: %s", params[0]),
+			fmt.Sprintf(constants.MsgSeasonAlreadyExists, params[0]))
+		return
+	}
+
+	season := models.Season{Name: params[0], StartDate: startDate, EndDate: endDate, Scoring: scoring}
+	if err := handler.deps.Storage.CreateSeason(season); err != nil {
+		errorHandlers.System().HandleSystemError("SEASON_CREATE_FAILED", "Failed to create season", constants.MsgSeasonUpdateFailed, err)
+		return
+	}
+
+	errors.SendDiscordSuccess(session, message.ChannelID, fmt.Sprintf(constants.MsgSeasonCreated,
+		season.Name, utils.FormatDate(startDate), utils.FormatDate(endDate), models.SeasonScoringLabel(scoring)))
+}
+
+// handleSeasonDelete 시즌을 삭제합니다 (대회 결과는 그대로 유지)
+func (handler *CommandHandler) handleSeasonDelete(session *discordgo.Session, message *discordgo.MessageCreate, params []string) {
+	errorHandlers := utils.NewErrorHandlerFactory(session, message.ChannelID)
+
+	if len(params) != 1 {
+		errorHandlers.Validation().HandleInvalidParams("SEASON_INVALID_PARAMS",
+			"Invalid season delete parameters",
+			constants.MsgSeasonUsage)
+		return
+	}
+
+	season, found := findSeason(handler.deps.Storage.GetSeasons(), params[0])
+	if !found {
+		errorHandlers.Validation().HandleInvalidParams("SEASON_NOT_FOUND",
+			fmt.Sprintf("Season not found: %s", params[0]),
+			fmt.Sprintf(constants.MsgSeasonNotFound, params[0]))
+		return
+	}
+
+	if err := handler.deps.Storage.DeleteSeason(season.Name); err != nil {
+		errorHandlers.System().HandleSystemError("SEASON_DELETE_FAILED", "Failed to delete season", constants.MsgSeasonUpdateFailed, err)
+		return
+	}
+	errors.SendDiscordSuccess(session, message.ChannelID, fmt.Sprintf(constants.MsgSeasonDeleted, season.Name))
+}
+
+// findSeason 이름으로 시즌을 찾습니다 (대소문자 무시)
+func findSeason(seasons []models.Season, name string) (models.Season, bool) {
+	for _, season := range seasons {
+		if strings.EqualFold(season.Name, name) {
+			return season, true
+		}
+	}
+	return models.Season{}, false
+}
+
+// formatSeasonStandings 시즌 순위를 고정폭 표로 만든 embed를 반환합니다
+func formatSeasonStandings(season models.Season, competitionCount int, standings []models.SeasonStanding) *discordgo.MessageEmbed {
+	description := fmt.Sprintf(constants.MsgSeasonSummary,
+		utils.FormatDate(season.StartDate), utils.FormatDate(season.EndDate),
+		models.SeasonScoringLabel(season.Scoring), competitionCount)
+
+	if len(standings) == 0 {
+		description += "\n\n" + constants.MsgSeasonNoResults
+	} else {
+		var builder strings.Builder
+		builder.WriteString("\n```\n")
+		builder.WriteString(fmt.Sprintf("%-*s %-*s %*s %s\n",
+			constants.ScoreboardRankWidth, "순위",
+			constants.ScoreboardNameWidth, "아이디",
+			constants.ScoreboardScoreWidth, "포인트", "대회"))
+		builder.WriteString(constants.ScoreboardSeparator + "\n")
+
+		var lastPoints float64 = -1.0
+		var rank int
+		for i, standing := range standings {
+			if standing.Points != lastPoints {
+				rank = i + 1
+			}
+			lastPoints = standing.Points
+			if i >= constants.MaxSeasonStandingLines {
+				builder.WriteString(fmt.Sprintf(constants.MsgSeasonMoreMembers, len(standings)-i))
+				break
+			}
+			builder.WriteString(fmt.Sprintf("%-*d  %-*s %*.1f %d\n",
+				constants.ScoreboardRankWidth, rank,
+				constants.ScoreboardNameWidth, utils.TruncateString(standing.BaekjoonID, constants.ScoreboardNameWidth),
+				constants.ScoreboardScoreWidth, standing.Points,
+				standing.Competitions))
+		}
+		builder.WriteString("```")
+		description += builder.String()
+	}
+
+	return &discordgo.MessageEmbed{
+		Title:       fmt.Sprintf(constants.MsgSeasonTitle, season.Name),
+		Description: description,
+		Color:       constants.ColorTierGold,
+		Footer:      &discordgo.MessageEmbedFooter{Text: constants.MsgSeasonFooter},
+	}
+}
@@ -0,0 +1,36 @@
+package bot
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/ssugameworks/kkemi/models"
+)
+
+func TestFormatSeasonStandings(t *testing.T) {
+	standings := []models.SeasonStanding{
+		{BaekjoonID: "alice", Points: 43, Competitions: 2},
+		{BaekjoonID: "bob", Points: 43, Competitions: 2},
+		{BaekjoonID: "carol", Points: 25, Competitions: 1},
+	}
+
+	embed := formatSeasonStandings(models.Season{Name: "spring"}, 2, standings)
+	lines := strings.Split(embed.Description, "\n")
+
+	if !strings.Contains(embed.Title, "spring") {
+		t.Errorf("Expected season name in title, got %q", embed.Title)
+	}
+	if !strings.HasPrefix(lines[4], "1") || !strings.HasPrefix(lines[5], "1") || !strings.Contains(lines[5], "bob") {
+		t.Errorf("Expected tied members to share rank 1, got %q / %q", lines[4], lines[5])
+	}
+	if !strings.HasPrefix(lines[6], "3") || !strings.Contains(lines[6], "carol") {
+		t.Errorf("Expected carol at rank 3, got %q", lines[6])
+	}
+}
+
+func TestFindSeason(t *testing.T) {
+	seasons := []models.Season{{Name: "Spring2025"}}
+	if season, ok := findSeason(seasons, "spring2025"); !ok || season.Name != "Spring2025" {
+		t.Errorf("Expected case-insensitive season lookup, got %+v", season)
+	}
+}
@@ -0,0 +1,213 @@
+package bot
+
+import (
+	"context"
+	stderrors "errors"
+	"fmt"
+
+	"github.com/ssugameworks/kkemi/api"
+	"github.com/ssugameworks/kkemi/constants"
+	"github.com/ssugameworks/kkemi/errors"
+	"github.com/ssugameworks/kkemi/interfaces"
+	"github.com/ssugameworks/kkemi/models"
+	"github.com/ssugameworks/kkemi/utils"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+// authorParticipant 작성자의 디스코드 ID로 연결된 참가자를 찾고, 없으면 안내 메시지를 보냅니다
+func (handler *CommandHandler) authorParticipant(session *discordgo.Session, message *discordgo.MessageCreate, competition *models.Competition) (models.Participant, bool) {
+	handle := handler.linkedAuthorHandle(message)
+	participant, found := findParticipant(handler.deps.Storage.GetParticipants(), handle)
+	if handle == "" || !found {
+		errors.SendDiscordInfo(session, message.ChannelID, fmt.Sprintf(constants.MsgMyInfoNotLinked, competition.Name))
+		return models.Participant{}, false
+	}
+	return participant, true
+}
+
+// handleWithdraw 참가자가 스스로 대회에서 탈퇴합니다 (`!탈퇴 확인`으로 한 번 더 확인)
+func (handler *CommandHandler) handleWithdraw(session *discordgo.Session, message *discordgo.MessageCreate, params []string) {
+	errorHandlers := utils.NewErrorHandlerFactory(session, message.ChannelID)
+
+	competition := handler.deps.Storage.GetCompetition()
+	if competition == nil {
+		errorHandlers.Data().HandleNoActiveCompetition()
+		return
+	}
+	if competition.IsFinalized() {
+		errors.SendDiscordInfo(session, message.ChannelID, fmt.Sprintf(constants.MsgWithdrawFinalized, competition.Name))
+		return
+	}
+
+	participant, ok := handler.authorParticipant(session, message, competition)
+	if !ok {
+		return
+	}
+
+	if !isWithdrawConfirmation(params) {
+		errors.SendDiscordInfo(session, message.ChannelID, fmt.Sprintf(constants.MsgWithdrawConfirm, competition.Name, participant.BaekjoonID))
+		return
+	}
+
+	if err := handler.deps.Storage.RemoveParticipant(participant.BaekjoonID); err != nil {
+		errorHandlers.System().HandleSystemError("WITHDRAW_FAILED",
+			"Failed to withdraw participant", constants.MsgWithdrawFailed, err)
+		return
+	}
+	handler.deleteRegistrationChallenge(message.Author.ID)
+
+	handler.recordAudit(session, message, models.AuditEntry{
+		CompetitionID: competition.ID,
+		Action:        models.AuditActionParticipantWithdraw,
+		Target:        participant.BaekjoonID,
+		Before:        participant.Name,
+	})
+	errors.SendDiscordSuccess(session, message.ChannelID, fmt.Sprintf(constants.MsgWithdrawn, competition.Name, participant.BaekjoonID))
+}
+
+// isWithdrawConfirmation 탈퇴 확인 매개변수인지 확인합니다
+func isWithdrawConfirmation(params []string) bool {
+	return len(params) == 1 && (params[0] == "확인" || params[0] == "confirm")
+}
+
+// handleHandleChange 참가자의 백준 ID 변경을 신청합니다 (`!핸들변경 <새 백준ID>`, 검증 후 `!인증`으로 완료)
+func (handler *CommandHandler) handleHandleChange(session *discordgo.Session, message *discordgo.MessageCreate, params []string) {
+	errorHandlers := utils.NewErrorHandlerFactory(session, message.ChannelID)
+
+	if len(params) != 1 || !utils.IsValidBaekjoonID(params[0]) {
+		errorHandlers.Validation().HandleInvalidParams("HANDLE_CHANGE_INVALID_PARAMS",
+			"Invalid handle change parameters",
+			constants.MsgHandleChangeUsage)
+		return
+	}
+	newHandle := params[0]
+
+	if !handler.validateCompetitionStatus(errorHandlers) {
+		return
+	}
+	competition := handler.deps.Storage.GetCompetition()
+	participant, ok := handler.authorParticipant(session, message, competition)
+	if !ok {
+		return
+	}
+
+	if newHandle == participant.BaekjoonID {
+		errorHandlers.Validation().HandleInvalidParams("HANDLE_CHANGE_SAME",
+			fmt.Sprintf("Handle is unchanged: %s", newHandle),
+			fmt.Sprintf(constants.MsgHandleChangeSame, newHandle))
+		return
+	}
+	if _, exists := findParticipant(handler.deps.Storage.GetParticipants(), newHandle); exists {
+		errorHandlers.Data().HandleParticipantAlreadyExists(newHandle)
+		return
+	}
+
+	// 새 백준 ID도 등록과 같은 기준(solved.ac 이름, 참가 자격)으로 다시 검증합니다
+	userInfo, ok := handler.validateSolvedACUser(participant.Name, newHandle, errorHandlers)
+	if !ok {
+		return
+	}
+	organizationID, ok := handler.validateEligibility(participant.Name, newHandle, userInfo, errorHandlers)
+	if !ok {
+		return
+	}
+
+	handler.issueRegistrationChallenge(session, message, models.RegistrationChallenge{
+		Name:               participant.Name,
+		BaekjoonID:         newHandle,
+		OrganizationID:     organizationID,
+		ReplacesBaekjoonID: participant.BaekjoonID,
+	})
+}
+
+// completeHandleChange 본인 확인이 끝난 백준 ID 변경을 적용합니다
+func (handler *CommandHandler) completeHandleChange(session *discordgo.Session, message *discordgo.MessageCreate, challenge *models.RegistrationChallenge) {
+	errorHandlers := utils.NewErrorHandlerFactory(session, message.ChannelID)
+
+	// 토큰 발급 후 탈퇴했거나 관리자가 연결을 바꿨을 수 있으므로 다시 확인합니다
+	participants := handler.deps.Storage.GetParticipants()
+	participant, found := findParticipant(participants, challenge.ReplacesBaekjoonID)
+	if !found || participant.DiscordUserID != message.Author.ID {
+		handler.deleteRegistrationChallenge(message.Author.ID)
+		errorHandlers.Data().HandleParticipantNotFound(challenge.ReplacesBaekjoonID)
+		return
+	}
+	if _, taken := findParticipant(participants, challenge.BaekjoonID); taken {
+		handler.deleteRegistrationChallenge(message.Author.ID)
+		errorHandlers.Data().HandleParticipantAlreadyExists(challenge.BaekjoonID)
+		return
+	}
+
+	solved, err := handler.deps.APIClient.GetUserSolvedProblems(context.Background(), challenge.BaekjoonID)
+	if err != nil {
+		errorHandlers.API().HandleBaekjoonUserNotFound(challenge.BaekjoonID, err)
+		return
+	}
+	solvedIDs := make([]int, 0, len(solved))
+	for _, problem := range solved {
+		solvedIDs = append(solvedIDs, problem.ProblemID)
+	}
+
+	policy, err := handler.handleChangePolicy(participant, solvedIDs)
+	if err != nil {
+		// 토큰은 그대로 두어 solved.ac가 복구되면 `!인증`으로 다시 시도할 수 있게 합니다
+		errorHandlers.System().HandleSystemError("HANDLE_CHANGE_CHECK_FAILED",
+			"Failed to check previous handle", constants.MsgHandleChangeCheckFailed, err)
+		return
+	}
+	change := models.HandleChange{
+		DiscordUserID:  message.Author.ID,
+		OldBaekjoonID:  participant.BaekjoonID,
+		NewBaekjoonID:  challenge.BaekjoonID,
+		OrganizationID: challenge.OrganizationID,
+		Policy:         policy,
+		ChangedAt:      utils.GetCurrentTimeKST(),
+	}
+	if err := handler.deps.Storage.ChangeParticipantHandle(change); stderrors.Is(err, interfaces.ErrStartSnapshotUnavailable) {
+		errorHandlers.System().HandleSystemError("HANDLE_CHANGE_SNAPSHOT_FAILED",
+			"Failed to load start snapshot for new handle", fmt.Sprintf(constants.MsgHandleChangeSnapshotFailed, change.NewBaekjoonID), err)
+		return
+	} else if err != nil {
+		errorHandlers.System().HandleSystemError("HANDLE_CHANGE_FAILED",
+			"Failed to change participant handle", constants.MsgHandleChangeFailed, err)
+		return
+	}
+	handler.deleteRegistrationChallenge(message.Author.ID)
+
+	entry := models.AuditEntry{
+		Action: models.AuditActionParticipantHandle,
+		Target: participant.Name,
+		Before: change.OldBaekjoonID,
+		After:  fmt.Sprintf("%s (%s)", change.NewBaekjoonID, change.Policy),
+	}
+	if competition := handler.deps.Storage.GetCompetition(); competition != nil {
+		entry.CompetitionID = competition.ID
+	}
+	handler.recordAudit(session, message, entry)
+
+	errors.SendDiscordSuccess(session, message.ChannelID, fmt.Sprintf(constants.MsgHandleChanged,
+		change.OldBaekjoonID, change.NewBaekjoonID, models.HandleChangePolicyLabel(change.Policy)))
+	errors.SendDiscordInfo(session, message.ChannelID, constants.MsgVerifyCleanup)
+}
+
+// handleChangePolicy 기존 아이디와 해결 기록을 확인해 등록 시점 기록을 이어받을지 결정합니다.
+// 기존 아이디가 solved.ac에서 사라졌다고 확인된 경우(404)에만 아이디만 바꾼 같은 계정으로 볼 수 있고,
+// 시간 초과나 서버 오류처럼 확인하지 못한 경우에는 판단하지 않고 에러를 반환합니다
+func (handler *CommandHandler) handleChangePolicy(participant models.Participant, solvedIDs []int) (string, error) {
+	_, err := handler.fetchFreshUserInfo(participant.BaekjoonID)
+	oldHandleMissing := stderrors.Is(err, api.ErrNotFound)
+	if err != nil && !oldHandleMissing {
+		return "", fmt.Errorf("failed to look up previous handle %s: %w", participant.BaekjoonID, err)
+	}
+
+	records, err := handler.deps.Storage.GetSolveRecords(participant.BaekjoonID)
+	if err != nil {
+		return "", fmt.Errorf("failed to load solve records for %s: %w", participant.BaekjoonID, err)
+	}
+	recordedIDs := make([]int, 0, len(records))
+	for id := range records {
+		recordedIDs = append(recordedIDs, id)
+	}
+	return models.ChooseHandleChangePolicy(oldHandleMissing, participant.GetStartProblemIDs(), recordedIDs, solvedIDs), nil
+}
@@ -0,0 +1,136 @@
+package bot
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/ssugameworks/kkemi/api"
+	"github.com/ssugameworks/kkemi/constants"
+	"github.com/ssugameworks/kkemi/models"
+	"github.com/ssugameworks/kkemi/storage"
+)
+
+func TestIsWithdrawConfirmation(t *testing.T) {
+	tests := []struct {
+		params   []string
+		expected bool
+	}{
+		{nil, false},
+		{[]string{"확인"}, true},
+		{[]string{"confirm"}, true},
+		{[]string{"yes"}, false},
+		{[]string{"확인", "extra"}, false},
+	}
+
+	for _, test := range tests {
+		if got := isWithdrawConfirmation(test.params); got != test.expected {
+			t.Errorf("isWithdrawConfirmation(%v) = %t, expected %t", test.params, got, test.expected)
+		}
+	}
+}
+
+func TestChangeParticipantHandle(t *testing.T) {
+	store := storage.NewInMemoryStorage(&MockSolvedACClient{})
+	start := time.Now().AddDate(0, 0, -1)
+	if _, err := store.CreateCompetition("test", start, start.AddDate(0, 0, 7)); err != nil {
+		t.Fatalf("Failed to create competition: %v", err)
+	}
+	if err := store.AddParticipant("홍길동", "oldhandle", 6, 0, 0, "7"); err != nil {
+		t.Fatalf("Failed to add participant: %v", err)
+	}
+	if err := store.CreateTeam("alpha"); err != nil {
+		t.Fatalf("Failed to create team: %v", err)
+	}
+	if err := store.AssignTeam("alpha", "oldhandle"); err != nil {
+		t.Fatalf("Failed to assign team: %v", err)
+	}
+	if _, err := store.RecordSolves("oldhandle", []int{1000}, start); err != nil {
+		t.Fatalf("Failed to record solves: %v", err)
+	}
+
+	change := models.HandleChange{
+		DiscordUserID: "7",
+		OldBaekjoonID: "oldhandle",
+		NewBaekjoonID: "newhandle",
+		Policy:        models.HandleChangeCarryOver,
+		ChangedAt:     time.Now(),
+	}
+	if err := store.ChangeParticipantHandle(change); err != nil {
+		t.Fatalf("Failed to change handle: %v", err)
+	}
+
+	participants := store.GetParticipants()
+	if _, found := findParticipant(participants, "oldhandle"); found {
+		t.Error("Expected old handle to be removed")
+	}
+	participant, found := findParticipant(participants, "newhandle")
+	if !found || participant.Name != "홍길동" || participant.DiscordUserID != "7" {
+		t.Fatalf("Expected renamed participant to keep name and link, got %+v", participant)
+	}
+
+	if records, _ := store.GetSolveRecords("newhandle"); len(records) != 1 {
+		t.Errorf("Expected solve records to be carried over, got %v", records)
+	}
+	if teams := store.GetTeams(); len(teams) != 1 || !teams[0].HasMember("newhandle") || teams[0].HasMember("oldhandle") {
+		t.Errorf("Expected team membership to follow the new handle, got %+v", teams)
+	}
+
+	handler := &CommandHandler{deps: &CommandDependencies{Storage: store}}
+	if previous := handler.previousHandles(participant); len(previous) != 1 || previous[0] != "oldhandle" {
+		t.Errorf("Expected previous handle to be recorded, got %v", previous)
+	}
+
+	if err := store.AddParticipant("김철수", "taken", 6, 0, 0, ""); err != nil {
+		t.Fatalf("Failed to add participant: %v", err)
+	}
+	change = models.HandleChange{OldBaekjoonID: "newhandle", NewBaekjoonID: "taken", Policy: models.HandleChangeResnapshot}
+	if err := store.ChangeParticipantHandle(change); err == nil {
+		t.Error("Expected change to an already registered handle to be rejected")
+	}
+}
+
+func TestHandleChangePolicy(t *testing.T) {
+	client := &MockSolvedACClient{solved: []api.ProblemInfo{{ProblemID: 1000}}}
+	store := storage.NewInMemoryStorage(client)
+	start := time.Now().AddDate(0, 0, -1)
+	if _, err := store.CreateCompetition("test", start, start.AddDate(0, 0, 7)); err != nil {
+		t.Fatalf("Failed to create competition: %v", err)
+	}
+	if err := store.AddParticipant("홍길동", "oldhandle", 6, 0, 0, "7"); err != nil {
+		t.Fatalf("Failed to add participant: %v", err)
+	}
+	if _, err := store.RecordSolves("oldhandle", []int{1001}, start); err != nil {
+		t.Fatalf("Failed to record solves: %v", err)
+	}
+	participant, _ := findParticipant(store.GetParticipants(), "oldhandle")
+	handler := &CommandHandler{deps: &CommandDependencies{Storage: store, APIClient: client}}
+
+	// 기존 아이디가 사라졌고 해결 문제가 스냅샷과 해결 기록에 맞으면 이어받습니다
+	client.userInfoErr = fmt.Errorf("%w: oldhandle", api.ErrNotFound)
+	if policy, err := handler.handleChangePolicy(participant, []int{1000, 1001, 1002}); err != nil || policy != models.HandleChangeCarryOver {
+		t.Errorf("Expected carry over for renamed account, got %s (err=%v)", policy, err)
+	}
+
+	// 기록이 적은 계정을 지우고 기록이 많은 계정으로 옮기면 스냅샷을 새로 저장합니다
+	superset := []int{1000, 1001}
+	for id := 2000; id < 2000+constants.HandleChangeMaxUnrecordedSolves+1; id++ {
+		superset = append(superset, id)
+	}
+	if policy, err := handler.handleChangePolicy(participant, superset); err != nil || policy != models.HandleChangeResnapshot {
+		t.Errorf("Expected resnapshot for superset account, got %s (err=%v)", policy, err)
+	}
+
+	// 시간 초과나 서버 오류는 아이디가 사라졌다는 근거가 아니므로 판단하지 않습니다
+	client.userInfoErr = fmt.Errorf("API가 상태 코드 503를 반환했습니다")
+	if _, err := handler.handleChangePolicy(participant, []int{1000, 1001}); err == nil {
+		t.Error("Expected error when previous handle lookup fails")
+	}
+
+	// 기존 아이디가 그대로 있으면 다른 계정입니다
+	client.userInfoErr = nil
+	client.userInfo = &api.UserInfo{Handle: "oldhandle"}
+	if policy, err := handler.handleChangePolicy(participant, []int{1000, 1001}); err != nil || policy != models.HandleChangeResnapshot {
+		t.Errorf("Expected resnapshot while previous handle exists, got %s (err=%v)", policy, err)
+	}
+}
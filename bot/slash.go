@@ -0,0 +1,393 @@
+package bot
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/ssugameworks/kkemi/constants"
+	"github.com/ssugameworks/kkemi/models"
+	"github.com/ssugameworks/kkemi/utils"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+// slashSplitOption 값을 공백으로 나눠 여러 매개변수로 넘기는 옵션 이름 (예: 문제 번호 목록)
+const slashSplitOption = "args"
+
+// slashArchiveCommand 지난 대회 조회 슬래시 명령어 이름 (텍스트 명령어 `!대회 history|results`로 실행)
+const slashArchiveCommand = "archive"
+
+// SlashCommands 디스코드에 등록할 애플리케이션 명령어 목록을 반환합니다
+// 관리 명령어도 기본 권한을 두지 않습니다. `!권한`으로 권한을 받은 역할이 볼 수 있어야 하며, 실행 권한은 routeCommand에서 확인합니다.
+// 옵션은 텍스트 명령어의 매개변수 순서대로 정의하며, 불리언 옵션은 이름 그대로 맨 앞에 붙습니다 (예: preview, force)
+func SlashCommands() []*discordgo.ApplicationCommand {
+	return []*discordgo.ApplicationCommand{
+		{
+			Name:              "register",
+			NameLocalizations: koreanName("등록"),
+			Description:       "대회 참가 등록을 신청합니다",
+			Options: []*discordgo.ApplicationCommandOption{
+				stringOption("name", "solved.ac에 등록된 이름", true),
+				stringOption("handle", "백준 ID", true),
+			},
+		},
+		{
+			Name:              "verify",
+			NameLocalizations: koreanName("인증"),
+			Description:       "solved.ac 자기소개의 토큰을 확인하고 등록을 완료합니다",
+		},
+		{
+			Name:              "rename",
+			NameLocalizations: koreanName("핸들변경"),
+			Description:       "기록을 유지한 채 백준 ID를 변경합니다",
+			Options: []*discordgo.ApplicationCommandOption{
+				stringOption("handle", "새 백준 ID", true),
+			},
+		},
+		{
+			Name:              "withdraw",
+			NameLocalizations: koreanName("탈퇴"),
+			Description:       "대회에서 탈퇴합니다",
+			Options: []*discordgo.ApplicationCommandOption{
+				boolOption("confirm", "안내를 확인했고 바로 탈퇴"),
+			},
+		},
+		{
+			Name:              "scoreboard",
+			NameLocalizations: koreanName("스코어보드"),
+			Description:       "현재 스코어보드를 확인합니다",
+			Options: []*discordgo.ApplicationCommandOption{
+				stringOption("date", "이 날짜(YYYY-MM-DD)까지의 마지막 스냅샷 기준으로 다시 생성", false),
+			},
+		},
+		{
+			Name:              "competition",
+			NameLocalizations: koreanName("대회"),
+			Description:       "대회를 관리합니다",
+			Options: []*discordgo.ApplicationCommandOption{
+				subcommand("create", "대회를 생성합니다",
+					stringOption("name", "대회명", true),
+					stringOption("start", "시작일 (YYYY-MM-DD)", true),
+					stringOption("end", "종료일 (YYYY-MM-DD)", true)),
+				subcommand("list", "진행 중인 대회 목록을 확인합니다"),
+				subcommand("select", "현재 채널의 기본 대회를 지정하거나 해제합니다",
+					autocompleteOption("competition", "대회 ID 또는 대회명 (해제는 clear)")),
+				subcommand("status", "대회 상태를 확인합니다"),
+				subcommand("blackout", "스코어보드 공개 여부를 바꿉니다",
+					stringOption("state", "블랙아웃 설정", true, "on", "off")),
+				subcommand("update", "대회 정보를 수정합니다",
+					stringOption("field", "수정할 항목", true, "name", "start", "end", "schedule"),
+					stringOption("value", "새 값", true)),
+				subcommand("backfill", "기존 참가자의 등록 시점 해결 문제 스냅샷을 보완합니다"),
+				subcommand("rules", "점수 규칙을 조회하거나 변경합니다",
+					stringOption("action", "동작", true, "show", "validate", "set", "reset"),
+					stringOption("json", "점수 규칙 JSON (validate, set)", false)),
+				subcommand("eligibility", "참가 자격 요건을 조회하거나 변경합니다",
+					stringOption("action", "동작", true, "show", "set", "reset"),
+					stringOption("json", "참가 자격 JSON (set)", false)),
+				subcommand("mode", "점수 계산 방식을 바꿉니다",
+					stringOption("mode", "점수 계산 방식", true, models.ScoringModeLevel, models.ScoringModeTierPoints, models.ScoringModeCustom),
+					boolOption("preview", "저장하지 않고 변경 전후만 비교")),
+				subcommand("problems", "문제 목록 대회의 문제를 관리합니다",
+					stringOption("action", "동작", true, "show", "set", "query", "clear"),
+					stringOption(slashSplitOption, "문제번호[:배점] 목록 또는 solved.ac 검색어", false)),
+				subcommand("finalize", "대회 결과를 확정하여 보관합니다",
+					boolOption("force", "종료 전이라도 확정")),
+			},
+		},
+		{
+			// competition은 대회 관리 권한이 필요하므로, 누구나 쓰는 지난 대회 조회는 따로 등록합니다
+			Name:              slashArchiveCommand,
+			NameLocalizations: koreanName("지난대회"),
+			Description:       "확정된 지난 대회 결과를 확인합니다",
+			Options: []*discordgo.ApplicationCommandOption{
+				subcommand("history", "확정된 지난 대회 목록을 확인합니다"),
+				subcommand("results", "지난 대회 결과를 확인합니다",
+					autocompleteOption("competition", "대회 ID 또는 대회명"),
+					stringOption("handle", "점수 내역을 볼 백준 ID", false),
+					integerOption("page", "점수 내역 페이지")),
+			},
+		},
+		{
+			Name:              "participants",
+			NameLocalizations: koreanName("참가자"),
+			Description:       "참가자 목록을 확인합니다",
+		},
+		{
+			Name:              "remove",
+			NameLocalizations: koreanName("삭제"),
+			Description:       "참가자를 삭제합니다",
+			Options: []*discordgo.ApplicationCommandOption{
+				autocompleteOption("handle", "삭제할 참가자의 백준 ID"),
+			},
+		},
+		{
+			Name:              "cache",
+			NameLocalizations: koreanName("캐시"),
+			Description:       "solved.ac API 캐시 통계를 확인합니다",
+		},
+	}
+}
+
+// RegisterSlashCommands 애플리케이션 명령어를 등록합니다 (guildID가 비어 있으면 전역 등록)
+func RegisterSlashCommands(session *discordgo.Session, guildID string) error {
+	commands, err := session.ApplicationCommandBulkOverwrite(session.State.User.ID, guildID, SlashCommands())
+	if err != nil {
+		return err
+	}
+	utils.Info("Registered %d slash commands", len(commands))
+	return nil
+}
+
+// HandleInteraction 슬래시 명령어, 자동 완성 요청, 버튼·선택 메뉴 입력을 처리합니다
+func (handler *CommandHandler) HandleInteraction(session *discordgo.Session, interaction *discordgo.InteractionCreate) {
+	switch interaction.Type {
+	case discordgo.InteractionApplicationCommand:
+		handler.handleSlashCommand(session, interaction)
+	case discordgo.InteractionApplicationCommandAutocomplete:
+		handler.handleAutocomplete(session, interaction)
+	case discordgo.InteractionMessageComponent:
+		if isScoreboardComponent(interaction.MessageComponentData().CustomID) {
+			handler.handleScoreboardComponent(session, interaction)
+		}
+	}
+}
+
+// handleSlashCommand 슬래시 명령어를 텍스트 명령어와 같은 핸들러로 실행합니다 (결과는 채널에 전송)
+func (handler *CommandHandler) handleSlashCommand(session *discordgo.Session, interaction *discordgo.InteractionCreate) {
+	data := interaction.ApplicationCommandData()
+	command, params := slashCommandParams(data)
+	message := interactionMessage(interaction, command, params)
+	if message.Author == nil {
+		return
+	}
+
+	// 3초 안에 응답해야 하므로 먼저 본인에게만 보이는 접수 메시지를 보냅니다
+	err := session.InteractionRespond(interaction.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseChannelMessageWithSource,
+		Data: &discordgo.InteractionResponseData{
+			Content: fmt.Sprintf(constants.MsgSlashAccepted, data.Name),
+			Flags:   discordgo.MessageFlagsEphemeral,
+		},
+	})
+	if err != nil {
+		utils.Error("DISCORD API ERROR: Failed to respond to interaction: %v", err)
+		return
+	}
+
+	handler.forChannel(message.ChannelID).routeCommand(session, message, command, params, message.GuildID == "")
+}
+
+// slashCommandParams 슬래시 명령어를 텍스트 명령어 이름과 매개변수로 바꿉니다
+func slashCommandParams(data discordgo.ApplicationCommandInteractionData) (string, []string) {
+	definition := findSlashCommand(data.Name)
+	if definition == nil {
+		return data.Name, nil
+	}
+	command := data.Name
+	if command == slashArchiveCommand {
+		command = "competition"
+	}
+
+	options := data.Options
+	definitions := definition.Options
+	var params []string
+	if len(options) == 1 && options[0].Type == discordgo.ApplicationCommandOptionSubCommand {
+		params = append(params, options[0].Name)
+		definitions = nil
+		for _, sub := range definition.Options {
+			if sub.Name == options[0].Name {
+				definitions = sub.Options
+			}
+		}
+		options = options[0].Options
+	}
+	return command, append(params, optionParams(definitions, options)...)
+}
+
+// optionParams 정의된 순서대로 입력된 옵션 값을 매개변수로 나열합니다 (불리언 플래그가 먼저, 빠진 옵션은 건너뜀)
+func optionParams(definitions []*discordgo.ApplicationCommandOption, options []*discordgo.ApplicationCommandInteractionDataOption) []string {
+	values := make(map[string]*discordgo.ApplicationCommandInteractionDataOption, len(options))
+	for _, option := range options {
+		values[option.Name] = option
+	}
+
+	var flags, params []string
+	for _, definition := range definitions {
+		option, ok := values[definition.Name]
+		if !ok {
+			continue
+		}
+		switch option.Type {
+		case discordgo.ApplicationCommandOptionBoolean:
+			if option.BoolValue() {
+				flags = append(flags, definition.Name)
+			}
+		case discordgo.ApplicationCommandOptionInteger:
+			params = append(params, strconv.FormatInt(option.IntValue(), 10))
+		default:
+			value := strings.TrimSpace(option.StringValue())
+			if definition.Name == slashSplitOption {
+				params = append(params, strings.Fields(value)...)
+			} else if value != "" {
+				params = append(params, value)
+			}
+		}
+	}
+	return append(flags, params...)
+}
+
+// interactionMessage 슬래시 명령어를 기존 핸들러가 받는 메시지 형태로 바꿉니다 (본문은 같은 텍스트 명령어)
+func interactionMessage(interaction *discordgo.InteractionCreate, command string, params []string) *discordgo.MessageCreate {
+	author := interaction.User
+	if interaction.Member != nil && interaction.Member.User != nil {
+		author = interaction.Member.User
+	}
+
+	content := constants.CommandPrefix + command
+	if len(params) > 0 {
+		content += " " + strings.Join(params, " ")
+	}
+
+	return &discordgo.MessageCreate{Message: &discordgo.Message{
+		ID:        interaction.ID,
+		ChannelID: interaction.ChannelID,
+		GuildID:   interaction.GuildID,
+		Author:    author,
+		Member:    interaction.Member,
+		Content:   content,
+	}}
+}
+
+// handleAutocomplete 대회명과 참가자 백준 ID 옵션의 자동 완성 후보를 보냅니다
+func (handler *CommandHandler) handleAutocomplete(session *discordgo.Session, interaction *discordgo.InteractionCreate) {
+	data := interaction.ApplicationCommandData()
+	options := data.Options
+	subcommand := ""
+	if len(options) == 1 && options[0].Type == discordgo.ApplicationCommandOptionSubCommand {
+		subcommand = options[0].Name
+		options = options[0].Options
+	}
+
+	var focused *discordgo.ApplicationCommandInteractionDataOption
+	for _, option := range options {
+		if option.Focused {
+			focused = option
+		}
+	}
+	if focused == nil {
+		return
+	}
+
+	scoped := handler.forChannel(interaction.ChannelID)
+	var candidates []string
+	switch {
+	case focused.Name == "competition" && subcommand == "results":
+		candidates = competitionNames(scoped.deps.Storage.GetArchivedCompetitions())
+	case focused.Name == "competition":
+		candidates = append(competitionNames(scoped.deps.Storage.GetActiveCompetitions()), "clear")
+	case focused.Name == "handle":
+		for _, participant := range scoped.deps.Storage.GetParticipants() {
+			candidates = append(candidates, participant.BaekjoonID)
+		}
+	}
+
+	err := session.InteractionRespond(interaction.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionApplicationCommandAutocompleteResult,
+		Data: &discordgo.InteractionResponseData{Choices: autocompleteChoices(candidates, focused.StringValue())},
+	})
+	if err != nil {
+		utils.Warn("Failed to send autocomplete choices: %v", err)
+	}
+}
+
+// competitionNames 대회 이름 목록을 반환합니다
+func competitionNames(competitions []models.Competition) []string {
+	names := make([]string, 0, len(competitions))
+	for _, competition := range competitions {
+		names = append(names, competition.Name)
+	}
+	return names
+}
+
+// autocompleteChoices 입력값을 포함하는 후보를 최대 MaxAutocompleteChoices개 고릅니다 (대소문자 무시)
+func autocompleteChoices(candidates []string, input string) []*discordgo.ApplicationCommandOptionChoice {
+	input = strings.ToLower(strings.TrimSpace(input))
+	choices := make([]*discordgo.ApplicationCommandOptionChoice, 0, constants.MaxAutocompleteChoices)
+	for _, candidate := range candidates {
+		if len(choices) >= constants.MaxAutocompleteChoices {
+			break
+		}
+		if strings.Contains(strings.ToLower(candidate), input) {
+			choices = append(choices, &discordgo.ApplicationCommandOptionChoice{Name: candidate, Value: candidate})
+		}
+	}
+	return choices
+}
+
+// findSlashCommand 이름으로 슬래시 명령어 정의를 찾습니다
+func findSlashCommand(name string) *discordgo.ApplicationCommand {
+	for _, command := range SlashCommands() {
+		if command.Name == name {
+			return command
+		}
+	}
+	return nil
+}
+
+// koreanName 한국어 클라이언트에 표시할 명령어 이름을 만듭니다
+func koreanName(name string) *map[discordgo.Locale]string {
+	return &map[discordgo.Locale]string{discordgo.Korean: name}
+}
+
+// subcommand 하위 명령어 옵션을 만듭니다
+func subcommand(name, description string, options ...*discordgo.ApplicationCommandOption) *discordgo.ApplicationCommandOption {
+	return &discordgo.ApplicationCommandOption{
+		Type:        discordgo.ApplicationCommandOptionSubCommand,
+		Name:        name,
+		Description: description,
+		Options:     options,
+	}
+}
+
+// stringOption 문자열 옵션을 만듭니다 (choices를 주면 그 중에서만 고를 수 있음)
+func stringOption(name, description string, required bool, choices ...string) *discordgo.ApplicationCommandOption {
+	option := &discordgo.ApplicationCommandOption{
+		Type:        discordgo.ApplicationCommandOptionString,
+		Name:        name,
+		Description: description,
+		Required:    required,
+	}
+	for _, choice := range choices {
+		option.Choices = append(option.Choices, &discordgo.ApplicationCommandOptionChoice{Name: choice, Value: choice})
+	}
+	return option
+}
+
+// autocompleteOption 자동 완성을 제공하는 필수 문자열 옵션을 만듭니다
+func autocompleteOption(name, description string) *discordgo.ApplicationCommandOption {
+	option := stringOption(name, description, true)
+	option.Autocomplete = true
+	return option
+}
+
+// integerOption 1 이상의 선택 정수 옵션을 만듭니다
+func integerOption(name, description string) *discordgo.ApplicationCommandOption {
+	minValue := 1.0
+	return &discordgo.ApplicationCommandOption{
+		Type:        discordgo.ApplicationCommandOptionInteger,
+		Name:        name,
+		Description: description,
+		MinValue:    &minValue,
+	}
+}
+
+// boolOption 선택 불리언 옵션을 만듭니다
+func boolOption(name, description string) *discordgo.ApplicationCommandOption {
+	return &discordgo.ApplicationCommandOption{
+		Type:        discordgo.ApplicationCommandOptionBoolean,
+		Name:        name,
+		Description: description,
+	}
+}
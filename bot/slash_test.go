@@ -0,0 +1,165 @@
+package bot
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+func TestSlashCommands_Definitions(t *testing.T) {
+	for _, command := range SlashCommands() {
+		if len(command.Description) == 0 || len([]rune(command.Description)) > 100 {
+			t.Errorf("Command %s has invalid description length", command.Name)
+		}
+		checkOptionOrder(t, command.Name, command.Options)
+		for _, option := range command.Options {
+			if option.Type == discordgo.ApplicationCommandOptionSubCommand {
+				checkOptionOrder(t, command.Name+" "+option.Name, option.Options)
+			}
+		}
+	}
+}
+
+// checkOptionOrder 디스코드는 필수 옵션이 선택 옵션보다 앞에 와야 합니다
+func checkOptionOrder(t *testing.T, name string, options []*discordgo.ApplicationCommandOption) {
+	t.Helper()
+	optionalSeen := false
+	for _, option := range options {
+		if option.Type == discordgo.ApplicationCommandOptionSubCommand {
+			continue
+		}
+		if option.Required && optionalSeen {
+			t.Errorf("Command %s has required option %s after an optional one", name, option.Name)
+		}
+		optionalSeen = optionalSeen || !option.Required
+	}
+}
+
+func TestSlashCommandsHaveNoDefaultPermissions(t *testing.T) {
+	// 권한은 routeCommand에서 확인하므로, `!권한`으로 위임받은 역할도 명령어를 볼 수 있어야 합니다
+	for _, command := range SlashCommands() {
+		if command.DefaultMemberPermissions != nil {
+			t.Errorf("Command %s should not hide itself behind a default permission", command.Name)
+		}
+	}
+}
+
+func TestArchiveSlashCommandIsPublic(t *testing.T) {
+	archive := findSlashCommand(slashArchiveCommand)
+	if archive == nil || archive.DefaultMemberPermissions != nil {
+		t.Fatalf("Expected a public archive command, got %+v", archive)
+	}
+	for _, option := range archive.Options {
+		if _, required := commandCapability("competition", []string{option.Name}); required {
+			t.Errorf("Archive subcommand %s must not require a capability", option.Name)
+		}
+	}
+	for _, option := range findSlashCommand("competition").Options {
+		if option.Name == "history" || option.Name == "results" {
+			t.Errorf("Public subcommand %s should not be under the admin competition command", option.Name)
+		}
+	}
+}
+
+func TestSlashCommandParams(t *testing.T) {
+	option := func(name string, optionType discordgo.ApplicationCommandOptionType, value interface{}) *discordgo.ApplicationCommandInteractionDataOption {
+		return &discordgo.ApplicationCommandInteractionDataOption{Name: name, Type: optionType, Value: value}
+	}
+	sub := func(name string, options ...*discordgo.ApplicationCommandInteractionDataOption) []*discordgo.ApplicationCommandInteractionDataOption {
+		return []*discordgo.ApplicationCommandInteractionDataOption{{Name: name, Type: discordgo.ApplicationCommandOptionSubCommand, Options: options}}
+	}
+
+	tests := []struct {
+		name     string
+		data     discordgo.ApplicationCommandInteractionData
+		command  string
+		expected []string
+	}{
+		{
+			name: "register keeps name with spaces",
+			data: discordgo.ApplicationCommandInteractionData{Name: "register", Options: []*discordgo.ApplicationCommandInteractionDataOption{
+				option("handle", discordgo.ApplicationCommandOptionString, "alice"),
+				option("name", discordgo.ApplicationCommandOptionString, "김 철수"),
+			}},
+			expected: []string{"김 철수", "alice"},
+		},
+		{
+			name: "boolean flag comes first",
+			data: discordgo.ApplicationCommandInteractionData{Name: "competition", Options: sub("mode",
+				option("preview", discordgo.ApplicationCommandOptionBoolean, true),
+				option("mode", discordgo.ApplicationCommandOptionString, "level"))},
+			expected: []string{"mode", "preview", "level"},
+		},
+		{
+			name: "false flag is omitted",
+			data: discordgo.ApplicationCommandInteractionData{Name: "competition", Options: sub("finalize",
+				option("force", discordgo.ApplicationCommandOptionBoolean, false))},
+			expected: []string{"finalize"},
+		},
+		{
+			name: "split option and integer",
+			data: discordgo.ApplicationCommandInteractionData{Name: "competition", Options: sub("problems",
+				option("action", discordgo.ApplicationCommandOptionString, "set"),
+				option("args", discordgo.ApplicationCommandOptionString, "1000 1001:2"))},
+			expected: []string{"problems", "set", "1000", "1001:2"},
+		},
+		{
+			name: "archive results run as competition and skip missing handle",
+			data: discordgo.ApplicationCommandInteractionData{Name: "archive", Options: sub("results",
+				option("competition", discordgo.ApplicationCommandOptionString, "봄 대회"),
+				option("page", discordgo.ApplicationCommandOptionInteger, float64(2)))},
+			command:  "competition",
+			expected: []string{"results", "봄 대회", "2"},
+		},
+	}
+
+	for _, test := range tests {
+		command, params := slashCommandParams(test.data)
+		expectedCommand := test.command
+		if expectedCommand == "" {
+			expectedCommand = test.data.Name
+		}
+		if command != expectedCommand || !reflect.DeepEqual(params, test.expected) {
+			t.Errorf("%s: got %s %q, expected %q", test.name, command, params, test.expected)
+		}
+	}
+}
+
+func TestInteractionMessage(t *testing.T) {
+	interaction := &discordgo.InteractionCreate{Interaction: &discordgo.Interaction{
+		ID:        "1",
+		ChannelID: "channel",
+		GuildID:   "guild",
+		Member:    &discordgo.Member{User: &discordgo.User{ID: "user"}},
+	}}
+
+	message := interactionMessage(interaction, "competition", []string{"rules", "set", `{"name":"x"}`})
+	if message.Author == nil || message.Author.ID != "user" || message.GuildID != "guild" || message.ChannelID != "channel" {
+		t.Errorf("Expected member as author in the same channel, got %+v", message.Message)
+	}
+	if message.Content != `!competition rules set {"name":"x"}` {
+		t.Errorf("Unexpected content %q", message.Content)
+	}
+
+	dm := &discordgo.InteractionCreate{Interaction: &discordgo.Interaction{ChannelID: "dm", User: &discordgo.User{ID: "user"}}}
+	if message := interactionMessage(dm, "register", nil); message.Author.ID != "user" || message.Content != "!register" {
+		t.Errorf("Expected DM user as author, got %+v", message.Message)
+	}
+}
+
+func TestAutocompleteChoices(t *testing.T) {
+	candidates := []string{"Alice", "bob", "alicia"}
+	choices := autocompleteChoices(candidates, "ALI")
+	if len(choices) != 2 || choices[0].Value != "Alice" || choices[1].Name != "alicia" {
+		t.Errorf("Expected case-insensitive matches, got %+v", choices)
+	}
+
+	many := make([]string, 40)
+	for i := range many {
+		many[i] = "user"
+	}
+	if got := autocompleteChoices(many, ""); len(got) != 25 {
+		t.Errorf("Expected choices capped at 25, got %d", len(got))
+	}
+}
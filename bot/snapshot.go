@@ -0,0 +1,197 @@
+package bot
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/ssugameworks/kkemi/constants"
+	"github.com/ssugameworks/kkemi/models"
+	"github.com/ssugameworks/kkemi/utils"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+// TakeSnapshot 현재 점수를 계산하여 스냅샷으로 저장합니다 (스코어보드를 보내지 않는 블랙아웃 기간용)
+func (manager *ScoreboardManager) TakeSnapshot() error {
+	scores, err := manager.CollectScoreData()
+	if err != nil {
+		return err
+	}
+	if len(scores) == 0 {
+		return nil
+	}
+	return manager.saveSnapshot(scores)
+}
+
+// saveSnapshot 점수 데이터를 순위와 함께 지금 시각의 스냅샷으로 저장합니다
+func (manager *ScoreboardManager) saveSnapshot(scores []models.ScoreData) error {
+	competition := manager.storage.GetCompetition()
+	if competition == nil {
+		return fmt.Errorf("활성화된 대회가 없습니다")
+	}
+
+	snapshot := models.NewScoreSnapshot(scores, manager.rankScoresFor(competition, scores), time.Now())
+	if err := manager.storage.SaveSnapshot(snapshot); err != nil {
+		utils.Error("Failed to save score snapshot for %s: %v", competition.Name, err)
+		return err
+	}
+	return nil
+}
+
+// latestSnapshot 가장 최근 스냅샷을 반환합니다 (없거나 조회에 실패하면 nil)
+func (manager *ScoreboardManager) latestSnapshot() *models.ScoreSnapshot {
+	snapshot, err := manager.storage.GetLatestSnapshot()
+	if err != nil {
+		utils.Warn("Failed to load latest score snapshot: %v", err)
+		return nil
+	}
+	return snapshot
+}
+
+// rankScoresFor 대회 유형에 맞는 순위를 계산합니다 (문제 목록 대회는 전체 순위, 그 외는 리그별 순위)
+func (manager *ScoreboardManager) rankScoresFor(competition *models.Competition, scores []models.ScoreData) map[string]int {
+	if competition.IsProblemSet() {
+		return problemSetRanks(scores)
+	}
+	return manager.rankScores(scores)
+}
+
+// ScoreboardAsOf 지정한 날짜(KST)가 끝나기 전 마지막 스냅샷으로 당시의 스코어보드를 다시 만듭니다
+// 해당 시점의 스냅샷이 없으면 found=false를 반환합니다
+func (manager *ScoreboardManager) ScoreboardAsOf(date time.Time) (embed *discordgo.MessageEmbed, found bool, err error) {
+	competition := manager.storage.GetCompetition()
+	if competition == nil || !competition.IsActive {
+		return nil, false, fmt.Errorf("활성화된 대회가 없습니다")
+	}
+
+	snapshots, err := manager.storage.GetSnapshots()
+	if err != nil {
+		return nil, false, err
+	}
+	snapshot, index, found := models.SnapshotBefore(snapshots, date.AddDate(0, 0, 1))
+	if !found {
+		return nil, false, nil
+	}
+
+	var previous *models.ScoreSnapshot
+	if index > 0 {
+		previous = &snapshots[index-1]
+	}
+
+	embed = manager.formatScoreboard(competition, snapshot.Scores(), previous)
+	takenAt := snapshot.TakenAt.In(utils.GetCurrentTimeKST().Location())
+	embed.Title = fmt.Sprintf(constants.MsgScoreboardAsOfTitle, competition.Name, utils.FormatDateTime(takenAt))
+	embed.Footer = &discordgo.MessageEmbedFooter{Text: constants.MsgScoreboardAsOfFooter}
+	return embed, true, nil
+}
+
+// formatRankChange 이전 스냅샷 대비 순위 변화를 화살표로 표시합니다 (스냅샷이 없으면 빈 문자열)
+func formatRankChange(previous *models.ScoreSnapshot, baekjoonID string, league, rank int) string {
+	if previous == nil {
+		return ""
+	}
+	delta, ok := previous.RankChange(baekjoonID, league, rank)
+	switch {
+	case !ok:
+		return constants.MsgRankChangeNew
+	case delta > 0:
+		return fmt.Sprintf(constants.MsgRankChangeUp, delta)
+	case delta < 0:
+		return fmt.Sprintf(constants.MsgRankChangeDown, -delta)
+	default:
+		return constants.MsgRankChangeSame
+	}
+}
+
+// handleTrend 참가자의 스냅샷별 점수와 순위 추이를 보여줍니다
+func (handler *CommandHandler) handleTrend(session *discordgo.Session, message *discordgo.MessageCreate, params []string) {
+	errorHandlers := utils.NewErrorHandlerFactory(session, message.ChannelID)
+
+	competition := handler.deps.Storage.GetCompetition()
+	if competition == nil || !competition.IsActive {
+		errorHandlers.Data().HandleNoActiveCompetition()
+		return
+	}
+
+	if len(params) > 1 || (len(params) == 1 && !utils.IsValidBaekjoonID(params[0])) {
+		errorHandlers.Validation().HandleInvalidParams("TREND_INVALID_PARAMS",
+			"Invalid trend parameters",
+			constants.MsgTrendUsage)
+		return
+	}
+
+	handle := ""
+	if len(params) == 1 {
+		handle = params[0]
+	} else {
+		handle = handler.resolveAuthorHandle(message)
+	}
+	participant, found := findParticipant(handler.deps.Storage.GetParticipants(), handle)
+	if handle == "" || !found {
+		errorHandlers.Validation().HandleInvalidParams("TREND_PARTICIPANT_NOT_FOUND",
+			fmt.Sprintf("Participant not found: %s", handle),
+			constants.MsgTrendUsage)
+		return
+	}
+
+	snapshots, err := handler.deps.Storage.GetSnapshots()
+	if err != nil {
+		errorHandlers.System().HandleSystemError("TREND_LOAD_FAILED",
+			"Failed to load score snapshots",
+			"점수 기록을 불러오는 중 오류가 발생했습니다.", err)
+		return
+	}
+
+	// 블랙아웃 중에는 블랙아웃 시작 이후 기록을 숨깁니다
+	hidden := handler.deps.ScoreboardManager.IsScoreHidden(handler.hasCapability(session, message, models.CapabilityViewHiddenScoreboard))
+	snapshots = visibleSnapshots(snapshots, competition, hidden)
+
+	embed := formatTrend(participant.BaekjoonID, models.ScoreTrend(snapshots, participant.BaekjoonID))
+	if hidden {
+		embed.Footer = &discordgo.MessageEmbedFooter{Text: constants.MsgTrendBlackoutFooter}
+	}
+	if _, err := session.ChannelMessageSendEmbed(message.ChannelID, embed); err != nil {
+		utils.Error("DISCORD API ERROR: Failed to send score trend: %v", err)
+	}
+}
+
+// formatTrend 점수 추이를 최근 기록부터 최대 MaxTrendPoints개 표로 만듭니다
+func formatTrend(baekjoonID string, points []models.TrendPoint) *discordgo.MessageEmbed {
+	embed := &discordgo.MessageEmbed{
+		Title: fmt.Sprintf(constants.MsgTrendTitle, baekjoonID),
+		Color: constants.ColorTierGold,
+	}
+	if len(points) == 0 {
+		embed.Description = constants.MsgTrendEmpty
+		return embed
+	}
+
+	start := 0
+	if len(points) > constants.MaxTrendPoints {
+		start = len(points) - constants.MaxTrendPoints
+	}
+
+	location := utils.GetCurrentTimeKST().Location()
+	var builder strings.Builder
+	builder.WriteString("```\n")
+	for i := start; i < len(points); i++ {
+		point := points[i]
+		line := fmt.Sprintf("%s %*.0f점 %3d위", point.TakenAt.In(location).Format(constants.TrendDateFormat),
+			constants.ScoreboardScoreWidth, point.Score, point.Rank)
+		if i > 0 && points[i-1].League == point.League {
+			line += fmt.Sprintf(" (%+.0f)", point.Score-points[i-1].Score)
+			if delta := points[i-1].Rank - point.Rank; delta > 0 {
+				line += fmt.Sprintf(constants.MsgRankChangeUp, delta)
+			} else if delta < 0 {
+				line += fmt.Sprintf(constants.MsgRankChangeDown, -delta)
+			}
+		}
+		builder.WriteString(line + "\n")
+	}
+	builder.WriteString("```")
+
+	first, last := points[start], points[len(points)-1]
+	embed.Description = fmt.Sprintf(constants.MsgTrendSummary, len(points), last.Score-first.Score) + "\n" + builder.String()
+	return embed
+}
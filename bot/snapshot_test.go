@@ -0,0 +1,55 @@
+package bot
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ssugameworks/kkemi/models"
+)
+
+func TestFormatRankChange(t *testing.T) {
+	previous := &models.ScoreSnapshot{Entries: []models.SnapshotEntry{
+		{BaekjoonID: "alice", League: 1, Rank: 4},
+		{BaekjoonID: "bob", League: 1, Rank: 1},
+		{BaekjoonID: "carol", League: 1, Rank: 2},
+	}}
+
+	tests := []struct {
+		id       string
+		rank     int
+		expected string
+	}{
+		{"alice", 1, " ▲3"},
+		{"bob", 2, " ▼1"},
+		{"carol", 2, " -"},
+		{"dave", 3, " NEW"},
+	}
+	for _, test := range tests {
+		if got := formatRankChange(previous, test.id, 1, test.rank); got != test.expected {
+			t.Errorf("formatRankChange(%s) = %q, expected %q", test.id, got, test.expected)
+		}
+	}
+	if got := formatRankChange(nil, "alice", 1, 1); got != "" {
+		t.Errorf("Expected no marker without a previous snapshot, got %q", got)
+	}
+}
+
+func TestFormatTrend(t *testing.T) {
+	points := []models.TrendPoint{
+		{TakenAt: time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC), Score: 10, Rank: 3, League: 1},
+		{TakenAt: time.Date(2025, 3, 2, 0, 0, 0, 0, time.UTC), Score: 25, Rank: 1, League: 1},
+	}
+
+	embed := formatTrend("alice", points)
+
+	if !strings.Contains(embed.Description, "+15점") {
+		t.Errorf("Expected total change in summary, got %q", embed.Description)
+	}
+	if !strings.Contains(embed.Description, "(+15) ▲2") {
+		t.Errorf("Expected per-snapshot change with rank arrow, got %q", embed.Description)
+	}
+	if empty := formatTrend("alice", nil); !strings.Contains(empty.Description, "기록이 없습니다") {
+		t.Errorf("Expected empty notice, got %q", empty.Description)
+	}
+}
@@ -0,0 +1,179 @@
+package bot
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/ssugameworks/kkemi/api"
+	"github.com/ssugameworks/kkemi/constants"
+	"github.com/ssugameworks/kkemi/models"
+	"github.com/ssugameworks/kkemi/utils"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+// handleStreakboard 잔디(연속 해결) 리더보드를 보여줍니다
+func (handler *CommandHandler) handleStreakboard(session *discordgo.Session, message *discordgo.MessageCreate) {
+	errorHandlers := utils.NewErrorHandlerFactory(session, message.ChannelID)
+
+	competition := handler.deps.Storage.GetCompetition()
+	if competition == nil || !competition.IsActive {
+		errorHandlers.Data().HandleNoActiveCompetition()
+		return
+	}
+
+	embed, err := handler.deps.ScoreboardManager.GenerateStreakboard(handler.isAdmin(session, message))
+	if err != nil {
+		utils.Error("Failed to generate streakboard: %v", err)
+		errorHandlers.System().HandleScoreboardGenerationFailed(err)
+		return
+	}
+
+	if _, err := session.ChannelMessageSendEmbed(message.ChannelID, embed); err != nil {
+		utils.Error("DISCORD API ERROR: Failed to send streakboard: %v", err)
+	}
+}
+
+// GenerateStreakboard 저장된 해결 기록으로 잔디 리더보드를 생성합니다 (solved.ac를 호출하지 않음)
+// 스코어보드와 같이 블랙아웃 기간에는 관리자가 아니면 공개하지 않습니다 (마지막날에는 공개)
+func (manager *ScoreboardManager) GenerateStreakboard(isAdmin bool) (*discordgo.MessageEmbed, error) {
+	competition := manager.storage.GetCompetition()
+	if competition == nil || !competition.IsActive {
+		return nil, fmt.Errorf("활성화된 대회가 없습니다")
+	}
+
+	if embed := manager.checkBlackoutPeriod(competition, isAdmin || isLastDay(competition)); embed != nil {
+		return embed, nil
+	}
+
+	participants := manager.storage.GetParticipants()
+	if embed := manager.checkEmptyParticipants(competition, participants); embed != nil {
+		return embed, nil
+	}
+
+	return formatStreakboard(competition, manager.collectStreakData(competition, participants)), nil
+}
+
+// collectStreakData 정기 수집이 저장해 둔 문제별 최초 발견 시각으로 참가자들의 연속 해결 기록을 계산합니다
+func (manager *ScoreboardManager) collectStreakData(competition *models.Competition, participants []models.Participant) []models.ScoreData {
+	calculator := manager.calculator.ForCompetition(competition)
+	today := streakReferenceTime(competition)
+	scores := make([]models.ScoreData, 0, len(participants))
+	for _, participant := range participants {
+		firstSeen, err := manager.storage.GetSolveRecords(participant.BaekjoonID)
+		if err != nil {
+			utils.Warn("Failed to load solve records of %s for streakboard: %v", participant.BaekjoonID, err)
+			continue
+		}
+
+		recorded := make([]api.ProblemInfo, 0, len(firstSeen))
+		for problemID := range firstSeen {
+			recorded = append(recorded, api.ProblemInfo{ProblemID: problemID})
+		}
+		solved := calculator.FilterSolvesInWindow(recorded, firstSeen, competition)
+		scores = append(scores, models.ScoreData{
+			ParticipantID: participant.ID,
+			Name:          participant.Name,
+			BaekjoonID:    participant.BaekjoonID,
+			Streak:        calculator.CalculateStreak(solved, firstSeen, participant.GetStartProblemIDs(), today),
+		})
+	}
+	return scores
+}
+
+// sortByStreak 진행 중인 연속 기록, 최장 기록, 아이디 순으로 정렬된 사본을 반환합니다
+func sortByStreak(scores []models.ScoreData) []models.ScoreData {
+	sorted := make([]models.ScoreData, 0, len(scores))
+	for _, score := range scores {
+		if score.Streak.Longest > 0 {
+			sorted = append(sorted, score)
+		}
+	}
+
+	sort.SliceStable(sorted, func(i, j int) bool {
+		if sorted[i].Streak.Current != sorted[j].Streak.Current {
+			return sorted[i].Streak.Current > sorted[j].Streak.Current
+		}
+		if sorted[i].Streak.Longest != sorted[j].Streak.Longest {
+			return sorted[i].Streak.Longest > sorted[j].Streak.Longest
+		}
+		return sorted[i].BaekjoonID < sorted[j].BaekjoonID
+	})
+	return sorted
+}
+
+// formatStreakboard 잔디 리더보드 embed를 만듭니다 (리그 구분 없이 전체 순위)
+func formatStreakboard(competition *models.Competition, scores []models.ScoreData) *discordgo.MessageEmbed {
+	embed := &discordgo.MessageEmbed{
+		Title: fmt.Sprintf(constants.MsgStreakboardTitle, competition.Name),
+		Color: constants.ColorTierGold,
+		Footer: &discordgo.MessageEmbedFooter{
+			Text: constants.MsgStreakboardFooter,
+		},
+	}
+
+	sorted := sortByStreak(scores)
+	if len(sorted) == 0 {
+		embed.Description = constants.MsgStreakboardEmpty
+		return embed
+	}
+
+	var builder strings.Builder
+	builder.WriteString("```\n")
+	builder.WriteString(fmt.Sprintf("%-*s %-*s %4s %4s\n",
+		constants.ScoreboardRankWidth, "순위",
+		constants.ScoreboardNameWidth, "아이디",
+		"현재", "최장"))
+	builder.WriteString(constants.ScoreboardSeparator + "\n")
+
+	rank := 0
+	for i, score := range sorted {
+		if i >= constants.MaxStreakboardLines {
+			break
+		}
+		if i == 0 || score.Streak.Current != sorted[i-1].Streak.Current || score.Streak.Longest != sorted[i-1].Streak.Longest {
+			rank = i + 1
+		}
+		builder.WriteString(fmt.Sprintf("%-*d  %-*s %4d %4d\n",
+			constants.ScoreboardRankWidth, rank,
+			constants.ScoreboardNameWidth, utils.TruncateString(score.BaekjoonID, constants.ScoreboardNameWidth),
+			score.Streak.Current, score.Streak.Longest))
+	}
+	builder.WriteString("```")
+
+	embed.Description = builder.String()
+	return embed
+}
+
+// formatBrokenStreaks 어제 끊긴 연속 기록을 알리는 embed를 만듭니다 (없으면 nil)
+func formatBrokenStreaks(scores []models.ScoreData) *discordgo.MessageEmbed {
+	broken := make([]models.ScoreData, 0)
+	for _, score := range scores {
+		if score.Streak.Broken >= constants.MinReportedBrokenDays {
+			broken = append(broken, score)
+		}
+	}
+	if len(broken) == 0 {
+		return nil
+	}
+
+	sort.SliceStable(broken, func(i, j int) bool {
+		if broken[i].Streak.Broken != broken[j].Streak.Broken {
+			return broken[i].Streak.Broken > broken[j].Streak.Broken
+		}
+		return broken[i].BaekjoonID < broken[j].BaekjoonID
+	})
+
+	var builder strings.Builder
+	for _, score := range broken {
+		builder.WriteString(fmt.Sprintf(constants.MsgStreakBrokenLine, score.BaekjoonID, score.Streak.Broken))
+		builder.WriteString("\n")
+	}
+
+	return &discordgo.MessageEmbed{
+		Title:       constants.MsgStreakBrokenTitle,
+		Description: builder.String(),
+		Color:       constants.ColorTierGold,
+	}
+}
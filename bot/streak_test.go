@@ -0,0 +1,98 @@
+package bot
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ssugameworks/kkemi/constants"
+	"github.com/ssugameworks/kkemi/models"
+	"github.com/ssugameworks/kkemi/scoring"
+	"github.com/ssugameworks/kkemi/storage"
+)
+
+func TestSortByStreak(t *testing.T) {
+	scores := []models.ScoreData{
+		{BaekjoonID: "none"},
+		{BaekjoonID: "bravo", Streak: models.StreakStats{Current: 2, Longest: 5}},
+		{BaekjoonID: "alpha", Streak: models.StreakStats{Current: 2, Longest: 5}},
+		{BaekjoonID: "charlie", Streak: models.StreakStats{Current: 4, Longest: 4}},
+	}
+
+	sorted := sortByStreak(scores)
+	var ids []string
+	for _, score := range sorted {
+		ids = append(ids, score.BaekjoonID)
+	}
+	if got := strings.Join(ids, ","); got != "charlie,alpha,bravo" {
+		t.Errorf("Unexpected streak order: %s", got)
+	}
+}
+
+func TestFormatBrokenStreaks(t *testing.T) {
+	if embed := formatBrokenStreaks([]models.ScoreData{{BaekjoonID: "alpha", Streak: models.StreakStats{Broken: 1}}}); embed != nil {
+		t.Errorf("One day streaks should not be reported, got %v", embed.Description)
+	}
+
+	embed := formatBrokenStreaks([]models.ScoreData{
+		{BaekjoonID: "alpha", Streak: models.StreakStats{Broken: 3}},
+		{BaekjoonID: "bravo", Streak: models.StreakStats{Current: 5}},
+	})
+	if embed == nil || !strings.Contains(embed.Description, "alpha") || strings.Contains(embed.Description, "bravo") {
+		t.Errorf("Expected only alpha in broken streaks, got %v", embed)
+	}
+}
+
+func TestGenerateStreakboardUsesStoredSolves(t *testing.T) {
+	client := &MockSolvedACClient{}
+	store := storage.NewInMemoryStorage(client)
+	now := time.Now()
+	start := now.AddDate(0, 0, -3)
+	if _, err := store.CreateCompetition("test", start, start.AddDate(0, 0, 10)); err != nil {
+		t.Fatalf("Failed to create competition: %v", err)
+	}
+	if err := store.AddParticipant("홍길동", "player", 6, 0, 0, ""); err != nil {
+		t.Fatalf("Failed to add participant: %v", err)
+	}
+	for i, seenAt := range []time.Time{now.AddDate(0, 0, -2), now.AddDate(0, 0, -1), now} {
+		if _, err := store.RecordSolves("player", []int{1000 + i}, seenAt); err != nil {
+			t.Fatalf("Failed to record solves: %v", err)
+		}
+	}
+
+	// solved.ac를 호출하면 실패하도록 만들어 저장된 기록만 쓰는지 확인합니다
+	client.shouldError = true
+	tierManager := models.GetTierManager()
+	manager := NewScoreboardManager(store, scoring.NewScoreCalculator(client, tierManager), client, tierManager)
+
+	embed, err := manager.GenerateStreakboard(false)
+	if err != nil {
+		t.Fatalf("Expected streakboard without solved.ac, got %v", err)
+	}
+	if !strings.Contains(embed.Description, "player") || !strings.Contains(embed.Description, "   3    3") {
+		t.Errorf("Expected a 3 day streak for player, got %q", embed.Description)
+	}
+}
+
+func TestGenerateStreakboardHiddenDuringBlackout(t *testing.T) {
+	client := &MockSolvedACClient{}
+	store := storage.NewInMemoryStorage(client)
+	end := time.Now().AddDate(0, 0, 1)
+	if _, err := store.CreateCompetition("test", end.AddDate(0, 0, -7), end); err != nil {
+		t.Fatalf("Failed to create competition: %v", err)
+	}
+	tierManager := models.GetTierManager()
+	manager := NewScoreboardManager(store, scoring.NewScoreCalculator(client, tierManager), client, tierManager)
+
+	embed, err := manager.GenerateStreakboard(false)
+	if err != nil {
+		t.Fatalf("Failed to generate streakboard: %v", err)
+	}
+	if embed.Title != constants.MsgScoreboardBlackout {
+		t.Errorf("Expected blackout embed for members, got %q", embed.Title)
+	}
+
+	if embed, _ := manager.GenerateStreakboard(true); embed.Title == constants.MsgScoreboardBlackout {
+		t.Error("Expected admins to see the streakboard during blackout")
+	}
+}
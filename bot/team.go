@@ -0,0 +1,283 @@
+package bot
+
+import (
+	stderrors "errors"
+	"fmt"
+	"strings"
+
+	"github.com/ssugameworks/kkemi/constants"
+	"github.com/ssugameworks/kkemi/errors"
+	"github.com/ssugameworks/kkemi/interfaces"
+	"github.com/ssugameworks/kkemi/models"
+	"github.com/ssugameworks/kkemi/utils"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+// handleTeam 팀 목록 조회, 생성, 가입/탈퇴와 관리자 팀 배정을 처리합니다
+func (handler *CommandHandler) handleTeam(session *discordgo.Session, message *discordgo.MessageCreate, params []string) {
+	errorHandlers := utils.NewErrorHandlerFactory(session, message.ChannelID)
+
+	competition := handler.deps.Storage.GetCompetition()
+	if competition == nil || !competition.IsActive {
+		errorHandlers.Data().HandleNoActiveCompetition()
+		return
+	}
+
+	action := "list"
+	if len(params) > 0 {
+		action = params[0]
+	}
+
+	switch action {
+	case "list":
+		handler.handleTeamList(session, message)
+	case "create":
+		handler.handleTeamCreate(session, message, params[1:])
+	case "join":
+		handler.handleTeamJoin(session, message, params[1:])
+	case "leave":
+		handler.handleTeamLeave(session, message)
+	case "assign", "unassign", "delete":
+		handler.handleTeamAdmin(session, message, action, params[1:])
+	default:
+		errorHandlers.Validation().HandleInvalidParams("TEAM_INVALID_PARAMS",
+			fmt.Sprintf("Unknown team action: %s", action),
+			constants.MsgTeamUsage)
+	}
+}
+
+// handleTeamList 팀과 팀원 목록을 보여줍니다
+func (handler *CommandHandler) handleTeamList(session *discordgo.Session, message *discordgo.MessageCreate) {
+	teams := handler.deps.Storage.GetTeams()
+	if len(teams) == 0 {
+		errors.SendDiscordInfo(session, message.ChannelID, constants.MsgTeamEmpty)
+		return
+	}
+
+	var builder strings.Builder
+	for _, team := range teams {
+		members := constants.MsgTeamNoMembers
+		if len(team.Members) > 0 {
+			members = strings.Join(team.Members, ", ")
+		}
+		builder.WriteString(fmt.Sprintf("**%s** (%d/%d) — %s\n", team.Name, len(team.Members), constants.MaxTeamSize, members))
+	}
+
+	embed := &discordgo.MessageEmbed{
+		Title:       fmt.Sprintf(constants.MsgTeamListTitle, len(teams)),
+		Description: builder.String(),
+		Color:       constants.ColorTierGold,
+	}
+	if _, err := session.ChannelMessageSendEmbed(message.ChannelID, embed); err != nil {
+		utils.Error("DISCORD API ERROR: Failed to send team list: %v", err)
+	}
+}
+
+// handleTeamCreate 팀을 만들고, 작성자가 참가자라면 바로 가입시킵니다
+func (handler *CommandHandler) handleTeamCreate(session *discordgo.Session, message *discordgo.MessageCreate, params []string) {
+	errorHandlers := utils.NewErrorHandlerFactory(session, message.ChannelID)
+
+	if len(params) != 1 || !utils.IsValidTeamName(params[0]) {
+		errorHandlers.Validation().HandleInvalidParams("TEAM_INVALID_NAME",
+			"Invalid team name",
+			constants.MsgTeamInvalidName)
+		return
+	}
+	name := params[0]
+
+	if _, exists := findTeam(handler.deps.Storage.GetTeams(), name); exists {
+		errorHandlers.Validation().HandleInvalidParams("TEAM_ALREADY_EXISTS",
+			fmt.Sprintf("Team already exists: %s", name),
+			fmt.Sprintf(constants.MsgTeamAlreadyExists, name))
+		return
+	}
+
+	// 대회 관리 권한이 없으면 참가자만 팀을 만들 수 있습니다
+	handle := handler.linkedAuthorHandle(message)
+	if handle == "" && !handler.hasCapability(session, message, models.CapabilityManageCompetition) {
+		errorHandlers.Validation().HandleInvalidParams("TEAM_NOT_PARTICIPANT",
+			"Team creator is not a participant",
+			constants.MsgTeamNotParticipant)
+		return
+	}
+
+	if err := handler.deps.Storage.CreateTeam(name); err != nil {
+		errorHandlers.System().HandleSystemError("TEAM_CREATE_FAILED", "Failed to create team", constants.MsgTeamUpdateFailed, err)
+		return
+	}
+
+	response := fmt.Sprintf(constants.MsgTeamCreated, name)
+	if handle != "" {
+		if err := handler.deps.Storage.AssignTeam(name, handle); err != nil {
+			errorHandlers.System().HandleSystemError("TEAM_JOIN_FAILED", "Failed to join created team", constants.MsgTeamUpdateFailed, err)
+			return
+		}
+		response += "\n" + fmt.Sprintf(constants.MsgTeamJoined, handle, name)
+	}
+	errors.SendDiscordSuccess(session, message.ChannelID, response)
+}
+
+// handleTeamJoin 작성자를 팀에 가입시킵니다 (기존 팀에서는 자동으로 빠짐)
+func (handler *CommandHandler) handleTeamJoin(session *discordgo.Session, message *discordgo.MessageCreate, params []string) {
+	errorHandlers := utils.NewErrorHandlerFactory(session, message.ChannelID)
+
+	if len(params) != 1 {
+		errorHandlers.Validation().HandleInvalidParams("TEAM_INVALID_PARAMS",
+			"Invalid team join parameters",
+			constants.MsgTeamUsage)
+		return
+	}
+
+	handle := handler.linkedAuthorHandle(message)
+	if handle == "" {
+		errorHandlers.Validation().HandleInvalidParams("TEAM_NOT_PARTICIPANT",
+			"Team member is not a participant",
+			constants.MsgTeamNotParticipant)
+		return
+	}
+
+	handler.assignTeam(session, message, params[0], handle)
+}
+
+// handleTeamLeave 작성자를 소속 팀에서 뺍니다
+func (handler *CommandHandler) handleTeamLeave(session *discordgo.Session, message *discordgo.MessageCreate) {
+	errorHandlers := utils.NewErrorHandlerFactory(session, message.ChannelID)
+
+	handle := handler.linkedAuthorHandle(message)
+	if handle == "" {
+		errorHandlers.Validation().HandleInvalidParams("TEAM_NOT_PARTICIPANT",
+			"Team member is not a participant",
+			constants.MsgTeamNotParticipant)
+		return
+	}
+
+	handler.leaveTeam(session, message, handle)
+}
+
+// handleTeamAdmin 관리자의 팀 배정/배정 해제/삭제를 처리합니다
+func (handler *CommandHandler) handleTeamAdmin(session *discordgo.Session, message *discordgo.MessageCreate, action string, params []string) {
+	errorHandlers := utils.NewErrorHandlerFactory(session, message.ChannelID)
+
+	switch {
+	case action == "assign" && len(params) == 2:
+		participant, found := findParticipant(handler.deps.Storage.GetParticipants(), params[0])
+		if !found {
+			errorHandlers.Data().HandleParticipantNotFound(params[0])
+			return
+		}
+		handler.assignTeam(session, message, params[1], participant.BaekjoonID)
+	case action == "unassign" && len(params) == 1:
+		participant, found := findParticipant(handler.deps.Storage.GetParticipants(), params[0])
+		if !found {
+			errorHandlers.Data().HandleParticipantNotFound(params[0])
+			return
+		}
+		handler.leaveTeam(session, message, participant.BaekjoonID)
+	case action == "delete" && len(params) == 1:
+		team, found := findTeam(handler.deps.Storage.GetTeams(), params[0])
+		if !found {
+			errorHandlers.Validation().HandleInvalidParams("TEAM_NOT_FOUND",
+				fmt.Sprintf("Team not found: %s", params[0]),
+				fmt.Sprintf(constants.MsgTeamNotFound, params[0]))
+			return
+		}
+		if err := handler.deps.Storage.DeleteTeam(team.Name); err != nil {
+			errorHandlers.System().HandleSystemError("TEAM_DELETE_FAILED", "Failed to delete team", constants.MsgTeamUpdateFailed, err)
+			return
+		}
+		errors.SendDiscordSuccess(session, message.ChannelID, fmt.Sprintf(constants.MsgTeamDeleted, team.Name))
+	default:
+		errorHandlers.Validation().HandleInvalidParams("TEAM_INVALID_PARAMS",
+			fmt.Sprintf("Invalid team %s parameters", action),
+			constants.MsgTeamUsage)
+	}
+}
+
+// assignTeam 팀 존재를 확인한 뒤 참가자를 팀에 배정합니다 (정원은 저장소가 배정과 함께 확인)
+func (handler *CommandHandler) assignTeam(session *discordgo.Session, message *discordgo.MessageCreate, teamName, baekjoonID string) {
+	errorHandlers := utils.NewErrorHandlerFactory(session, message.ChannelID)
+
+	team, found := findTeam(handler.deps.Storage.GetTeams(), teamName)
+	if !found {
+		errorHandlers.Validation().HandleInvalidParams("TEAM_NOT_FOUND",
+			fmt.Sprintf("Team not found: %s", teamName),
+			fmt.Sprintf(constants.MsgTeamNotFound, teamName))
+		return
+	}
+
+	err := handler.deps.Storage.AssignTeam(team.Name, baekjoonID)
+	if stderrors.Is(err, interfaces.ErrTeamFull) {
+		errorHandlers.Validation().HandleInvalidParams("TEAM_FULL",
+			fmt.Sprintf("Team is full: %s", team.Name),
+			fmt.Sprintf(constants.MsgTeamFull, team.Name, constants.MaxTeamSize))
+		return
+	}
+	if err != nil {
+		errorHandlers.System().HandleSystemError("TEAM_ASSIGN_FAILED", "Failed to assign team", constants.MsgTeamUpdateFailed, err)
+		return
+	}
+	errors.SendDiscordSuccess(session, message.ChannelID, fmt.Sprintf(constants.MsgTeamJoined, baekjoonID, team.Name))
+}
+
+// leaveTeam 참가자를 소속 팀에서 뺍니다
+func (handler *CommandHandler) leaveTeam(session *discordgo.Session, message *discordgo.MessageCreate, baekjoonID string) {
+	errorHandlers := utils.NewErrorHandlerFactory(session, message.ChannelID)
+
+	team, found := models.TeamOf(handler.deps.Storage.GetTeams(), baekjoonID)
+	if !found {
+		errorHandlers.Validation().HandleInvalidParams("TEAM_NOT_MEMBER",
+			fmt.Sprintf("Participant has no team: %s", baekjoonID),
+			fmt.Sprintf(constants.MsgTeamNoTeam, baekjoonID))
+		return
+	}
+
+	if err := handler.deps.Storage.LeaveTeam(baekjoonID); err != nil {
+		errorHandlers.System().HandleSystemError("TEAM_LEAVE_FAILED", "Failed to leave team", constants.MsgTeamUpdateFailed, err)
+		return
+	}
+	errors.SendDiscordSuccess(session, message.ChannelID, fmt.Sprintf(constants.MsgTeamLeft, baekjoonID, team.Name))
+}
+
+// findTeam 이름으로 팀을 찾습니다 (대소문자 무시)
+func findTeam(teams []models.Team, name string) (models.Team, bool) {
+	for _, team := range teams {
+		if strings.EqualFold(team.Name, name) {
+			return team, true
+		}
+	}
+	return models.Team{}, false
+}
+
+// formatTeamScoreboard 팀 순위를 고정폭 표로 만듭니다 (팀원은 점수순으로 표시)
+func formatTeamScoreboard(teamScores []models.TeamScore) string {
+	var builder strings.Builder
+	builder.WriteString("```\n")
+	builder.WriteString(fmt.Sprintf("%-*s %-*s %*s\n",
+		constants.ScoreboardRankWidth, "순위",
+		constants.MaxTeamNameDisplayWidth, "팀",
+		constants.ScoreboardScoreWidth, "점수"))
+	builder.WriteString(constants.ScoreboardSeparator + "\n")
+
+	var lastScore float64 = -1.0
+	var rank int
+	for i, teamScore := range teamScores {
+		if teamScore.Score != lastScore {
+			rank = i + 1
+		}
+		lastScore = teamScore.Score
+
+		members := make([]string, 0, len(teamScore.Members))
+		for _, member := range teamScore.Members {
+			members = append(members, fmt.Sprintf("%s %.0f", member.BaekjoonID, member.Score))
+		}
+
+		builder.WriteString(fmt.Sprintf("%-*d  %-*s %*.1f\n",
+			constants.ScoreboardRankWidth, rank,
+			constants.MaxTeamNameDisplayWidth, teamScore.Name,
+			constants.ScoreboardScoreWidth, teamScore.Score))
+		builder.WriteString(fmt.Sprintf("      └ %s\n", strings.Join(members, ", ")))
+	}
+	builder.WriteString("```\n")
+	return builder.String()
+}
@@ -0,0 +1,84 @@
+package bot
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ssugameworks/kkemi/constants"
+	"github.com/ssugameworks/kkemi/interfaces"
+	"github.com/ssugameworks/kkemi/models"
+	"github.com/ssugameworks/kkemi/storage"
+)
+
+func TestFormatTeamScoreboard(t *testing.T) {
+	teamScores := []models.TeamScore{
+		{Name: "alpha", Score: 30, Members: []models.ScoreData{{BaekjoonID: "a1", Score: 20}, {BaekjoonID: "a2", Score: 10}}},
+		{Name: "bravo", Score: 30, Members: []models.ScoreData{{BaekjoonID: "b1", Score: 30}}},
+		{Name: "charlie", Score: 5, Members: []models.ScoreData{{BaekjoonID: "c1", Score: 5}}},
+	}
+
+	lines := strings.Split(formatTeamScoreboard(teamScores), "\n")
+
+	if !strings.HasPrefix(lines[3], "1") || !strings.Contains(lines[3], "alpha") {
+		t.Errorf("Expected alpha at rank 1, got %q", lines[3])
+	}
+	if !strings.Contains(lines[4], "a1 20, a2 10") {
+		t.Errorf("Expected members ordered by score, got %q", lines[4])
+	}
+	if !strings.HasPrefix(lines[5], "1") || !strings.Contains(lines[5], "bravo") {
+		t.Errorf("Expected bravo tied at rank 1, got %q", lines[5])
+	}
+	if !strings.HasPrefix(lines[7], "3") || !strings.Contains(lines[7], "charlie") {
+		t.Errorf("Expected charlie at rank 3, got %q", lines[7])
+	}
+}
+
+func TestFindTeam(t *testing.T) {
+	teams := []models.Team{{Name: "Alpha"}}
+	if team, ok := findTeam(teams, "alpha"); !ok || team.Name != "Alpha" {
+		t.Errorf("Expected case-insensitive team lookup, got %+v", team)
+	}
+}
+
+func TestAssignTeamEnforcesCapacityUnderConcurrency(t *testing.T) {
+	store := storage.NewInMemoryStorage(&MockSolvedACClient{})
+	start := time.Now().AddDate(0, 0, -1)
+	if _, err := store.CreateCompetition("test", start, start.AddDate(0, 0, 7)); err != nil {
+		t.Fatalf("Failed to create competition: %v", err)
+	}
+	if err := store.CreateTeam("alpha"); err != nil {
+		t.Fatalf("Failed to create team: %v", err)
+	}
+
+	// 정원보다 많은 참가자가 동시에 가입해도 정원을 넘지 않고 나머지는 ErrTeamFull을 받습니다
+	joins := constants.MaxTeamSize * 2
+	errs := make(chan error, joins)
+	var wg sync.WaitGroup
+	for i := 0; i < joins; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			errs <- store.AssignTeam("alpha", fmt.Sprintf("player%d", i))
+		}(i)
+	}
+	wg.Wait()
+	close(errs)
+
+	full := 0
+	for err := range errs {
+		if errors.Is(err, interfaces.ErrTeamFull) {
+			full++
+		} else if err != nil {
+			t.Errorf("Unexpected assign error: %v", err)
+		}
+	}
+	team, _ := findTeam(store.GetTeams(), "alpha")
+	if len(team.Members) != constants.MaxTeamSize || full != joins-constants.MaxTeamSize {
+		t.Errorf("Expected %d members and %d full errors, got %d members and %d full errors",
+			constants.MaxTeamSize, joins-constants.MaxTeamSize, len(team.Members), full)
+	}
+}
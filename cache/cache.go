@@ -26,12 +26,15 @@ type CacheStats struct {
 	UserTop100Count        int
 	UserAdditionalCount    int
 	UserOrganizationsCount int
+	UserSolvedCount        int
+	ProblemInfoCount       int
+	ClassProblemsCount     int
 }
 
 // ExpirationEntry 만료 시간 기반 우선순위 큐의 항목
 type ExpirationEntry struct {
 	Key       string
-	CacheType string // "userInfo", "userTop100", "userAdditional", "userOrganizations"
+	CacheType string // "userInfo", "userTop100", "userAdditional", "userOrganizations", "userSolved", "problemInfo", "classProblems"
 	ExpiresAt time.Time
 	Index     int // 힙에서의 인덱스
 }
@@ -74,6 +77,9 @@ type EfficientAPICache struct {
 	userTop100Cache        map[string]*CacheItem
 	userAdditionalCache    map[string]*CacheItem
 	userOrganizationsCache map[string]*CacheItem
+	userSolvedCache        map[string]*CacheItem
+	problemInfoCache       map[string]*CacheItem
+	classProblemsCache     map[string]*CacheItem
 
 	// 만료 시간 추적을 위한 우선순위 큐와 인덱스
 	expirationQueue *ExpirationQueue
@@ -86,6 +92,9 @@ type EfficientAPICache struct {
 	userTop100TTL        time.Duration
 	userAdditionalTTL    time.Duration
 	userOrganizationsTTL time.Duration
+	userSolvedTTL        time.Duration
+	problemInfoTTL       time.Duration
+	classProblemsTTL     time.Duration
 
 	// 효율적인 정리를 위한 설정
 	lastCleanup        time.Time
@@ -103,6 +112,9 @@ func NewEfficientAPICache() *EfficientAPICache {
 		userTop100Cache:        make(map[string]*CacheItem),
 		userAdditionalCache:    make(map[string]*CacheItem),
 		userOrganizationsCache: make(map[string]*CacheItem),
+		userSolvedCache:        make(map[string]*CacheItem),
+		problemInfoCache:       make(map[string]*CacheItem),
+		classProblemsCache:     make(map[string]*CacheItem),
 
 		expirationQueue: priorityQueue,
 		keyToEntry:      make(map[string]*ExpirationEntry),
@@ -112,6 +124,9 @@ func NewEfficientAPICache() *EfficientAPICache {
 		userTop100TTL:        constants.UserTop100CacheTTL,
 		userAdditionalTTL:    constants.UserAdditionalCacheTTL,
 		userOrganizationsTTL: constants.UserAdditionalCacheTTL,
+		userSolvedTTL:        constants.UserSolvedCacheTTL,
+		problemInfoTTL:       constants.ProblemInfoCacheTTL,
+		classProblemsTTL:     constants.ClassProblemsCacheTTL,
 
 		// 효율적인 정리 설정
 		cleanupBatchSize:   constants.CacheCleanupBatchSize,   // 한 번에 처리할 항목 수
@@ -147,6 +162,12 @@ func (cache *EfficientAPICache) setWithExpiration(cacheType, key string, data in
 		cache.userAdditionalCache[key] = item
 	case "userOrganizations":
 		cache.userOrganizationsCache[key] = item
+	case "userSolved":
+		cache.userSolvedCache[key] = item
+	case "problemInfo":
+		cache.problemInfoCache[key] = item
+	case "classProblems":
+		cache.classProblemsCache[key] = item
 	}
 
 	// 우선순위 큐에 추가
@@ -231,6 +252,60 @@ func (cache *EfficientAPICache) SetUserOrganizations(handle string, organization
 	cache.setWithExpiration("userOrganizations", handle, organizations, cache.userOrganizationsTTL)
 }
 
+// GetUserSolvedProblems 캐시에서 사용자의 전체 해결 문제 목록을 조회합니다
+func (cache *EfficientAPICache) GetUserSolvedProblems(handle string) (interface{}, bool) {
+	cache.mu.RLock()
+	defer cache.mu.RUnlock()
+
+	item, exists := cache.userSolvedCache[handle]
+	if !exists || item.IsExpired() {
+		return nil, false
+	}
+
+	return item.Data, true
+}
+
+// SetUserSolvedProblems 사용자의 전체 해결 문제 목록을 캐시에 저장합니다
+func (cache *EfficientAPICache) SetUserSolvedProblems(handle string, problems interface{}) {
+	cache.setWithExpiration("userSolved", handle, problems, cache.userSolvedTTL)
+}
+
+// GetProblemInfo 캐시에서 문제 정보를 조회합니다
+func (cache *EfficientAPICache) GetProblemInfo(problemID string) (interface{}, bool) {
+	cache.mu.RLock()
+	defer cache.mu.RUnlock()
+
+	item, exists := cache.problemInfoCache[problemID]
+	if !exists || item.IsExpired() {
+		return nil, false
+	}
+
+	return item.Data, true
+}
+
+// SetProblemInfo 문제 정보를 캐시에 저장합니다
+func (cache *EfficientAPICache) SetProblemInfo(problemID string, problem interface{}) {
+	cache.setWithExpiration("problemInfo", problemID, problem, cache.problemInfoTTL)
+}
+
+// GetClassProblems 캐시에서 클래스 소속 문제 목록을 조회합니다
+func (cache *EfficientAPICache) GetClassProblems(class string) (interface{}, bool) {
+	cache.mu.RLock()
+	defer cache.mu.RUnlock()
+
+	item, exists := cache.classProblemsCache[class]
+	if !exists || item.IsExpired() {
+		return nil, false
+	}
+
+	return item.Data, true
+}
+
+// SetClassProblems 클래스 소속 문제 목록을 캐시에 저장합니다
+func (cache *EfficientAPICache) SetClassProblems(class string, problemIDs interface{}) {
+	cache.setWithExpiration("classProblems", class, problemIDs, cache.classProblemsTTL)
+}
+
 // ClearExpiredEfficient 우선순위 큐를 사용하여 효율적으로 만료된 항목을 정리합니다
 func (cache *EfficientAPICache) ClearExpiredEfficient() int {
 	cache.mu.Lock()
@@ -277,6 +352,12 @@ func (cache *EfficientAPICache) ClearExpiredEfficient() int {
 			delete(cache.userAdditionalCache, entry.Key)
 		case "userOrganizations":
 			delete(cache.userOrganizationsCache, entry.Key)
+		case "userSolved":
+			delete(cache.userSolvedCache, entry.Key)
+		case "problemInfo":
+			delete(cache.problemInfoCache, entry.Key)
+		case "classProblems":
+			delete(cache.classProblemsCache, entry.Key)
 		}
 
 		cleaned++
@@ -296,6 +377,9 @@ func (cache *EfficientAPICache) GetStats() CacheStats {
 		UserTop100Count:        len(cache.userTop100Cache),
 		UserAdditionalCount:    len(cache.userAdditionalCache),
 		UserOrganizationsCount: len(cache.userOrganizationsCache),
+		UserSolvedCount:        len(cache.userSolvedCache),
+		ProblemInfoCount:       len(cache.problemInfoCache),
+		ClassProblemsCount:     len(cache.classProblemsCache),
 	}
 }
 
@@ -308,6 +392,9 @@ func (cache *EfficientAPICache) Clear() {
 	cache.userTop100Cache = make(map[string]*CacheItem)
 	cache.userAdditionalCache = make(map[string]*CacheItem)
 	cache.userOrganizationsCache = make(map[string]*CacheItem)
+	cache.userSolvedCache = make(map[string]*CacheItem)
+	cache.problemInfoCache = make(map[string]*CacheItem)
+	cache.classProblemsCache = make(map[string]*CacheItem)
 
 	// 우선순위 큐와 인덱스도 초기화
 	cache.expirationQueue = &ExpirationQueue{}
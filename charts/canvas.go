@@ -0,0 +1,55 @@
+package charts
+
+import (
+	"image"
+	"image/color"
+)
+
+// fillRect 이미지 범위 안쪽만 사각형으로 칠합니다
+func fillRect(img *image.RGBA, x, y, width, height int, c color.Color) {
+	rect := image.Rect(x, y, x+width, y+height).Intersect(img.Bounds())
+	for py := rect.Min.Y; py < rect.Max.Y; py++ {
+		for px := rect.Min.X; px < rect.Max.X; px++ {
+			img.Set(px, py, c)
+		}
+	}
+}
+
+// drawLine 두 점 사이를 brush×brush 크기의 붓으로 잇습니다 (Bresenham 알고리즘)
+func drawLine(img *image.RGBA, x0, y0, x1, y1, brush int, c color.Color) {
+	dx := abs(x1 - x0)
+	dy := -abs(y1 - y0)
+	sx, sy := 1, 1
+	if x0 > x1 {
+		sx = -1
+	}
+	if y0 > y1 {
+		sy = -1
+	}
+
+	offset := brush / 2
+	err := dx + dy
+	for {
+		fillRect(img, x0-offset, y0-offset, brush, brush, c)
+		if x0 == x1 && y0 == y1 {
+			return
+		}
+		doubled := 2 * err
+		if doubled >= dy {
+			err += dy
+			x0 += sx
+		}
+		if doubled <= dx {
+			err += dx
+			y0 += sy
+		}
+	}
+}
+
+// abs 정수의 절댓값을 반환합니다
+func abs(value int) int {
+	if value < 0 {
+		return -value
+	}
+	return value
+}
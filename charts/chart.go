@@ -0,0 +1,275 @@
+// Package charts 외부 서비스 없이 표준 라이브러리만으로 점수 추이 PNG 차트를 그립니다
+package charts
+
+import (
+	"bytes"
+	"errors"
+	"image"
+	"image/color"
+	"image/png"
+	"math"
+	"strconv"
+	"time"
+)
+
+// ErrNoData 그릴 데이터가 하나도 없을 때 반환됩니다
+var ErrNoData = errors.New("차트에 그릴 데이터가 없습니다")
+
+// PanelKind 차트 칸을 그리는 방식
+type PanelKind int
+
+const (
+	PanelLine PanelKind = iota // 시점별 값을 선으로 연결
+	PanelBar                   // 시점별 값을 막대로 표시 (첫 번째 계열만 사용)
+)
+
+// 차트 크기와 여백 (픽셀)
+const (
+	chartWidth   = 800
+	panelHeight  = 320
+	marginLeft   = 64
+	marginRight  = 176
+	marginTop    = 32
+	marginBottom = 36
+	textScale    = 2
+	lineBrush    = 2
+	markerSize   = 5
+	yTickCount   = 4
+	xTickCount   = 5
+	legendRow    = 20
+	legendSwatch = 12
+	legendChars  = 11
+)
+
+// 차트 색상
+var (
+	colorBackground = color.RGBA{R: 0xFF, G: 0xFF, B: 0xFF, A: 0xFF}
+	colorGrid       = color.RGBA{R: 0xE5, G: 0xE7, B: 0xEB, A: 0xFF}
+	colorAxis       = color.RGBA{R: 0x6B, G: 0x72, B: 0x80, A: 0xFF}
+	colorText       = color.RGBA{R: 0x1F, G: 0x29, B: 0x37, A: 0xFF}
+)
+
+// palette 계열별 색상 (리그 상위 10명까지 서로 다른 색)
+var palette = []color.RGBA{
+	{R: 0x1F, G: 0x77, B: 0xB4, A: 0xFF},
+	{R: 0xFF, G: 0x7F, B: 0x0E, A: 0xFF},
+	{R: 0x2C, G: 0xA0, B: 0x2C, A: 0xFF},
+	{R: 0xD6, G: 0x27, B: 0x28, A: 0xFF},
+	{R: 0x94, G: 0x67, B: 0xBD, A: 0xFF},
+	{R: 0x8C, G: 0x56, B: 0x4B, A: 0xFF},
+	{R: 0xE3, G: 0x77, B: 0xC2, A: 0xFF},
+	{R: 0x7F, G: 0x7F, B: 0x7F, A: 0xFF},
+	{R: 0xBC, G: 0xBD, B: 0x22, A: 0xFF},
+	{R: 0x17, G: 0xBE, B: 0xCF, A: 0xFF},
+}
+
+// Point 계열의 한 시점 값입니다 (축 날짜는 Time의 시간대로 표시)
+type Point struct {
+	Time  time.Time
+	Value float64
+}
+
+// Series 범례에 표시되는 하나의 선(또는 막대) 데이터입니다
+type Series struct {
+	Label  string
+	Points []Point
+}
+
+// Panel 세로로 쌓이는 차트 한 칸입니다 (제목은 ASCII만 그려짐)
+type Panel struct {
+	Title  string
+	Kind   PanelKind
+	Series []Series
+}
+
+// Render 차트 칸들을 위에서부터 차례로 그린 PNG 이미지를 반환합니다
+func Render(panels []Panel) ([]byte, error) {
+	if !hasData(panels) {
+		return nil, ErrNoData
+	}
+
+	img := image.NewRGBA(image.Rect(0, 0, chartWidth, panelHeight*len(panels)))
+	fillRect(img, 0, 0, chartWidth, panelHeight*len(panels), colorBackground)
+	for i, panel := range panels {
+		drawPanel(img, panel, panelHeight*i)
+	}
+
+	var buffer bytes.Buffer
+	if err := png.Encode(&buffer, img); err != nil {
+		return nil, err
+	}
+	return buffer.Bytes(), nil
+}
+
+// hasData 점이 하나라도 있는 칸이 있는지 확인합니다
+func hasData(panels []Panel) bool {
+	for _, panel := range panels {
+		for _, series := range panel.Series {
+			if len(series.Points) > 0 {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// drawPanel top 위치에 차트 한 칸(제목, 격자, 축 라벨, 데이터, 범례)을 그립니다
+func drawPanel(img *image.RGBA, panel Panel, top int) {
+	left, right := marginLeft, chartWidth-marginRight
+	plotTop, plotBottom := top+marginTop, top+panelHeight-marginBottom
+
+	drawText(img, left, top+(marginTop-glyphHeight*textScale)/2, panel.Title, textScale, colorText)
+
+	series := panel.Series
+	if panel.Kind == PanelBar && len(series) > 1 {
+		series = series[:1]
+	}
+
+	minTime, maxTime := timeRange(series)
+	if panel.Kind == PanelBar {
+		// 양 끝 막대가 축에 걸치지 않도록 반나절씩 여유를 둡니다
+		minTime = minTime.Add(-12 * time.Hour)
+		maxTime = maxTime.Add(12 * time.Hour)
+	}
+	step, maxValue := niceScale(maxSeriesValue(series), yTickCount)
+
+	xOf := func(t time.Time) int {
+		ratio := float64(t.Sub(minTime)) / float64(maxTime.Sub(minTime))
+		return left + int(math.Round(ratio*float64(right-left)))
+	}
+	yOf := func(value float64) int {
+		return plotBottom - int(math.Round(value/maxValue*float64(plotBottom-plotTop)))
+	}
+
+	// 가로 격자와 세로축 라벨
+	for tick := 0; tick <= yTickCount; tick++ {
+		value := step * float64(tick)
+		y := yOf(value)
+		drawLine(img, left, y, right, y, 1, colorGrid)
+		label := formatValue(value)
+		drawText(img, left-8-textWidth(label, textScale), y-glyphHeight*textScale/2, label, textScale, colorAxis)
+	}
+
+	// 가로축과 날짜 라벨
+	drawLine(img, left, plotBottom, right, plotBottom, 1, colorAxis)
+	drawLine(img, left, plotTop, left, plotBottom, 1, colorAxis)
+	layout := timeLayout(minTime, maxTime)
+	for tick := 0; tick < xTickCount; tick++ {
+		at := minTime.Add(maxTime.Sub(minTime) * time.Duration(tick) / time.Duration(xTickCount-1))
+		x := xOf(at)
+		drawLine(img, x, plotBottom, x, plotBottom+4, 1, colorAxis)
+		label := at.Format(layout)
+		drawText(img, x-textWidth(label, textScale)/2, plotBottom+10, label, textScale, colorAxis)
+	}
+
+	for i, s := range series {
+		c := palette[i%len(palette)]
+		if panel.Kind == PanelBar {
+			drawBars(img, s.Points, xOf, yOf, plotBottom, right-left, c)
+		} else {
+			drawSeriesLine(img, s.Points, xOf, yOf, c)
+		}
+
+		// 범례
+		legendTop := plotTop + i*legendRow
+		if legendTop+legendSwatch > plotBottom {
+			continue
+		}
+		fillRect(img, right+16, legendTop, legendSwatch, legendSwatch, c)
+		label := s.Label
+		if runes := []rune(label); len(runes) > legendChars {
+			label = string(runes[:legendChars])
+		}
+		drawText(img, right+16+legendSwatch+6, legendTop-1, label, textScale, colorText)
+	}
+}
+
+// drawSeriesLine 시간순 점들을 선으로 잇고 각 점에 표식을 찍습니다
+func drawSeriesLine(img *image.RGBA, points []Point, xOf func(time.Time) int, yOf func(float64) int, c color.Color) {
+	for i, point := range points {
+		x, y := xOf(point.Time), yOf(point.Value)
+		if i > 0 {
+			drawLine(img, xOf(points[i-1].Time), yOf(points[i-1].Value), x, y, lineBrush, c)
+		}
+		fillRect(img, x-markerSize/2, y-markerSize/2, markerSize, markerSize, c)
+	}
+}
+
+// drawBars 점마다 가로축에서 값까지 막대를 그립니다
+func drawBars(img *image.RGBA, points []Point, xOf func(time.Time) int, yOf func(float64) int, baseline, plotWidth int, c color.Color) {
+	width := plotWidth / (len(points)*2 + 1)
+	if width < 2 {
+		width = 2
+	}
+	for _, point := range points {
+		y := yOf(point.Value)
+		fillRect(img, xOf(point.Time)-width/2, y, width, baseline-y, c)
+	}
+}
+
+// timeRange 모든 계열의 가장 이른 시각과 늦은 시각을 반환합니다 (한 시점뿐이면 앞뒤로 한 시간씩 넓힘)
+func timeRange(series []Series) (time.Time, time.Time) {
+	var minTime, maxTime time.Time
+	for _, s := range series {
+		for _, point := range s.Points {
+			if minTime.IsZero() || point.Time.Before(minTime) {
+				minTime = point.Time
+			}
+			if maxTime.IsZero() || point.Time.After(maxTime) {
+				maxTime = point.Time
+			}
+		}
+	}
+	if !maxTime.After(minTime) {
+		minTime = minTime.Add(-time.Hour)
+		maxTime = maxTime.Add(time.Hour)
+	}
+	return minTime, maxTime
+}
+
+// timeLayout 기간이 이틀보다 짧으면 시각, 아니면 날짜를 축 라벨로 씁니다
+func timeLayout(minTime, maxTime time.Time) string {
+	if maxTime.Sub(minTime) < 48*time.Hour {
+		return "15:04"
+	}
+	return "01-02"
+}
+
+// maxSeriesValue 모든 계열의 최댓값을 반환합니다 (음수는 0으로 취급)
+func maxSeriesValue(series []Series) float64 {
+	var maxValue float64
+	for _, s := range series {
+		for _, point := range s.Points {
+			maxValue = math.Max(maxValue, point.Value)
+		}
+	}
+	return maxValue
+}
+
+// niceScale 최댓값을 ticks칸으로 나누는 1·2·5×10^n 단위 눈금 간격과 축 최댓값을 반환합니다
+func niceScale(maxValue float64, ticks int) (step, top float64) {
+	if maxValue <= 0 {
+		return 1, float64(ticks)
+	}
+	raw := maxValue / float64(ticks)
+	magnitude := math.Pow(10, math.Floor(math.Log10(raw)))
+	step = 10 * magnitude
+	for _, factor := range []float64{1, 2, 5} {
+		if raw <= factor*magnitude {
+			step = factor * magnitude
+			break
+		}
+	}
+	if step < 1 {
+		step = 1
+	}
+	return step, step * float64(ticks)
+}
+
+// formatValue 축 라벨용으로 값을 줄여 씁니다 (1000 이상은 K 단위)
+func formatValue(value float64) string {
+	if value >= 1000 {
+		return strconv.FormatFloat(value/1000, 'f', -1, 64) + "K"
+	}
+	return strconv.Itoa(int(math.Round(value)))
+}
@@ -0,0 +1,82 @@
+package charts
+
+import (
+	"bytes"
+	"image/png"
+	"testing"
+	"time"
+)
+
+func TestRender(t *testing.T) {
+	start := time.Date(2025, 3, 1, 9, 0, 0, 0, time.UTC)
+	panels := []Panel{
+		{Title: "#1", Kind: PanelLine, Series: []Series{
+			{Label: "alice", Points: []Point{{Time: start, Value: 10}, {Time: start.AddDate(0, 0, 1), Value: 40}}},
+			{Label: "bob_the_very_long_handle", Points: []Point{{Time: start.AddDate(0, 0, 1), Value: 25}}},
+		}},
+		{Title: "SOLVED", Kind: PanelBar, Series: []Series{
+			{Label: "alice", Points: []Point{{Time: start, Value: 2}, {Time: start.AddDate(0, 0, 1), Value: 3}}},
+		}},
+	}
+
+	data, err := Render(panels)
+	if err != nil {
+		t.Fatalf("Render failed: %v", err)
+	}
+	img, err := png.Decode(bytes.NewReader(data))
+	if err != nil {
+		t.Fatalf("Expected a valid PNG: %v", err)
+	}
+	if bounds := img.Bounds(); bounds.Dx() != chartWidth || bounds.Dy() != panelHeight*2 {
+		t.Errorf("Unexpected image size %v", bounds)
+	}
+}
+
+func TestRender_NoData(t *testing.T) {
+	if _, err := Render([]Panel{{Title: "EMPTY", Series: []Series{{Label: "alice"}}}}); err != ErrNoData {
+		t.Errorf("Expected ErrNoData, got %v", err)
+	}
+	if _, err := Render(nil); err != ErrNoData {
+		t.Errorf("Expected ErrNoData for no panels, got %v", err)
+	}
+}
+
+func TestNiceScale(t *testing.T) {
+	tests := []struct {
+		maxValue float64
+		step     float64
+		top      float64
+	}{
+		{0, 1, 4},
+		{3, 1, 4},
+		{37, 10, 40},
+		{130, 50, 200},
+		{1999, 500, 2000},
+	}
+	for _, tt := range tests {
+		step, top := niceScale(tt.maxValue, 4)
+		if step != tt.step || top != tt.top {
+			t.Errorf("niceScale(%v) = (%v, %v), want (%v, %v)", tt.maxValue, step, top, tt.step, tt.top)
+		}
+	}
+}
+
+func TestFormatValue(t *testing.T) {
+	tests := map[float64]string{0: "0", 250: "250", 1000: "1K", 2500: "2.5K"}
+	for value, want := range tests {
+		if got := formatValue(value); got != want {
+			t.Errorf("formatValue(%v) = %q, want %q", value, got, want)
+		}
+	}
+}
+
+func TestGlyphsCoverHandles(t *testing.T) {
+	for _, r := range "ABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789_-.:" {
+		if _, ok := glyphs[r]; !ok {
+			t.Errorf("Missing glyph for %q", r)
+		}
+	}
+	if width := textWidth("01-02", 2); width != (5*6-1)*2 {
+		t.Errorf("Unexpected text width %d", width)
+	}
+}
@@ -15,11 +15,14 @@ type Config struct {
 	Logging   LoggingConfig
 	Features  FeatureFlags
 	Telemetry TelemetryConfig
+	Scoring   ScoringConfig
 }
 
 type DiscordConfig struct {
-	Token     string
-	ChannelID string
+	Token          string
+	ChannelID      string
+	GuildID        string // 슬래시 명령어를 등록하고 DM 관리 명령어 권한을 확인할 서버 (비어 있으면 전역 등록, DM 관리 명령어 불가)
+	AuditChannelID string // 관리 작업 감사 로그를 함께 보낼 채널 (비어 있으면 저장소에만 기록)
 }
 
 type ScheduleConfig struct {
@@ -43,12 +46,18 @@ type TelemetryConfig struct {
 	ProjectID string
 }
 
+type ScoringConfig struct {
+	RulesFile string // 대회에 규칙이 없을 때 사용할 규칙 파일 경로 (.json, 또는 .yaml/.yml이면 YAML)
+}
+
 // Load 환경변수에서 설정을 로드합니다
 func Load() *Config {
 	return &Config{
 		Discord: DiscordConfig{
-			Token:     getEnv(constants.EnvDiscordToken, ""),
-			ChannelID: getEnv(constants.EnvChannelID, ""),
+			Token:          getEnv(constants.EnvDiscordToken, ""),
+			ChannelID:      getEnv(constants.EnvChannelID, ""),
+			GuildID:        getEnv(constants.EnvGuildID, ""),
+			AuditChannelID: getEnv(constants.EnvAuditChannel, ""),
 		},
 		Schedule: ScheduleConfig{
 			ScoreboardHour:   getEnvInt("SCOREBOARD_HOUR", constants.DailyScoreboardHour),
@@ -67,6 +76,9 @@ func Load() *Config {
 			Enabled:   getEnvBool("TELEMETRY_ENABLED", false),
 			ProjectID: getEnv("GOOGLE_CLOUD_PROJECT", ""),
 		},
+		Scoring: ScoringConfig{
+			RulesFile: getEnv(constants.EnvScoringRules, ""),
+		},
 	}
 }
 
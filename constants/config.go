@@ -8,6 +8,9 @@ const (
 	UserInfoCacheTTL       = 5 * time.Minute  // 사용자 정보 캐시 만료 시간
 	UserTop100CacheTTL     = 10 * time.Minute // TOP 100 캐시 만료 시간
 	UserAdditionalCacheTTL = 30 * time.Minute // 추가 정보 캐시 만료 시간
+	UserSolvedCacheTTL     = 10 * time.Minute // 전체 해결 문제 목록 캐시 만료 시간
+	ProblemInfoCacheTTL    = 24 * time.Hour   // 문제 정보(태그) 캐시 만료 시간
+	ClassProblemsCacheTTL  = 24 * time.Hour   // 클래스 소속 문제 목록 캐시 만료 시간
 	CacheCleanupInterval   = 5 * time.Minute  // 캐시 정리 간격
 
 	// Discord API 재시도 설정
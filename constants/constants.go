@@ -13,11 +13,20 @@ const (
 	RetryDelay            = 1 * time.Second
 	APIRetryMultiplier    = 2
 	MaxConcurrentRequests = 5
+
+	// /search/problem 페이지네이션
+	SolvedProblemsPageSize = 50  // solved.ac 검색 API 페이지당 문제 수
+	MaxSolvedProblemPages  = 400 // 한 사용자당 최대 조회 페이지 수 (20,000문제)
+
+	// solved.ac 클래스
+	ClassSearchQueryFormat = "in_class:%d" // 클래스 소속 문제 검색 쿼리
+	MinSolvedACClass       = 1
+	MaxSolvedACClass       = 10
 )
 
-// 조직 ID 관련 상수
+// 참가 자격 관련 상수
 const (
-	UniversityID = 323 // 숭실대학교 organizationId
+	DefaultOrganizationID = 323 // 참가 자격을 지정하지 않은 대회의 기본 허용 소속 (숭실대학교 organizationId)
 )
 
 // 잔디심기 챌린지 리그 분류 상수
@@ -27,6 +36,12 @@ const (
 	LeagueMaster = 2 // 마스터: Gold IV ~ (티어 12 이상)
 )
 
+// 잔디(연속 해결) 관련 상수
+const (
+	MinStreakBonusDays    = 2 // 연속 해결 보너스 단계의 최소 일수
+	MinReportedBrokenDays = 2 // 일일 공지에 끊긴 기록으로 알릴 최소 연속 일수
+)
+
 // 각 리그별 가중치 (상위/동일/하위 티어)
 const (
 	// 루키 리그 가중치
@@ -51,8 +66,43 @@ const (
 	DailyScoreboardHour   = 9
 	DailyScoreboardMinute = 0
 	SchedulerInterval     = 24 * time.Hour
+	SolveTrackerInterval  = 10 * time.Minute // 해결 기록 수집 간격
+
+	CompetitionScheduleCheckInterval = time.Minute      // 대회별 스코어보드 발송 시각 확인 간격
+	AutoFinalizeDelay                = 24 * time.Hour   // 점수 인정 구간이 끝난 뒤 결과를 자동 확정하기까지의 유예 시간
+	AutoFinalizeRetryDelay           = 10 * time.Minute // 자동 확정 실패 후 첫 재시도까지의 대기 시간 (실패할 때마다 두 배)
+	MaxAutoFinalizeAttempts          = 5                // 이 횟수째 시도부터는 조회에 실패한 참가자를 마지막 기록으로 채워 확정
+	ScoreboardViewTTL                = time.Hour        // 스코어보드 페이지 버튼이 동작하는 시간
 )
 
+// 시즌 관련 상수
+const (
+	SeasonParticipationPoints = 1.0 // 순위 포인트 표 밖의 참가자가 받는 포인트
+	MaxSeasonStandingLines    = 30  // 시즌 순위표에 표시할 최대 참가자 수
+)
+
+// 등록 본인 확인 관련 상수
+const (
+	RegistrationTokenPrefix = "kkemi-"         // solved.ac 자기소개에 넣을 토큰 접두사
+	RegistrationTokenBytes  = 6                // 토큰 무작위 부분의 바이트 수 (16진수 12자리)
+	RegistrationTokenTTL    = 30 * time.Minute // 토큰 유효 시간
+
+	HandleChangeMaxUnrecordedSolves = 10 // 백준 ID 변경 시 기존 기록을 이어받을 수 있는 스냅샷·해결 기록 밖의 최대 해결 문제 수
+)
+
+// 참가 명단 관련 상수
+const (
+	RosterWriteBatchSize   = 400              // 명단 저장 트랜잭션 하나에 담을 최대 항목 수 (Firestore 쓰기 500개 제한)
+	MaxRosterCSVBytes      = 1 << 20          // 가져올 CSV 첨부 파일 최대 크기
+	RosterDownloadTimeout  = 10 * time.Second // CSV 첨부 파일 다운로드 제한 시간
+	MaxRosterListNames     = 100              // 명단 조회 시 표시할 최대 이름 수
+	MaxRosterDiffNames     = 20               // 명단 변경 보고에 표시할 최대 이름 수
+	MaxFinalizeFailedNames = 20               // 결과 확정 알림에 표시할 조회 실패 참가자 최대 수
+)
+
+// SeasonPlacementPoints 리그 순위별 시즌 포인트 (1위부터)
+var SeasonPlacementPoints = []float64{25, 18, 15, 12, 10, 8, 6, 4, 3, 2}
+
 // Discord 관련 상수
 const (
 	CommandPrefix = "!"
@@ -77,8 +127,16 @@ const (
 
 // 날짜 형식
 const (
-	DateFormat     = "2006-01-02"
-	DateTimeFormat = "2006-01-02 15:04:05"
+	DateFormat      = "2006-01-02"
+	DateTimeFormat  = "2006-01-02 15:04:05"
+	TrendDateFormat = "01-02 15:04" // 점수 추이 표의 시각 표시
+)
+
+// 차트 이미지 (이미지 글꼴은 ASCII만 지원)
+const (
+	ChartFileName    = "chart.png"
+	ChartScoreTitle  = "SCORE"
+	ChartSolvedTitle = "SOLVED / DAY"
 )
 
 // 로그 관련 상수
@@ -91,11 +149,35 @@ const (
 
 // 문자열 크기 제한
 const (
-	TruncateIndicator    = "..."
-	ScoreboardRankWidth  = 4
-	ScoreboardNameWidth  = 15
-	ScoreboardScoreWidth = 6
-	ScoreboardSeparator  = "──────────────────────────────"
+	TruncateIndicator         = "..."
+	ScoreboardRankWidth       = 4
+	ScoreboardNameWidth       = 15
+	ScoreboardScoreWidth      = 6
+	ScoreboardSeparator       = "──────────────────────────────"
+	MaxRulesJSONLength        = 3000 // 규칙 JSON 표시 최대 길이 (임베드 설명 4096자 제한)
+	MaxModeDiffLines          = 25   // 점수 방식 변경 시 표시할 최대 참가자 수
+	BreakdownPageSize         = 15   // 점수 내역 한 페이지에 표시할 문제 수
+	MaxStreakboardLines       = 20   // 잔디 리더보드에 표시할 최대 참가자 수
+	MaxMostImprovedPerLeague  = 3    // 리그별 성장왕으로 표시할 최대 참가자 수
+	MatrixNameWidth           = 12   // 문제 해결 표의 아이디 열 너비
+	MaxMatrixColumns          = 26   // 문제 해결 표에 표시할 최대 문제 수
+	MaxMatrixRows             = 40   // 문제 해결 표에 표시할 최대 참가자 수
+	MaxProblemSetSize         = 50   // 문제 목록 대회의 최대 문제 수
+	DefaultProblemPoints      = 1.0  // 배점을 생략한 문제의 기본 점수
+	MaxTeamSize               = 5    // 팀당 최대 팀원 수
+	MaxArchiveListSize        = 15   // 지난 대회 목록에 표시할 최대 대회 수
+	MaxTrendPoints            = 14   // 점수 추이에 표시할 최대 스냅샷 수
+	MaxChartSeries            = 10   // 점수 추이 차트에 리그별로 그릴 최대 참가자 수
+	MinChartPoints            = 2    // 스코어보드에 차트를 첨부하기 위한 최소 시점 수
+	MaxAutocompleteChoices    = 25   // 슬래시 명령어 자동 완성 후보 최대 개수 (디스코드 제한)
+	ScoreboardPageSize        = 20   // 스코어보드 한 페이지에 표시할 참가자 수
+	MaxEmbedDescriptionLength = 4096 // embed 설명 최대 길이 (디스코드 제한)
+	MaxMessageEmbedLength     = 6000 // 메시지 하나에 담긴 embed 글자 수 합계 최대 (디스코드 제한)
+	MaxEmbedsPerMessage       = 10   // 메시지 하나에 담을 수 있는 embed 최대 개수 (디스코드 제한)
+	DefaultAuditLogSize       = 10   // 감사 로그 조회 시 기본으로 보여줄 기록 수
+	MaxAuditLogSize           = 50   // 감사 로그 한 번에 조회할 수 있는 최대 기록 수
+	MaxAuditValueLength       = 200  // 감사 로그에 남길 변경 전/후 값의 최대 길이
+	GuildMembersPageSize      = 1000 // 서버 멤버 목록 조회 한 번에 가져올 최대 인원 (디스코드 제한)
 )
 
 // 메시지 템플릿
@@ -112,9 +194,12 @@ const (
 const (
 	EnvDiscordToken = "DISCORD_BOT_TOKEN"
 	EnvChannelID    = "DISCORD_CHANNEL_ID"
+	EnvGuildID      = "DISCORD_GUILD_ID"
+	EnvAuditChannel = "AUDIT_LOG_CHANNEL_ID"
 	EnvLogLevel     = "LOG_LEVEL"
 	EnvDebugMode    = "DEBUG_MODE"
 	EnvJSONLogging  = "JSON_LOGGING"
+	EnvScoringRules = "SCORING_RULES_FILE"
 )
 
 // 텔레메트리 관련 상수
@@ -135,6 +220,7 @@ const (
 const (
 	ParticipantSheetRange = "A:Z"           // 전체 시트 범위 (기본 시트)
 	ParticipantNameColumn = "이름\n(ex. 홍길동)" // 실제 스프레드시트 헤더와 정확히 일치
+	SeasonSheetName       = "시즌"            // 시즌 순위를 기록하는 시트 탭 이름
 )
 
 // GetParticipantSpreadsheetID 참가자 명단 스프레드시트 ID를 환경변수에서 가져옵니다
@@ -157,14 +243,8 @@ func GetScoreboardSpreadsheetID() string {
 	return id
 }
 
-// 환경 변수 키 (백업 명단용)
-const (
-	EnvBackupParticipantList = "BACKUP_PARTICIPANT_LIST"
-)
-
 // 에러 메시지 상수
 const (
 	// 참가자 명단 검증 관련 에러 메시지
-	ErrorSheetsCheckFailed = "게임웍스 부원 목록을 불러올 수 없습니다. 잠시 후 다시 시도해주세요."
-	ErrorNameNotInList     = "%s님은 게임웍스 부원이 아닙니다.\n만약 부원인 경우에도 이 메시지가 계속 뜬다면 운영진에게 문의해주세요."
+	ErrorRosterCheckFailed = "참가 명단을 불러올 수 없습니다. 잠시 후 다시 시도해주세요."
 )
@@ -3,12 +3,43 @@ package constants
 // 사용자 인터페이스 메시지
 const (
 	// 등록 관련
-	MsgRegisterSuccess            = "%s%s(%s)%s님이 %s 리그에 성공적으로 등록되었습니다!"
-	MsgRegisterUsage              = "사용법: `!등록 <이름> <백준ID>`"
-	MsgRegisterNotStarted         = "이벤트가 아직 시작되지 않았습니다. 등록은 %s부터 가능합니다."
-	MsgRegisterNoSolvedacName     = "solved.ac에 이름이 등록되지 않았습니다. solved.ac 프로필에서 이름을 등록한 후 다시 시도해주세요."
-	MsgRegisterNameMismatch       = "입력한 이름 '%s'이(가) solved.ac에 등록된 이름 '%s'와(과) 일치하지 않습니다."
-	MsgRegisterNotSoongsilStudent = "이 이벤트는 숭실대학교에 재학 중인 게임웍스 부원만 참여할 수 있습니다.\nBOJ에서 숭실대학교 학교 인증을 진행해주세요."
+	MsgRegisterSuccess         = "%s%s(%s)%s님이 %s 리그에 성공적으로 등록되었습니다!"
+	MsgRegisterUsage           = "사용법: `!등록 <이름> <백준ID>`"
+	MsgRegisterNotStarted      = "이벤트가 아직 시작되지 않았습니다. 등록은 %s부터 가능합니다."
+	MsgRegisterNoSolvedacName  = "solved.ac에 이름이 등록되지 않았습니다. solved.ac 프로필에서 이름을 등록한 후 다시 시도해주세요."
+	MsgRegisterNameMismatch    = "입력한 이름 '%s'이(가) solved.ac에 등록된 이름 '%s'와(과) 일치하지 않습니다."
+	MsgRegisterChallengeIssued = "**본인 확인이 필요합니다**\n1. solved.ac 프로필 편집에서 **%s** 계정의 자기소개에 아래 토큰을 넣고 저장해주세요.\n```\n%s\n```\n2. 이 채널에서 `!인증`을 입력하면 등록이 완료됩니다.\n⏰ 토큰은 %s까지 유효합니다."
+	MsgRegisterChallengeFailed = "본인 확인 토큰을 처리하는 중 오류가 발생했습니다."
+	MsgRegisterSnapshotFailed  = "solved.ac에서 **%s**의 등록 시점 해결 문제를 불러오지 못해 등록하지 않았습니다. 잠시 후 `!인증`을 다시 입력해주세요."
+	MsgVerifyNoChallenge       = "진행 중인 등록 신청이 없습니다. 먼저 `!등록 <이름> <백준ID>`로 신청해주세요."
+	MsgVerifyExpired           = "본인 확인 토큰이 만료되었습니다. `!등록 <이름> <백준ID>`로 다시 신청해주세요."
+	MsgVerifyTokenMissing      = "solved.ac **%s** 계정의 자기소개에서 토큰 `%s`을(를) 찾지 못했습니다. 자기소개를 저장했는지 확인한 뒤 다시 `!인증`을 입력해주세요. (%s까지 유효)"
+	MsgVerifyCleanup           = "본인 확인이 끝났습니다. 이제 solved.ac 자기소개에서 토큰을 지워도 됩니다."
+	MsgVerifyLinked            = "**%s**(%s) 참가자가 이 디스코드 계정과 연결되었습니다."
+
+	// 참가 자격 관련
+	MsgRegisterNotEligible          = "**%s**의 참가 자격을 충족하지 않습니다.\n%s"
+	MsgEligibilityOrganization      = "• solved.ac에서 %s 중 한 곳에 소속되어야 합니다. BOJ에서 학교/단체 인증을 진행해주세요."
+	MsgEligibilityMinTier           = "• 티어가 %s 이상이어야 합니다. (현재 %s)"
+	MsgEligibilityMaxTier           = "• 티어가 %s 이하여야 합니다. (현재 %s)"
+	MsgEligibilityClass             = "• solved.ac 클래스 %d 이상이어야 합니다. (현재 클래스 %d)"
+	MsgEligibilitySolvedCount       = "• 해결한 문제가 %d개 이상이어야 합니다. (현재 %d개)"
+	MsgEligibilityAccountAge        = "• solved.ac 가입 후 %d일이 지나야 합니다. (현재 %d일)"
+	MsgEligibilityAccountAgeUnknown = "• solved.ac 가입 후 %d일이 지나야 하지만 가입일을 확인할 수 없습니다. 운영진에게 문의해주세요."
+	MsgEligibilityRoster            = "• 참가 명단에 '%s' 이름이 없습니다. 명단에 있는데도 이 메시지가 보이면 운영진에게 문의해주세요."
+
+	// 탈퇴·백준 ID 변경 관련
+	MsgWithdrawConfirm             = "⚠️ **%s**에서 **%s**(으)로 등록된 참가 기록을 삭제합니다. 점수와 해결 기록, 팀 소속이 모두 사라지며 되돌릴 수 없습니다.\n정말 탈퇴하려면 `!탈퇴 확인`을 입력해주세요."
+	MsgWithdrawn                   = "**탈퇴 완료**\n🏁 대회: %s\n🎯 백준ID: %s"
+	MsgWithdrawFinalized           = "**%s**는 이미 결과가 확정되어 탈퇴할 수 없습니다."
+	MsgWithdrawFailed              = "탈퇴를 처리하는 중 오류가 발생했습니다."
+	MsgHandleChangeUsage           = "사용법: `!핸들변경 <새 백준ID>`"
+	MsgHandleChangeSame            = "이미 **%s**(으)로 등록되어 있습니다."
+	MsgHandleChangeChallengeIssued = "**본인 확인이 필요합니다**\n1. solved.ac 프로필 편집에서 새 계정 **%s**의 자기소개에 아래 토큰을 넣고 저장해주세요.\n```\n%s\n```\n2. 이 채널에서 `!인증`을 입력하면 백준 ID 변경이 완료됩니다.\n⏰ 토큰은 %s까지 유효합니다."
+	MsgHandleChanged               = "**백준 ID 변경 완료**\n🎯 %s → %s\n📌 등록 시점 기록: %s"
+	MsgHandleChangeFailed          = "백준 ID를 변경하는 중 오류가 발생했습니다."
+	MsgHandleChangeSnapshotFailed  = "solved.ac에서 **%s**의 해결 문제를 불러오지 못해 백준 ID를 바꾸지 않았습니다. 잠시 후 `!인증`을 다시 입력해주세요."
+	MsgHandleChangeCheckFailed     = "기존 백준 ID를 solved.ac에서 확인하지 못했습니다. 잠시 후 `!인증`을 다시 입력해주세요."
 
 	// 스코어보드 관련
 	MsgScoreboardTitle           = "🏆 %s 스코어보드"
@@ -18,6 +49,180 @@ const (
 	MsgScoreboardNoParticipants  = "참가자가 없습니다."
 	MsgScoreboardNoScores        = "아직 점수가 계산된 참가자가 없습니다."
 	MsgScoreboardBlackoutWarning = "⚠️ %d일 후 스코어보드가 비공개됩니다."
+	MsgScoreboardModeFooter      = "📐 점수 방식: %s"
+	MsgScoreboardMostImproved    = "📈 성장왕:"
+	MsgScoreboardTeamHeader      = "\n**👥 팀 순위** (%s)\n"
+	MsgScoreboardAsOfTitle       = "🕰️ %s 스코어보드 (%s 기준)"
+	MsgScoreboardAsOfFooter      = "저장된 스냅샷으로 다시 만든 스코어보드입니다 · 순위 변화는 직전 스냅샷 대비"
+	MsgScoreboardAsOfNotFound    = "%s 이전에 저장된 점수 스냅샷이 없습니다."
+	MsgScoreboardUsage           = "사용법: `!스코어보드 [YYYY-MM-DD]` (날짜를 주면 그날까지의 마지막 스냅샷 기준)"
+	MsgRankChangeUp              = " ▲%d"
+	MsgRankChangeDown            = " ▼%d"
+	MsgRankChangeSame            = " -"
+	MsgRankChangeNew             = " NEW"
+
+	// 페이지 스코어보드 관련 메시지
+	MsgScoreboardLeagueHeader      = "\n**🏆 %s 리그** (%d명)\n"
+	MsgScoreboardLeagueSection     = "%s 리그"
+	MsgScoreboardTeamSection       = "👥 팀 순위"
+	MsgScoreboardPageFooter        = "%s · %d/%d 페이지 · %s"
+	MsgScoreboardSelectPlaceholder = "리그 선택"
+	MsgScoreboardPrevButton        = "◀ 이전"
+	MsgScoreboardNextButton        = "다음 ▶"
+	MsgScoreboardFindMeButton      = "🙋 내 순위"
+	MsgScoreboardHighlight         = " ◀"
+	MsgScoreboardViewExpired       = "⌛ 이 스코어보드는 만료되었습니다. `!스코어보드`로 다시 불러와 주세요."
+	MsgScoreboardViewNotFound      = "이 스코어보드에서 내 순위를 찾지 못했습니다. 디스코드 이름이 백준 ID나 등록 이름과 같아야 합니다."
+
+	// 점수 추이 관련 메시지
+	MsgTrendUsage          = "사용법: `!추이 [백준ID]` (백준ID를 생략하면 디스코드 이름으로 참가자를 찾습니다)"
+	MsgTrendTitle          = "📈 %s 점수 추이"
+	MsgTrendEmpty          = "아직 저장된 점수 기록이 없습니다. 일일 스코어보드가 발송될 때마다 기록됩니다."
+	MsgTrendSummary        = "📸 기록 %d개 · 표시 구간 점수 변화 %+.0f점"
+	MsgTrendBlackoutFooter = "🔒 블랙아웃 기간에는 블랙아웃 시작 전 기록까지만 표시됩니다"
+
+	// 슬래시 명령어 관련 메시지
+	MsgSlashAccepted = "⏳ `/%s` 명령을 처리하고 있습니다. 결과는 채널에 표시됩니다."
+
+	// 점수 추이 차트 관련 메시지
+	MsgChartUsage                  = "사용법: `!그래프 [백준ID]` (백준ID를 생략하면 리그별 상위 참가자 차트)"
+	MsgChartLeagueTitle            = "📊 %s 리그별 점수 추이"
+	MsgChartLeagueDescription      = "리그별 상위 %d명의 스냅샷별 점수입니다."
+	MsgChartLeagueLegend           = "`#%d` %s"
+	MsgChartParticipantTitle       = "📊 %s 점수 그래프"
+	MsgChartParticipantDescription = "위: 날짜별 누적 점수 · 아래: 하루에 푼 문제 수 (KST 기준, 일일 스냅샷으로 계산)"
+	MsgChartEmpty                  = "아직 차트를 그릴 점수 기록이 없습니다. 일일 스코어보드가 발송될 때마다 기록됩니다."
+
+	// 점수 내역 관련
+	MsgScoreUsage            = "사용법: `!내점수 [백준ID] [페이지]`"
+	MsgScoreNotRegistered    = "대회 참가자 중 '%s'을(를) 찾을 수 없습니다. `!내점수 <백준ID>` 형식으로 입력해주세요."
+	MsgScoreTitle            = "🧮 %s 점수 내역"
+	MsgScoreSummary          = "🏅 리그: %s\n📐 점수 방식: %s\n🎯 총점: **%.0f**점 (%d문제)"
+	MsgScoreBonus            = "🎁 보너스: %d문제 (+%.1f점)"
+	MsgScoreStreak           = "🌱 잔디: 현재 %d일 · 최장 %d일 (+%.0f점)"
+	MsgScoreGrowth           = "📈 성장: 레이팅 %+d · 티어 %+d (+%.0f점)"
+	MsgScoreExcluded         = "🚫 제외: 등록 전 해결 %d문제 · 대회 기간 외 %d문제 · 난이도 없음 %d문제"
+	MsgScoreExcludedNotInSet = " · 목록 외 %d문제"
+	MsgScoreNoEntries        = "아직 점수에 반영된 문제가 없습니다."
+	MsgScorePageFooter       = "페이지 %d/%d · 다음 페이지: !내점수 %s %d"
+	MsgScorePageFooterLast   = "페이지 %d/%d"
+
+	// 잔디(연속 해결) 관련
+	// 팀 관련
+	MsgTeamUsage          = "사용법: `!팀 [list|create <팀명>|join <팀명>|leave]`\n관리자: `!팀 assign <백준ID> <팀명>`, `!팀 unassign <백준ID>`, `!팀 delete <팀명>`"
+	MsgTeamInvalidName    = "팀 이름은 공백 없이 2자 이상, 표시 폭 16칸 이하로 입력해주세요. (한글/영문/숫자/-/_/.)"
+	MsgTeamEmpty          = "아직 만들어진 팀이 없습니다. `!팀 create <팀명>`으로 팀을 만들어보세요."
+	MsgTeamListTitle      = "👥 팀 목록 (%d팀)"
+	MsgTeamNoMembers      = "(팀원 없음)"
+	MsgTeamAlreadyExists  = "이미 존재하는 팀입니다: %s"
+	MsgTeamNotFound       = "팀을 찾을 수 없습니다: %s"
+	MsgTeamNotParticipant = "이 디스코드 계정과 연결된 대회 참가자만 팀에 가입할 수 있습니다. `!내정보`로 연결을 확인해주세요."
+	MsgTeamFull           = "%s 팀은 정원(%d명)이 가득 찼습니다."
+	MsgTeamNoTeam         = "%s 님은 소속된 팀이 없습니다."
+	MsgTeamCreated        = "**팀 생성 완료**\n👥 팀: %s"
+	MsgTeamJoined         = "✅ %s 님이 %s 팀에 가입했습니다."
+	MsgTeamLeft           = "👋 %s 님이 %s 팀에서 나왔습니다."
+	MsgTeamDeleted        = "**팀 삭제 완료**\n👥 팀: %s (팀원은 팀 없는 참가자로 남습니다)"
+	MsgTeamUpdateFailed   = "팀 정보를 변경하는 중 오류가 발생했습니다."
+
+	// 시즌 관련 메시지
+	MsgSeasonUsage         = "사용법: `!시즌 [시즌명|list]`\n관리자: `!시즌 create <시즌명> <시작일> <종료일> [placement|normalized]`, `!시즌 delete <시즌명>`"
+	MsgSeasonCreateUsage   = "사용법: `!시즌 create <시즌명> <시작일> <종료일> [placement|normalized]`\n시즌명은 공백 없이 입력하고, 날짜는 YYYY-MM-DD 형식입니다. (placement: 리그 순위 포인트, normalized: 리그 1위 대비 점수 비율)"
+	MsgSeasonEmpty         = "등록된 시즌이 없습니다. 관리자가 `!시즌 create`로 시즌을 만들 수 있습니다."
+	MsgSeasonNotFound      = "시즌을 찾을 수 없습니다: %s"
+	MsgSeasonAlreadyExists = "이미 존재하는 시즌입니다: %s"
+	MsgSeasonCreated       = "**시즌 생성 완료**\n🏅 시즌: %s\n📅 기간: %s ~ %s\n📐 산정 방식: %s"
+	MsgSeasonDeleted       = "**시즌 삭제 완료**\n🏅 시즌: %s (대회 결과는 그대로 남습니다)"
+	MsgSeasonUpdateFailed  = "시즌 정보를 변경하는 중 오류가 발생했습니다."
+	MsgSeasonListTitle     = "🏅 시즌 목록 (%d개)"
+	MsgSeasonTitle         = "🏅 %s 시즌 순위"
+	MsgSeasonSummary       = "📅 %s ~ %s · 📐 %s · 🏆 확정된 대회 %d개"
+	MsgSeasonNoResults     = "아직 이 시즌에 확정된 대회가 없습니다."
+	MsgSeasonMoreMembers   = "... 외 %d명\n"
+	MsgSeasonFooter        = "종료일이 시즌 기간 안에 있는 확정 대회만 합산합니다 · 지난 대회: !대회 history"
+
+	// 권한 관련 메시지
+	MsgPermissionUsage                = "사용법: `!권한 [list]`, `!권한 grant|revoke <권한> <role|user> <ID|멘션>`\n권한: manage_competition, remove_participant, view_cache, view_hidden_scoreboard"
+	MsgPermissionListTitle            = "🔐 명령어 권한"
+	MsgPermissionEntry                = "**%s** (`%s`)\n└ %s\n"
+	MsgPermissionNone                 = "없음 (서버 관리자만)"
+	MsgPermissionListFooter           = "서버 소유자와 관리자(Administrator) 역할은 모든 권한을 가집니다 · DM 관리 명령어는 DISCORD_GUILD_ID 서버 기준으로 확인합니다"
+	MsgPermissionInvalidCapability    = "알 수 없는 권한입니다: %s\n권한: manage_competition, remove_participant, view_cache, view_hidden_scoreboard"
+	MsgPermissionInvalidTarget        = "대상은 `role <역할ID|@역할>` 또는 `user <사용자ID|@사용자>` 형식으로 입력해주세요."
+	MsgPermissionGranted              = "**권한 부여 완료**\n👤 대상: %s\n🔐 권한: `%s`"
+	MsgPermissionRevoked              = "**권한 회수 완료**\n👤 대상: %s\n🔐 권한: `%s`"
+	MsgPermissionAlreadyGranted       = "%s 에게 이미 `%s` 권한이 있습니다."
+	MsgPermissionNotGranted           = "%s 에게 `%s` 권한이 부여되어 있지 않습니다."
+	MsgPermissionLoadFailed           = "권한 설정을 불러오는 중 오류가 발생했습니다."
+	MsgPermissionUpdateFailed         = "권한 설정을 저장하는 중 오류가 발생했습니다."
+	MsgCapabilityManageCompetition    = "대회·시즌·팀 관리, 참가자 목록·계정 연결, 감사 로그"
+	MsgCapabilityRemoveParticipant    = "참가자 삭제"
+	MsgCapabilityViewCache            = "API 캐시 통계"
+	MsgCapabilityViewHiddenScoreboard = "스코어보드 생성, 블랙아웃 중 점수 조회"
+
+	// 계정 연결 관련 메시지
+	MsgMyInfoTitle              = "🙋 %s 참가 정보"
+	MsgMyInfoDescription        = "👤 이름: %s\n🎯 백준ID: %s\n🏷️ 등록 시 티어: %s\n📅 등록 시각: %s\n🔗 디스코드: %s"
+	MsgMyInfoNotLinked          = "**%s**에 이 디스코드 계정으로 등록된 참가자가 없습니다. `!등록 <이름> <백준ID>`로 등록해주세요. 계정 연결 기능 이전에 등록했다면 같은 명령어로 본인 확인을 거쳐 연결할 수 있고, 다른 디스코드 계정으로 등록했다면 관리자에게 계정 연결을 요청해주세요."
+	MsgMyInfoUnlinkedUser       = "연결 안 됨"
+	MsgMyInfoPreviousHandles    = "\n🔁 이전 백준ID: %s"
+	MsgRegisterAlreadyLinked    = "이 디스코드 계정은 이미 **%s**(으)로 등록되어 있습니다. 대회마다 계정 하나당 한 명만 등록할 수 있습니다."
+	MsgLinkUsage                = "사용법: `!계정연결 link <백준ID> <@사용자|사용자ID>`, `!계정연결 unlink <백준ID>`, `!계정연결 migrate`"
+	MsgLinkUserTaken            = "%s 님은 이미 **%s** 참가자와 연결되어 있습니다. 먼저 `!계정연결 unlink %[2]s`로 연결을 해제해주세요."
+	MsgLinkUpdated              = "**계정 연결 완료**\n🎯 백준ID: %s\n🔗 디스코드: %s"
+	MsgLinkRemoved              = "**계정 연결 해제 완료**\n🎯 백준ID: %s"
+	MsgLinkUpdateFailed         = "계정 연결을 변경하는 중 오류가 발생했습니다."
+	MsgLinkMigrated             = "**계정 연결 이전 완료**\n🔗 새로 연결: %d명\n❔ 아직 연결 안 됨: %d명 (이름이 겹치거나 서버에 없는 참가자는 `!계정연결 link`로 직접 연결해주세요)"
+	MsgLinkMigrateMembersFailed = "서버 멤버 목록을 가져오지 못했습니다. 개발자 포털에서 Server Members Intent를 켰는지 확인해주세요."
+	MsgLinkMigrateTarget        = "연결 안 된 기존 참가자"
+	MsgLinkMigrateAudit         = "%d명 연결"
+
+	// 감사 로그 관련 메시지
+	MsgAuditUsage              = "사용법: `!감사로그 [개수]` (1~%d, 기본 10개)"
+	MsgAuditEmpty              = "아직 기록된 관리 작업이 없습니다."
+	MsgAuditTitle              = "📝 최근 관리 작업 %d건"
+	MsgAuditMirrorTitle        = "📝 관리 작업: %s"
+	MsgAuditEntry              = "`%s` **%s** `%s` %s"
+	MsgAuditNoValue            = "(없음)"
+	MsgAuditFreeSolve          = "자유 해결"
+	MsgAuditDefaultRules       = "기본 규칙"
+	MsgAuditDefaultEligibility = "기본 요건"
+	MsgAuditRules              = "%s (%s, %d개 리그)"
+	MsgAuditPeriod             = "%s ~ %s"
+	MsgAuditBackfill           = "시작 스냅샷 %d명 보완"
+	MsgAuditFinalize           = "참가자 %d명 결과 확정"
+	MsgAuditFinalizePartial    = "참가자 %d명 결과 확정 (마지막 기록 기준 %d명: %s)"
+	MsgAuditParticipant        = "%s (%s)"
+
+	// 참가 명단 관련 메시지
+	MsgRosterUsage             = "사용법: `!명단 [list|add <이름> ...|remove <이름> ...|import csv|import sheets [스프레드시트 ID 또는 주소]|sheets off]`"
+	MsgRosterEmpty             = "**%s** 참가 명단이 비어 있습니다. `!명단 add` 또는 `!명단 import`로 명단을 등록해주세요."
+	MsgRosterTitle             = "📋 %s 참가 명단 (%d명)"
+	MsgRosterSourceCounts      = "직접 추가 %d명 · CSV %d명 · 스프레드시트 %d명"
+	MsgRosterMore              = "외 %d명"
+	MsgRosterInvalidNames      = "이름 형식이 올바르지 않아 건너뛰었습니다: %s"
+	MsgRosterNoChange          = "참가 명단에 바뀐 내용이 없습니다."
+	MsgRosterUpdated           = "**%s 참가 명단 변경 완료**\n%s"
+	MsgRosterDiffAdded         = "➕ 추가 %d명: %s"
+	MsgRosterDiffRemoved       = "➖ 삭제 %d명: %s"
+	MsgRosterSyncTitle         = "📋 %s 참가 명단 자동 동기화"
+	MsgRosterCSVMissing        = "CSV를 찾을 수 없습니다. `.csv` 파일을 첨부하거나 명령어 다음 줄부터 CSV 내용을 붙여넣어 주세요."
+	MsgRosterCSVInvalid        = "CSV를 읽을 수 없습니다: %s"
+	MsgRosterSheetsUnavailable = "스프레드시트 연동이 설정되지 않아 명단을 가져올 수 없습니다."
+	MsgRosterSheetsFailed      = "스프레드시트에서 참가 명단을 읽어오지 못했습니다."
+	MsgRosterSaveFailed        = "참가 명단을 저장하는 중 오류가 발생했습니다."
+	MsgRosterInvalidSheet      = "스프레드시트 ID 또는 주소가 올바르지 않습니다."
+	MsgRosterSheetEnabled      = "**%s** 참가 명단을 30분마다 스프레드시트 `%s`와 자동 동기화합니다. 끄려면 `!명단 sheets off`를 입력하세요."
+	MsgRosterSheetDisabled     = "**%s** 참가 명단 자동 동기화를 껐습니다. 스프레드시트에서 가져온 이름은 그대로 남습니다."
+	MsgRosterSheetNotSet       = "자동 동기화할 스프레드시트가 지정되어 있지 않습니다."
+	MsgRosterSheetStatusOn     = "🔄 스프레드시트 `%s` 자동 동기화 중"
+	MsgRosterSheetStatusOff    = "🔄 스프레드시트 자동 동기화 꺼짐 (`!명단 import sheets`로 지정)"
+
+	MsgStreakboardTitle  = "🌱 %s 잔디 리더보드"
+	MsgStreakboardEmpty  = "아직 연속 해결 기록이 없습니다."
+	MsgStreakboardFooter = "오늘 아직 풀지 않았어도 어제까지 이어졌다면 진행 중으로 표시됩니다."
+	MsgStreakBrokenTitle = "🥀 어제 끊긴 잔디"
+	MsgStreakBrokenLine  = "• %s — %d일 연속 기록이 끊겼습니다"
 
 	// 참가자 관련
 	MsgParticipantsEmpty = "참가자가 없습니다."
@@ -37,10 +242,91 @@ const (
 	BotStatusMessage = "점수 집계"
 
 	// 대회 관리 관련
-	MsgCompetitionCreateUsage   = "사용법: `!대회 create <대회명> <시작일> <종료일>` (날짜 형식: YYYY-MM-DD)"
-	MsgCompetitionCreateSuccess = "**대회 생성 완료**\n🏆 대회명: %s\n📅 기간: %s ~ %s\n🔒 블랙아웃: %s부터"
-	MsgCompetitionUpdateSuccess = "**대회 정보 수정 완료**\n🎯 수정 항목: %s"
-	MsgCompetitionStatus        = "🏆 **대회 정보**\n📝 대회명: %s\n📅 시작일: %s\n📅 종료일: %s\n🔒 블랙아웃: %s\n📊 스코어보드: %s\n👥 참가자: %d명"
+	MsgCompetitionCreateUsage      = "사용법: `!대회 create <대회명> <시작일> <종료일>` (날짜 형식: YYYY-MM-DD)"
+	MsgCompetitionCreateSuccess    = "**대회 생성 완료**\n🏆 대회명: %s\n📅 기간: %s ~ %s\n🔒 블랙아웃: %s부터"
+	MsgCompetitionUpdateSuccess    = "**대회 정보 수정 완료**\n🎯 수정 항목: %s"
+	MsgCompetitionBackfillSuccess  = "**등록 시점 스냅샷 보완 완료**\n👥 보완된 참가자: %d명"
+	MsgCompetitionBackfillFailed   = "스냅샷 보완 중 오류가 발생했습니다. (보완 완료: %d명)"
+	MsgCompetitionRulesUsage       = "사용법: `!대회 rules [show|validate <JSON>|set <JSON>|reset]`"
+	MsgCompetitionRulesNoJSON      = "규칙 JSON을 찾을 수 없습니다. 명령어 뒤에 `{ ... }` 형식으로 붙여넣어 주세요."
+	MsgCompetitionRulesInvalid     = "규칙 검증 실패: %s"
+	MsgCompetitionRulesValid       = "**규칙 검증 통과**\n📝 규칙명: %s\n🏅 리그: %d개"
+	MsgCompetitionRulesSetSuccess  = "**점수 규칙 적용 완료**\n📝 규칙명: %s\n🏅 리그: %d개"
+	MsgCompetitionRulesReset       = "**점수 규칙 초기화 완료**\n기본 규칙(또는 규칙 파일)을 사용합니다."
+	MsgCompetitionRulesSourceComp  = "대회 전용 규칙"
+	MsgCompetitionRulesSourceBase  = "기본 규칙"
+	MsgEligibilityUsage            = "사용법: `!대회 eligibility [show|set <JSON>|reset]`"
+	MsgEligibilityNoJSON           = "참가 자격 JSON을 찾을 수 없습니다. 명령어 뒤에 `{ ... }` 형식으로 붙여넣어 주세요."
+	MsgEligibilityInvalid          = "참가 자격 검증 실패: %s"
+	MsgEligibilityUnknownOrg       = "solved.ac에서 단체 ID %d를 찾을 수 없습니다."
+	MsgEligibilityTitle            = "🎫 참가 자격: %s"
+	MsgEligibilitySetSuccess       = "**참가 자격 적용 완료**\n%s"
+	MsgEligibilityReset            = "**참가 자격 초기화 완료**\n기본 요건을 사용합니다.\n%s"
+	MsgEligibilitySourceComp       = "대회 전용 요건"
+	MsgEligibilitySourceBase       = "기본 요건"
+	MsgEligibilitySummaryOrgs      = "🏫 소속: %s"
+	MsgEligibilitySummaryMinTier   = "⬆️ 최소 티어: %s"
+	MsgEligibilitySummaryMaxTier   = "⬇️ 최대 티어: %s"
+	MsgEligibilitySummaryClass     = "🎓 클래스: %d 이상"
+	MsgEligibilitySummarySolved    = "✅ 해결 문제: %d개 이상"
+	MsgEligibilitySummaryAge       = "📅 가입 후: %d일 이상"
+	MsgEligibilitySummaryRoster    = "📋 참가 명단에 이름 필요"
+	MsgEligibilitySummaryNone      = "제한 없음"
+	MsgCompetitionModeUsage        = "사용법: `!대회 mode [preview] <level|tier_points|custom>`"
+	MsgCompetitionModeApplied      = "✅ 점수 방식 변경: %s → %s"
+	MsgCompetitionModePreview      = "🔍 점수 방식 미리보기: %s → %s (적용되지 않음)"
+	MsgCompetitionModeNoChange     = "점수 변화가 있는 참가자가 없습니다."
+	MsgCompetitionModeMoreChanges  = "... 외 %d명"
+	MsgProblemSetUsage             = "사용법: `!대회 problems [show|set <문제번호[:배점]> ...|query <solved.ac 검색어>|clear]`"
+	MsgProblemSetInvalid           = "문제 목록 오류: %s"
+	MsgProblemSetSaved             = "**문제 목록 대회 설정 완료**\n📋 문제: %d개\n%s"
+	MsgProblemSetCleared           = "**문제 목록 초기화 완료**\n모든 문제를 채점하는 자유 해결 대회로 돌아갑니다."
+	MsgProblemSetEmpty             = "문제 목록이 설정되지 않았습니다. 모든 문제를 채점하는 자유 해결 대회입니다."
+	MsgProblemSetTitle             = "📋 문제 목록 (%d문제)"
+	MsgProblemSetQueryEmpty        = "검색 결과가 없습니다: `%s`"
+	MsgProblemSetQueryFailed       = "solved.ac 문제 검색 중 오류가 발생했습니다."
+	MsgProblemSetModeLabel         = "문제 목록 (%d문제)"
+	MsgProblemSetSolvedCounts      = "✅ 해결 인원: %s"
+	MsgProblemSetMoreColumns       = "... 외 %d문제는 `!대회 problems`로 확인하세요"
+	MsgProblemSetMoreRows          = "... 외 %d명"
+	MsgCompetitionCreateSelectHint = "🆔 대회 ID: `%s` (이 대회를 채널 기본 대회로 쓰려면 해당 채널에서 `!대회 select <ID>`)"
+	MsgCompetitionStatusScope      = "🆔 ID: `%s`\n📺 채널: %s\n⏰ 스코어보드 발송: %s"
+	MsgCompetitionListTitle        = "🏆 진행 중인 대회 (%d개)"
+	MsgCompetitionListScope        = "　📺 %s · ⏰ %s\n"
+	MsgCompetitionListFooter       = "▶ 이 채널에서 사용 중인 대회 · 채널 지정: !대회 select <ID|대회명>"
+	MsgCompetitionDefaultChannel   = "기본 채널"
+	MsgCompetitionDefaultSchedule  = "기본 시각"
+	MsgCompetitionSelectUsage      = "사용법: `!대회 select <대회 ID|대회명>` 또는 `!대회 select clear` (서버 채널에서만 사용 가능)"
+	MsgCompetitionSelectNotFound   = "진행 중인 대회를 찾을 수 없습니다. `!대회 list`로 대회 ID를 확인하세요."
+	MsgCompetitionSelectSuccess    = "이 채널의 기본 대회가 **%s**(으)로 지정되었습니다. 일일 스코어보드도 이 채널로 발송됩니다."
+	MsgCompetitionSelectCleared    = "이 채널의 대회 지정을 해제했습니다. 가장 최근에 만든 대회를 사용합니다."
+	MsgCompetitionSelectNone       = "이 채널에 지정된 대회가 없습니다."
+	MsgCompetitionScheduleInvalid  = "발송 시각은 `HH:MM` 형식(KST)으로 입력하거나 `default`를 입력하세요."
+	MsgCompetitionFinalizeNotEnded = "대회가 아직 끝나지 않았습니다. 종료일 이후에 확정하거나, 지금 확정하려면 `!대회 finalize force`를 입력하세요."
+	MsgCompetitionFinalizeStarted  = "⏳ **%s** 최종 점수를 계산하고 있습니다..."
+	MsgCompetitionFinalizeSuccess  = "**대회 결과 확정 완료**\n🏆 대회명: %s\n👥 보관된 결과: %d명\n📜 결과 보기: `!대회 results %s`"
+	MsgCompetitionFinalizePartial  = "⚠️ solved.ac 조회에 실패한 %d명은 마지막으로 기록된 점수로 확정했습니다: %s"
+	MsgFinalizePartialTitle        = "⚠️ %s 결과 부분 확정"
+	MsgFinalizeRetryTitle          = "⚠️ %s 결과 자동 확정 실패"
+	MsgFinalizeRetry               = "%d/%d번째 시도가 실패했습니다. %d분 뒤에 다시 시도합니다.\n%s"
+	MsgFinalizeFailedParticipants  = "solved.ac 조회 실패 %d명: %s"
+	MsgArchiveListTitle            = "📜 지난 대회 (%d개)"
+	MsgArchiveListEntry            = "• **%s** (`%s`) %s ~ %s · %d명 · %s 확정\n"
+	MsgArchiveListFooter           = "결과 보기: !대회 results <ID|대회명> [백준ID]"
+	MsgArchiveListMore             = "... 외 %d개 대회"
+	MsgArchiveEmpty                = "확정된 지난 대회가 없습니다."
+	MsgArchiveResultsUsage         = "사용법: `!대회 results <대회 ID|대회명> [백준ID] [페이지]`"
+	MsgArchiveNotFound             = "확정된 대회를 찾을 수 없습니다. `!대회 history`로 대회 ID를 확인하세요."
+	MsgArchiveResultsTitle         = "📜 %s 최종 결과"
+	MsgArchiveResultsFooter        = "%s 확정 · 참가자 %d명 · 대회 ID %s"
+	MsgArchiveParticipantNotFound  = "`%s`님의 결과가 보관되어 있지 않습니다."
+	MsgArchivePartialFooter        = " · ⚠️ %d명은 마지막 기록 기준"
+	MsgArchivePartialBreakdown     = "`%s`님은 확정 당시 solved.ac 조회에 실패해 마지막으로 기록된 점수로 확정되었습니다. 계산 내역은 보관되어 있지 않습니다."
+	MsgArchiveRank                 = "🏅 최종 순위: %s %d위\n"
+	MsgArchiveOverallRank          = "전체"
+	MsgArchivePageFooter           = "페이지 %d/%d · 다음 페이지: !대회 results %s %s %d"
+	MsgArchivePageFooterLast       = "페이지 %d/%d"
+	MsgCompetitionStatus           = "🏆 **대회 정보**\n📝 대회명: %s\n📅 시작일: %s\n📅 종료일: %s\n🔒 블랙아웃: %s\n📊 스코어보드: %s\n👥 참가자: %d명"
 
 	// 상태 표시
 	StatusActive   = "활성"
@@ -53,17 +339,44 @@ const (
 const HelpMessage = `🤖 **깨미 명령어**
 
 **참가자 명령어:**
-• ` + "`!등록 <이름> <백준ID>`" + ` - 대회 등록 신청
+• ` + "`!등록 <이름> <백준ID>`" + ` - 대회 등록 신청 (본인 확인 토큰 발급)
+• ` + "`!인증`" + ` - solved.ac 자기소개에 넣은 토큰을 확인하고 등록 완료
+• ` + "`!내점수 [백준ID] [페이지]`" + ` - 문제별 점수 계산 내역 확인
+• ` + "`!잔디`" + ` - 연속 해결(잔디) 리더보드 확인
+• ` + "`!추이 [백준ID]`" + ` - 일일 기록으로 본 점수·순위 추이 확인
+• ` + "`!그래프 [백준ID]`" + ` - 리그별 상위 10명 또는 참가자의 점수 추이 그래프 확인
+• ` + "`!팀 [list|create|join|leave]`" + ` - 팀 목록 확인, 팀 생성/가입/탈퇴
+• ` + "`!내정보`" + ` - 내 디스코드 계정과 연결된 참가 정보 확인
+• ` + "`!핸들변경 <새 백준ID>`" + ` - 기록을 유지한 채 백준 ID 변경 (본인 확인 후 완료)
+• ` + "`!탈퇴`" + ` - 대회에서 탈퇴 (` + "`!탈퇴 확인`" + `으로 확정)
+• ` + "`!대회 history`" + ` - 확정된 지난 대회 목록 확인
+• ` + "`!시즌 [시즌명|list]`" + ` - 여러 대회를 합산한 시즌 순위 확인
+• ` + "`!대회 results <ID|대회명> [백준ID]`" + ` - 지난 대회 최종 스코어보드/점수 내역 확인
 
 **관리자 명령어:**
-• ` + "`!스코어보드`" + ` - 현재 스코어보드 확인
+• ` + "`!스코어보드 [YYYY-MM-DD]`" + ` - 현재 스코어보드 확인 (날짜를 주면 그날 기준으로 다시 생성)
 • ` + "`!참가자`" + ` - 참가자 목록 확인
 • ` + "`!대회 create <대회명> <시작일> <종료일>`" + ` - 대회 생성 (YYYY-MM-DD 형식)
 • ` + "`!대회 status`" + ` - 대회 상태 확인
+• ` + "`!대회 list`" + ` - 진행 중인 대회 목록 확인
+• ` + "`!대회 select <ID|대회명|clear>`" + ` - 현재 채널의 기본 대회 지정/해제
+• ` + "`!대회 finalize [force]`" + ` - 대회 최종 결과 확정 및 보관 (종료 다음 날 자동 확정)
 • ` + "`!대회 blackout <on/off>`" + ` - 스코어보드 공개/비공개 설정
-• ` + "`!대회 update <필드> <값>`" + ` - 대회 정보 수정 (name, start, end)
+• ` + "`!대회 update <필드> <값>`" + ` - 대회 정보 수정 (name, start, end, schedule)
+• ` + "`!대회 backfill`" + ` - 기존 참가자의 등록 시점 전체 해결 문제 스냅샷 보완
+• ` + "`!대회 rules [show|validate|set|reset]`" + ` - 점수 규칙 확인/검증/적용/초기화 (JSON)
+• ` + "`!대회 mode [preview] <level|tier_points|custom>`" + ` - 점수 방식 변경 및 순위 재계산
+• ` + "`!대회 eligibility [show|set|reset]`" + ` - 참가 자격(소속, 티어, 클래스, 해결 수, 가입 기간, 명단) 확인/적용/초기화 (JSON)
+• ` + "`!대회 problems [show|set|query|clear]`" + ` - 문제 목록 대회 설정 (지정 문제만 채점)
+• ` + "`!시즌 create|delete`" + ` - 시즌 기간과 포인트 산정 방식(placement, normalized) 설정
 • ` + "`!삭제 <백준ID>`" + ` - 참가자 삭제
+• ` + "`!계정연결 link|unlink|migrate`" + ` - 참가자와 디스코드 계정 다시 연결/해제, 기존 참가자 일괄 연결
+• ` + "`!팀 assign|unassign|delete`" + ` - 참가자 팀 배정/해제, 팀 삭제
+• ` + "`!권한 [list|grant|revoke]`" + ` - 관리 명령어 권한을 역할/사용자에게 부여·회수 (서버 관리자 전용)
+• ` + "`!감사로그 [개수]`" + ` - 최근 관리 작업 기록 확인
+• ` + "`!명단 [list|add|remove|import csv|import sheets [시트]|sheets off]`" + ` - 등록 자격을 확인하는 대회 참가 명단 관리 (가져온 스프레드시트는 30분마다 자동 동기화)
 
 **기타:**
 • ` + "`!ping`" + ` - 봇 응답 확인
-• ` + "`!도움말`" + ` - 도움말 표시`
+• ` + "`!도움말`" + ` - 도움말 표시
+• 등록·인증·핸들변경·탈퇴·스코어보드·대회·참가자·삭제·캐시 명령어는 ` + "`/`" + ` 슬래시 명령어로도 사용할 수 있습니다`
@@ -5,6 +5,7 @@ const (
 	// 사용자명 검증
 	MaxUsernameDisplayWidth = 40 // 사용자명 최대 표시 너비
 	MaxCharacterRepeats     = 5  // 허용되는 최대 문자 반복 횟수
+	MaxTeamNameDisplayWidth = 16 // 팀 이름 최대 표시 너비 (스코어보드 열 너비)
 
 	// 입력 길이 제한
 	MaxUserInputLength = 10000 // 일반 사용자 입력 최대 길이
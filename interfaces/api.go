@@ -12,4 +12,9 @@ type APIClient interface {
 	GetUserTop100(ctx context.Context, handle string) (*api.Top100Response, error)
 	GetUserAdditionalInfo(ctx context.Context, handle string) (*api.UserAdditionalInfo, error)
 	GetUserOrganizations(ctx context.Context, handle string) ([]api.Organization, error)
+	GetOrganization(ctx context.Context, organizationID int) (*api.Organization, error)
+	GetUserSolvedProblems(ctx context.Context, handle string) ([]api.ProblemInfo, error)
+	GetProblemInfo(ctx context.Context, problemID int) (*api.ProblemInfo, error)
+	GetClassProblemIDs(ctx context.Context, class int) ([]int, error)
+	SearchProblems(ctx context.Context, query string, page int) (*api.ProblemSearchResponse, error)
 }
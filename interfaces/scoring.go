@@ -2,14 +2,25 @@ package interfaces
 
 import (
 	"context"
+	"time"
 
 	"github.com/ssugameworks/kkemi/api"
+	"github.com/ssugameworks/kkemi/models"
 )
 
 // ScoreCalculator 점수 계산을 위한 인터페이스입니다
 type ScoreCalculator interface {
 	CalculateScore(ctx context.Context, handle string, startTier int, startProblemIDs []int) (float64, error)
 	CalculateScoreWithTop100(top100 *api.Top100Response, startTier int, startProblemIDs []int) float64
+	CalculateScoreWithSolved(solved []api.ProblemInfo, startTier int, startProblemIDs []int) float64
+	CalculateBreakdown(solved []api.ProblemInfo, startTier int, startProblemIDs []int) *models.ScoreBreakdown
+	CalculateStreak(solved []api.ProblemInfo, firstSeen map[int]time.Time, startProblemIDs []int, today time.Time) models.StreakStats
+	CalculateGrowth(startTier, startRating, currentTier, currentRating int) models.GrowthStats
+	CountNewProblems(solved []api.ProblemInfo, startProblemIDs []int) int
+	CountPreRegistration(solved []api.ProblemInfo, startProblemIDs []int) int
+	FilterSolvesInWindow(solved []api.ProblemInfo, firstSeen map[int]time.Time, competition *models.Competition) []api.ProblemInfo
 	GetUserLeague(startTier int) int
 	GetLeagueName(league int) string
+	ForCompetition(competition *models.Competition) ScoreCalculator
+	GetRules() *models.ScoringRules
 }
@@ -1,28 +1,92 @@
 package interfaces
 
 import (
+	"errors"
 	"time"
 
 	"github.com/ssugameworks/kkemi/models"
 )
 
+// ErrStartSnapshotUnavailable 등록 시점 해결 문제를 조회하지 못해 참가자를 저장하지 않았음을 나타냅니다
+// (스냅샷 없이 저장하면 등록 전에 푼 문제까지 모두 점수로 인정되므로 등록·백준 ID 변경을 실패시킵니다)
+var ErrStartSnapshotUnavailable = errors.New("start snapshot unavailable")
+
+// ErrTeamFull 팀 정원(constants.MaxTeamSize)이 차서 배정하지 않았음을 나타냅니다 (정원 확인은 배정과 같은 트랜잭션에서 합니다)
+var ErrTeamFull = errors.New("team is full")
+
 // StorageRepository 데이터 저장소 작업을 위한 인터페이스입니다
 type StorageRepository interface {
 	// 참가자 작업
 	GetParticipants() []models.Participant
-	AddParticipant(name, baekjoonID string, startTier, startRating int, organizationID int) error
+	AddParticipant(name, baekjoonID string, startTier, startRating int, organizationID int, discordUserID string) error
 	RemoveParticipant(baekjoonID string) error
+	LinkDiscordUser(baekjoonID, discordUserID string) error
+	ChangeParticipantHandle(change models.HandleChange) error
+	GetHandleChanges() ([]models.HandleChange, error)
 	SaveParticipants() error
+	BackfillStartSnapshots() (int, error)
+
+	// 등록 본인 확인 작업 (디스코드 사용자별 토큰, 없으면 nil 반환)
+	SaveRegistrationChallenge(challenge models.RegistrationChallenge) error
+	GetRegistrationChallenge(discordUserID string) (*models.RegistrationChallenge, error)
+	DeleteRegistrationChallenge(discordUserID string) error
+
+	// 참가 명단 작업 (대회별, 정규화한 이름이 키라 같은 이름은 덮어씀)
+	GetRoster() ([]models.RosterEntry, error)
+	SaveRosterEntries(entries []models.RosterEntry) error
+	RemoveRosterEntries(names []string) error
+
+	// 팀 작업
+	GetTeams() []models.Team
+	CreateTeam(name string) error
+	DeleteTeam(name string) error
+	AssignTeam(teamName, baekjoonID string) error
+	LeaveTeam(baekjoonID string) error
+
+	// 해결 기록 작업
+	RecordSolves(baekjoonID string, problemIDs []int, seenAt time.Time) (map[int]time.Time, error)
+	GetSolveRecords(baekjoonID string) (map[int]time.Time, error)
 
-	// 대회 작업
+	// 대회 작업 (작업 대상 대회는 ForCompetition으로 지정, 기본값은 가장 최근에 만든 활성 대회)
+	ForCompetition(competitionID string) StorageRepository
 	GetCompetition() *models.Competition
-	CreateCompetition(name string, startDate, endDate time.Time) error
+	GetActiveCompetitions() []models.Competition
+	CreateCompetition(name string, startDate, endDate time.Time) (string, error)
 	SetScoreboardVisibility(visible bool) error
 	IsBlackoutPeriod() bool
 	SaveCompetition() error
 	UpdateCompetitionName(name string) error
 	UpdateCompetitionStartDate(startDate time.Time) error
 	UpdateCompetitionEndDate(endDate time.Time) error
+	UpdateCompetitionScoringRules(rules *models.ScoringRules) error
+	UpdateCompetitionEligibility(policy *models.EligibilityPolicy) error
+	UpdateCompetitionProblems(problems []models.TargetProblem) error
+	UpdateCompetitionChannel(channelID string) error
+	UpdateCompetitionSchedule(schedule string) error
+	UpdateCompetitionRosterSheet(spreadsheetID string) error
+
+	// 대회 보관 작업 (확정된 대회는 비활성화되고 결과는 solved.ac 없이 조회)
+	FinalizeCompetition(results []models.ArchivedResult) error
+	GetArchivedCompetitions() []models.Competition
+	GetArchivedResults() ([]models.ArchivedResult, error)
+
+	// 점수 스냅샷 작업 (정기 실행마다 저장, 시간순 조회)
+	SaveSnapshot(snapshot models.ScoreSnapshot) error
+	GetSnapshots() ([]models.ScoreSnapshot, error)
+	GetLatestSnapshot() (*models.ScoreSnapshot, error)
+
+	// 시즌 작업 (대회와 무관한 전역 데이터)
+	GetSeasons() []models.Season
+	CreateSeason(season models.Season) error
+	DeleteSeason(name string) error
+
+	// 서버 권한 작업 (대회와 무관한 서버별 설정, 설정이 없으면 빈 권한 반환)
+	GetGuildPermissions(guildID string) (*models.GuildPermissions, error)
+	SaveGuildPermissions(permissions *models.GuildPermissions) error
+
+	// 감사 로그 작업 (관리 작업 기록, 최신순 조회)
+	AppendAuditEntry(entry models.AuditEntry) error
+	GetAuditEntries(limit int) ([]models.AuditEntry, error)
 
 	// 리소스 정리
 	Close() error
@@ -0,0 +1,73 @@
+package models
+
+import (
+	"sort"
+	"strings"
+)
+
+// ArchivedResult 확정된 대회에서 참가자 한 명의 최종 결과입니다 (확정 시점의 점수를 그대로 보관)
+type ArchivedResult struct {
+	Rank      int             `json:"rank" firestore:"rank"` // 리그 내 순위 (문제 목록 대회는 전체 순위)
+	Score     ScoreData       `json:"score" firestore:"score"`
+	Breakdown *ScoreBreakdown `json:"breakdown,omitempty" firestore:"breakdown,omitempty"`
+	// Partial 확정 시 solved.ac 조회에 실패해 마지막 점수 스냅샷 값으로 채운 결과 (계산 내역 없음)
+	Partial bool `json:"partial,omitempty" firestore:"partial,omitempty"`
+}
+
+// IsFinalized 최종 결과가 확정되어 보관된 대회인지 확인합니다
+func (c *Competition) IsFinalized() bool {
+	return !c.FinalizedAt.IsZero()
+}
+
+// SortArchivedResults 보관된 결과를 리그, 순위, 아이디 순으로 정렬합니다
+func SortArchivedResults(results []ArchivedResult) {
+	sort.SliceStable(results, func(i, j int) bool {
+		if results[i].Score.League != results[j].Score.League {
+			return results[i].Score.League < results[j].Score.League
+		}
+		if results[i].Rank != results[j].Rank {
+			return results[i].Rank < results[j].Rank
+		}
+		return results[i].Score.BaekjoonID < results[j].Score.BaekjoonID
+	})
+}
+
+// ArchivedScores 보관된 결과에서 점수 데이터만 꺼냅니다
+func ArchivedScores(results []ArchivedResult) []ScoreData {
+	scores := make([]ScoreData, 0, len(results))
+	for _, result := range results {
+		scores = append(scores, result.Score)
+	}
+	return scores
+}
+
+// FindArchivedResult 백준 ID로 보관된 결과를 찾습니다 (대소문자 무시)
+func FindArchivedResult(results []ArchivedResult, baekjoonID string) (ArchivedResult, bool) {
+	for _, result := range results {
+		if strings.EqualFold(result.Score.BaekjoonID, baekjoonID) {
+			return result, true
+		}
+	}
+	return ArchivedResult{}, false
+}
+
+// SortArchivedCompetitions 보관된 대회를 최근에 확정된 순으로 정렬합니다
+func SortArchivedCompetitions(competitions []Competition) {
+	sort.SliceStable(competitions, func(i, j int) bool {
+		if !competitions[i].FinalizedAt.Equal(competitions[j].FinalizedAt) {
+			return competitions[i].FinalizedAt.After(competitions[j].FinalizedAt)
+		}
+		return competitions[i].ID < competitions[j].ID
+	})
+}
+
+// CountPartialResults 마지막 점수 스냅샷 값으로 확정된 결과 수를 셉니다
+func CountPartialResults(results []ArchivedResult) int {
+	count := 0
+	for _, result := range results {
+		if result.Partial {
+			count++
+		}
+	}
+	return count
+}
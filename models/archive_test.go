@@ -0,0 +1,52 @@
+package models
+
+import (
+	"testing"
+	"time"
+)
+
+func TestSortArchivedResults(t *testing.T) {
+	results := []ArchivedResult{
+		{Rank: 2, Score: ScoreData{BaekjoonID: "b", League: 1}},
+		{Rank: 1, Score: ScoreData{BaekjoonID: "c", League: 2}},
+		{Rank: 1, Score: ScoreData{BaekjoonID: "z", League: 1}},
+		{Rank: 1, Score: ScoreData{BaekjoonID: "a", League: 1}},
+	}
+
+	SortArchivedResults(results)
+
+	expected := []string{"a", "z", "b", "c"}
+	for i, id := range expected {
+		if results[i].Score.BaekjoonID != id {
+			t.Errorf("Expected %s at position %d, got %s", id, i, results[i].Score.BaekjoonID)
+		}
+	}
+}
+
+func TestFindArchivedResult(t *testing.T) {
+	results := []ArchivedResult{{Rank: 3, Score: ScoreData{BaekjoonID: "Alice"}}}
+
+	if result, ok := FindArchivedResult(results, "alice"); !ok || result.Rank != 3 {
+		t.Errorf("Expected case-insensitive lookup, got %+v", result)
+	}
+	if _, ok := FindArchivedResult(results, "bob"); ok {
+		t.Error("Expected missing participant to be reported")
+	}
+}
+
+func TestSortArchivedCompetitions(t *testing.T) {
+	base := time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)
+	competitions := []Competition{
+		{ID: "old", FinalizedAt: base},
+		{ID: "new", FinalizedAt: base.AddDate(0, 1, 0)},
+	}
+
+	SortArchivedCompetitions(competitions)
+
+	if competitions[0].ID != "new" || !competitions[0].IsFinalized() {
+		t.Errorf("Expected most recently finalized competition first, got %s", competitions[0].ID)
+	}
+	if (&Competition{}).IsFinalized() {
+		t.Error("Expected competition without FinalizedAt to be unfinalized")
+	}
+}
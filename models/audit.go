@@ -0,0 +1,44 @@
+package models
+
+import "time"
+
+// 감사 로그에 기록하는 관리 작업 종류
+const (
+	AuditActionCompetitionCreate      = "competition.create"
+	AuditActionCompetitionBlackout    = "competition.blackout"
+	AuditActionCompetitionName        = "competition.name"
+	AuditActionCompetitionStart       = "competition.start"
+	AuditActionCompetitionEnd         = "competition.end"
+	AuditActionCompetitionSchedule    = "competition.schedule"
+	AuditActionCompetitionChannel     = "competition.channel"
+	AuditActionCompetitionRules       = "competition.rules"
+	AuditActionCompetitionEligibility = "competition.eligibility"
+	AuditActionCompetitionProblems    = "competition.problems"
+	AuditActionCompetitionBackfill    = "competition.backfill"
+	AuditActionCompetitionFinalize    = "competition.finalize"
+	AuditActionParticipantRemove      = "participant.remove"
+	AuditActionParticipantLink        = "participant.link"
+	AuditActionParticipantUnlink      = "participant.unlink"
+	AuditActionParticipantWithdraw    = "participant.withdraw"
+	AuditActionParticipantHandle      = "participant.handle"
+	AuditActionRosterAdd              = "roster.add"
+	AuditActionRosterRemove           = "roster.remove"
+	AuditActionRosterImport           = "roster.import"
+	AuditActionRosterSync             = "roster.sync"
+	AuditActionRosterSheet            = "roster.sheet"
+	AuditActionPermissionGrant        = "permission.grant"
+	AuditActionPermissionRevoke       = "permission.revoke"
+)
+
+// AuditEntry 관리 작업 하나의 기록입니다 (누가, 무엇을, 어떻게 바꿨는지)
+type AuditEntry struct {
+	ID            string    `firestore:"-"`
+	CompetitionID string    `firestore:"competitionId,omitempty"`
+	ActorID       string    `firestore:"actorId"`
+	ActorName     string    `firestore:"actorName"`
+	Action        string    `firestore:"action"`
+	Target        string    `firestore:"target"`
+	Before        string    `firestore:"before,omitempty"`
+	After         string    `firestore:"after,omitempty"`
+	Timestamp     time.Time `firestore:"timestamp"`
+}
@@ -0,0 +1,152 @@
+package models
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/ssugameworks/kkemi/constants"
+)
+
+// 참가 자격 요건 종류 (등록 거절 사유 표시에 사용)
+const (
+	EligibilityRuleOrganization = "organization"
+	EligibilityRuleMinTier      = "min_tier"
+	EligibilityRuleMaxTier      = "max_tier"
+	EligibilityRuleClass        = "class"
+	EligibilityRuleSolvedCount  = "solved_count"
+	EligibilityRuleAccountAge   = "account_age"
+	EligibilityRuleRoster       = "roster"
+)
+
+// EligibilityPolicy 대회별 참가 자격 요건입니다 (0 또는 빈 값인 요건은 검사하지 않음)
+type EligibilityPolicy struct {
+	// OrganizationIDs 이 중 하나에 소속되어야 하는 solved.ac 단체 ID
+	OrganizationIDs []int `json:"organizationIds,omitempty" firestore:"organizationIds,omitempty"`
+	// MinTier, MaxTier 등록 시점 티어 범위 (1=Bronze V ~ 31=Master)
+	MinTier int `json:"minTier,omitempty" firestore:"minTier,omitempty"`
+	MaxTier int `json:"maxTier,omitempty" firestore:"maxTier,omitempty"`
+	// RequiredClass 필요한 solved.ac 클래스
+	RequiredClass int `json:"requiredClass,omitempty" firestore:"requiredClass,omitempty"`
+	// MinSolvedCount 필요한 최소 해결 문제 수
+	MinSolvedCount int `json:"minSolvedCount,omitempty" firestore:"minSolvedCount,omitempty"`
+	// MinAccountAgeDays solved.ac 가입 후 지나야 하는 일수
+	MinAccountAgeDays int `json:"minAccountAgeDays,omitempty" firestore:"minAccountAgeDays,omitempty"`
+	// RequireRoster 참가 명단에 이름이 있어야 하는지 여부
+	RequireRoster bool `json:"requireRoster,omitempty" firestore:"requireRoster,omitempty"`
+}
+
+// EligibilityProfile 참가 자격을 판단할 solved.ac 사용자 정보입니다
+type EligibilityProfile struct {
+	Tier            int
+	Class           int
+	SolvedCount     int
+	JoinedAt        time.Time // 알 수 없으면 zero
+	OrganizationIDs []int
+	InRoster        bool
+}
+
+// DefaultEligibilityPolicy 참가 자격을 지정하지 않은 대회에 적용하는 기본 요건 (기본 소속 + 참가 명단)
+func DefaultEligibilityPolicy() *EligibilityPolicy {
+	return &EligibilityPolicy{
+		OrganizationIDs: []int{constants.DefaultOrganizationID},
+		RequireRoster:   true,
+	}
+}
+
+// ParseEligibilityPolicy JSON 참가 자격 요건을 파싱하고 검증합니다 (모르는 항목은 오타로 보고 거부)
+func ParseEligibilityPolicy(data []byte) (*EligibilityPolicy, error) {
+	var policy EligibilityPolicy
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&policy); err != nil {
+		return nil, fmt.Errorf("참가 자격 JSON 파싱 실패: %w", err)
+	}
+	if err := policy.Validate(); err != nil {
+		return nil, err
+	}
+	return &policy, nil
+}
+
+// Validate 요건 값의 범위를 검증합니다
+func (p *EligibilityPolicy) Validate() error {
+	for _, id := range p.OrganizationIDs {
+		if id <= 0 {
+			return fmt.Errorf("단체 ID %d가 올바르지 않습니다", id)
+		}
+	}
+	if p.MinTier < 0 || p.MinTier > maxRuleTier || p.MaxTier < 0 || p.MaxTier > maxRuleTier {
+		return fmt.Errorf("티어는 0~%d 사이여야 합니다", maxRuleTier)
+	}
+	if p.MinTier > 0 && p.MaxTier > 0 && p.MinTier > p.MaxTier {
+		return fmt.Errorf("최소 티어 %d가 최대 티어 %d보다 높습니다", p.MinTier, p.MaxTier)
+	}
+	if p.RequiredClass < 0 || p.RequiredClass > constants.MaxSolvedACClass {
+		return fmt.Errorf("클래스는 0~%d 사이여야 합니다", constants.MaxSolvedACClass)
+	}
+	if p.MinSolvedCount < 0 || p.MinAccountAgeDays < 0 {
+		return fmt.Errorf("해결 문제 수와 가입 일수는 음수일 수 없습니다")
+	}
+	return nil
+}
+
+// EffectiveEligibility 대회에 적용되는 참가 자격 요건을 반환합니다 (지정되지 않았으면 기본 요건)
+func (c *Competition) EffectiveEligibility() *EligibilityPolicy {
+	if c.Eligibility != nil {
+		return c.Eligibility
+	}
+	return DefaultEligibilityPolicy()
+}
+
+// Violations 충족하지 못한 요건을 검사 순서대로 반환합니다 (모두 충족하면 빈 목록)
+func (p *EligibilityPolicy) Violations(profile EligibilityProfile, now time.Time) []string {
+	var violations []string
+	if len(p.OrganizationIDs) > 0 {
+		if _, ok := p.MatchOrganization(profile.OrganizationIDs); !ok {
+			violations = append(violations, EligibilityRuleOrganization)
+		}
+	}
+	if p.MinTier > 0 && profile.Tier < p.MinTier {
+		violations = append(violations, EligibilityRuleMinTier)
+	}
+	if p.MaxTier > 0 && profile.Tier > p.MaxTier {
+		violations = append(violations, EligibilityRuleMaxTier)
+	}
+	if p.RequiredClass > 0 && profile.Class < p.RequiredClass {
+		violations = append(violations, EligibilityRuleClass)
+	}
+	if p.MinSolvedCount > 0 && profile.SolvedCount < p.MinSolvedCount {
+		violations = append(violations, EligibilityRuleSolvedCount)
+	}
+	if p.MinAccountAgeDays > 0 && (profile.JoinedAt.IsZero() || AccountAgeDays(profile.JoinedAt, now) < p.MinAccountAgeDays) {
+		violations = append(violations, EligibilityRuleAccountAge)
+	}
+	if p.RequireRoster && !profile.InRoster {
+		violations = append(violations, EligibilityRuleRoster)
+	}
+	return violations
+}
+
+// MatchOrganization 사용자의 소속 중 허용된 단체를 찾습니다 (소속 제한이 없으면 첫 번째 소속, 소속이 없으면 0)
+func (p *EligibilityPolicy) MatchOrganization(organizationIDs []int) (int, bool) {
+	if len(p.OrganizationIDs) == 0 {
+		if len(organizationIDs) > 0 {
+			return organizationIDs[0], true
+		}
+		return 0, true
+	}
+	for _, id := range organizationIDs {
+		for _, allowed := range p.OrganizationIDs {
+			if id == allowed {
+				return id, true
+			}
+		}
+	}
+	return 0, false
+}
+
+// AccountAgeDays 가입 시각부터 지난 일수를 반환합니다
+func AccountAgeDays(joinedAt, now time.Time) int {
+	return int(now.Sub(joinedAt).Hours() / 24)
+}
@@ -0,0 +1,128 @@
+package models
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ssugameworks/kkemi/constants"
+)
+
+func TestParseEligibilityPolicy(t *testing.T) {
+	tests := []struct {
+		name    string
+		json    string
+		wantErr bool
+	}{
+		{"Valid policy", `{"organizationIds":[323],"minTier":6,"maxTier":15,"requiredClass":2,"minSolvedCount":50,"minAccountAgeDays":30,"requireRoster":true}`, false},
+		{"Empty policy", `{}`, false},
+		{"Unknown field", `{"minTeir":6}`, true},
+		{"Invalid organization", `{"organizationIds":[0]}`, true},
+		{"Tier out of range", `{"maxTier":32}`, true},
+		{"Inverted tier range", `{"minTier":10,"maxTier":5}`, true},
+		{"Class out of range", `{"requiredClass":11}`, true},
+		{"Negative solved count", `{"minSolvedCount":-1}`, true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := ParseEligibilityPolicy([]byte(test.json))
+			if (err != nil) != test.wantErr {
+				t.Errorf("Expected error=%t, got %v", test.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestEligibilityPolicyViolations(t *testing.T) {
+	now := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	policy := &EligibilityPolicy{
+		OrganizationIDs:   []int{323},
+		MinTier:           6,
+		MaxTier:           15,
+		RequiredClass:     2,
+		MinSolvedCount:    50,
+		MinAccountAgeDays: 30,
+		RequireRoster:     true,
+	}
+
+	eligible := EligibilityProfile{
+		Tier:            10,
+		Class:           3,
+		SolvedCount:     100,
+		JoinedAt:        now.AddDate(0, -2, 0),
+		OrganizationIDs: []int{1, 323},
+		InRoster:        true,
+	}
+	if violations := policy.Violations(eligible, now); len(violations) != 0 {
+		t.Errorf("Expected no violations, got %v", violations)
+	}
+
+	ineligible := EligibilityProfile{
+		Tier:        20,
+		Class:       1,
+		SolvedCount: 10,
+		JoinedAt:    now.AddDate(0, 0, -3),
+	}
+	expected := []string{
+		EligibilityRuleOrganization,
+		EligibilityRuleMaxTier,
+		EligibilityRuleClass,
+		EligibilityRuleSolvedCount,
+		EligibilityRuleAccountAge,
+		EligibilityRuleRoster,
+	}
+	violations := policy.Violations(ineligible, now)
+	if len(violations) != len(expected) {
+		t.Fatalf("Expected %v, got %v", expected, violations)
+	}
+	for i := range expected {
+		if violations[i] != expected[i] {
+			t.Errorf("Violation %d: expected %s, got %s", i, expected[i], violations[i])
+		}
+	}
+
+	// 가입일을 알 수 없으면 가입 기간 요건을 충족하지 못한 것으로 봅니다
+	unknownJoin := eligible
+	unknownJoin.JoinedAt = time.Time{}
+	if violations := policy.Violations(unknownJoin, now); len(violations) != 1 || violations[0] != EligibilityRuleAccountAge {
+		t.Errorf("Expected account age violation, got %v", violations)
+	}
+
+	low := eligible
+	low.Tier = 3
+	if violations := policy.Violations(low, now); len(violations) != 1 || violations[0] != EligibilityRuleMinTier {
+		t.Errorf("Expected min tier violation, got %v", violations)
+	}
+}
+
+func TestEligibilityPolicyMatchOrganization(t *testing.T) {
+	restricted := &EligibilityPolicy{OrganizationIDs: []int{323, 500}}
+	if id, ok := restricted.MatchOrganization([]int{1, 500}); !ok || id != 500 {
+		t.Errorf("Expected match 500, got %d (ok=%t)", id, ok)
+	}
+	if _, ok := restricted.MatchOrganization([]int{1}); ok {
+		t.Error("Expected no match for unlisted organization")
+	}
+
+	open := &EligibilityPolicy{}
+	if id, ok := open.MatchOrganization([]int{7, 8}); !ok || id != 7 {
+		t.Errorf("Expected first organization without restriction, got %d (ok=%t)", id, ok)
+	}
+	if id, ok := open.MatchOrganization(nil); !ok || id != 0 {
+		t.Errorf("Expected 0 without organizations, got %d (ok=%t)", id, ok)
+	}
+}
+
+func TestEffectiveEligibility(t *testing.T) {
+	competition := &Competition{}
+	policy := competition.EffectiveEligibility()
+	if len(policy.OrganizationIDs) != 1 || policy.OrganizationIDs[0] != constants.DefaultOrganizationID || !policy.RequireRoster {
+		t.Errorf("Expected default policy, got %+v", policy)
+	}
+
+	custom := &EligibilityPolicy{MinTier: 6}
+	competition.Eligibility = custom
+	if competition.EffectiveEligibility() != custom {
+		t.Error("Expected competition policy to override default")
+	}
+}
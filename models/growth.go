@@ -0,0 +1,57 @@
+package models
+
+import (
+	"fmt"
+	"sort"
+)
+
+// GrowthRule 대회 기간 중 레이팅 성장에 주는 점수 규칙입니다
+type GrowthRule struct {
+	PointsPerRating float64 `json:"pointsPerRating" firestore:"pointsPerRating"` // 오른 레이팅 1점당 점수
+	TierUpBonus     float64 `json:"tierUpBonus" firestore:"tierUpBonus"`         // 티어가 한 단계 오를 때마다 주는 점수
+}
+
+// GrowthStats 등록 시점 대비 레이팅/티어 변화와 성장 점수입니다
+type GrowthStats struct {
+	RatingGain int     `json:"rating_gain"` // 음수면 레이팅이 떨어진 것
+	TierGain   int     `json:"tier_gain"`
+	Bonus      float64 `json:"bonus"` // 성장 점수 (떨어진 경우 0)
+}
+
+// Improved 레이팅이나 티어가 올랐는지 확인합니다
+func (g GrowthStats) Improved() bool {
+	return g.RatingGain > 0 || g.TierGain > 0
+}
+
+// Validate 성장 규칙 값이 올바른지 검증합니다
+func (g *GrowthRule) Validate() error {
+	if g.PointsPerRating < 0 || g.TierUpBonus < 0 {
+		return fmt.Errorf("성장 점수 규칙의 값은 음수일 수 없습니다")
+	}
+	return nil
+}
+
+// MostImproved 티어 상승, 레이팅 상승 순으로 가장 많이 성장한 참가자를 최대 limit명 반환합니다
+func MostImproved(scores []ScoreData, limit int) []ScoreData {
+	improved := make([]ScoreData, 0, len(scores))
+	for _, score := range scores {
+		if score.Growth.Improved() {
+			improved = append(improved, score)
+		}
+	}
+
+	sort.SliceStable(improved, func(i, j int) bool {
+		if improved[i].Growth.TierGain != improved[j].Growth.TierGain {
+			return improved[i].Growth.TierGain > improved[j].Growth.TierGain
+		}
+		if improved[i].Growth.RatingGain != improved[j].Growth.RatingGain {
+			return improved[i].Growth.RatingGain > improved[j].Growth.RatingGain
+		}
+		return improved[i].BaekjoonID < improved[j].BaekjoonID
+	})
+
+	if len(improved) > limit {
+		improved = improved[:limit]
+	}
+	return improved
+}
@@ -0,0 +1,42 @@
+package models
+
+import "testing"
+
+func TestScoringRules_GrowthBonusFor(t *testing.T) {
+	rules := DefaultScoringRules()
+	if bonus := rules.GrowthBonusFor(100, 1); bonus != 0 {
+		t.Errorf("Expected no growth bonus without rule, got %f", bonus)
+	}
+
+	rules.Growth = &GrowthRule{PointsPerRating: 0.1, TierUpBonus: 10}
+	if bonus := rules.GrowthBonusFor(120, 2); bonus != 32 {
+		t.Errorf("Expected 12 + 20 = 32, got %f", bonus)
+	}
+	if bonus := rules.GrowthBonusFor(-50, -1); bonus != 0 {
+		t.Errorf("Rating drops should not be penalised, got %f", bonus)
+	}
+
+	rules.Growth.TierUpBonus = -1
+	if err := rules.Validate(); err == nil {
+		t.Error("Expected negative growth rule to be rejected")
+	}
+}
+
+func TestMostImproved(t *testing.T) {
+	scores := []ScoreData{
+		{BaekjoonID: "flat"},
+		{BaekjoonID: "dropped", Growth: GrowthStats{RatingGain: -30}},
+		{BaekjoonID: "rating", Growth: GrowthStats{RatingGain: 200}},
+		{BaekjoonID: "tierup", Growth: GrowthStats{RatingGain: 50, TierGain: 1}},
+		{BaekjoonID: "small", Growth: GrowthStats{RatingGain: 10}},
+	}
+
+	improved := MostImproved(scores, 2)
+	if len(improved) != 2 {
+		t.Fatalf("Expected 2 entries, got %d", len(improved))
+	}
+	if improved[0].BaekjoonID != "tierup" || improved[1].BaekjoonID != "rating" {
+		t.Errorf("Expected tier-up first then largest rating gain, got %s, %s",
+			improved[0].BaekjoonID, improved[1].BaekjoonID)
+	}
+}
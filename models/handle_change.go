@@ -0,0 +1,80 @@
+package models
+
+import (
+	"time"
+
+	"github.com/ssugameworks/kkemi/constants"
+)
+
+// 백준 ID 변경 시 등록 시점 스냅샷 처리 방식
+const (
+	HandleChangeCarryOver  = "carry_over" // 같은 계정의 아이디 변경: 등록 시점 스냅샷과 해결 기록을 그대로 이어받음
+	HandleChangeResnapshot = "resnapshot" // 다른 계정으로 교체: 변경 시점의 해결 문제로 스냅샷을 새로 저장
+)
+
+// HandleChange 참가자의 백준 ID 변경 기록입니다
+type HandleChange struct {
+	ID            string `firestore:"-"`
+	DiscordUserID string `firestore:"discordUserId,omitempty"`
+	OldBaekjoonID string `firestore:"oldBaekjoonId"`
+	NewBaekjoonID string `firestore:"newBaekjoonId"`
+	// OrganizationID 새 백준 ID로 다시 확인한 소속 (0이면 기존 소속 유지)
+	OrganizationID int       `firestore:"organizationId,omitempty"`
+	Policy         string    `firestore:"policy"`
+	ChangedAt      time.Time `firestore:"changedAt"`
+}
+
+// ChooseHandleChangePolicy 기존 아이디가 solved.ac에서 사라진 것이 확인되었고(404), 새 아이디의 해결 문제가
+// 등록 시점 스냅샷과 해결 기록을 모두 포함하면서 그 밖의 문제가 거의 없다면 같은 계정의 아이디 변경으로 보고 이어받습니다.
+// 그렇지 않으면 다른 계정으로 보고 스냅샷을 새로 저장합니다 (기록이 적은 계정에서 기록이 많은 계정으로 옮겨 점수를 얻는 것을 방지)
+func ChooseHandleChangePolicy(oldHandleMissing bool, startProblemIDs, recordedProblemIDs, solvedProblemIDs []int) string {
+	if !oldHandleMissing {
+		return HandleChangeResnapshot
+	}
+
+	solved := make(map[int]bool, len(solvedProblemIDs))
+	for _, id := range solvedProblemIDs {
+		solved[id] = true
+	}
+	known := make(map[int]bool, len(startProblemIDs)+len(recordedProblemIDs))
+	for _, ids := range [][]int{startProblemIDs, recordedProblemIDs} {
+		for _, id := range ids {
+			if !solved[id] {
+				return HandleChangeResnapshot
+			}
+			known[id] = true
+		}
+	}
+
+	unrecorded := 0
+	for id := range solved {
+		if !known[id] {
+			unrecorded++
+		}
+	}
+	if unrecorded > constants.HandleChangeMaxUnrecordedSolves {
+		return HandleChangeResnapshot
+	}
+	return HandleChangeCarryOver
+}
+
+// PreviousBaekjoonIDs 변경 기록을 거슬러 올라가 현재 백준 ID의 이전 아이디들을 오래된 순서로 반환합니다
+func PreviousBaekjoonIDs(changes []HandleChange, baekjoonID string) []string {
+	var previous []string
+	current := baekjoonID
+	for i := len(changes) - 1; i >= 0; i-- {
+		if changes[i].NewBaekjoonID == current {
+			current = changes[i].OldBaekjoonID
+			previous = append([]string{current}, previous...)
+		}
+	}
+	return previous
+}
+
+// HandleChangePolicyLabel 스냅샷 처리 방식의 표시 이름을 반환합니다
+func HandleChangePolicyLabel(policy string) string {
+	if policy == HandleChangeCarryOver {
+		return "기존 기록 유지"
+	}
+	return "새 계정 기준으로 다시 시작"
+}
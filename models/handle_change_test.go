@@ -0,0 +1,55 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/ssugameworks/kkemi/constants"
+)
+
+func TestChooseHandleChangePolicy(t *testing.T) {
+	// 스냅샷이 거의 비어 있는 계정에서 기록이 많은 계정으로 옮기는 경우
+	var history []int
+	for id := 1000; id < 1000+constants.HandleChangeMaxUnrecordedSolves+50; id++ {
+		history = append(history, id)
+	}
+
+	tests := []struct {
+		name             string
+		oldHandleMissing bool
+		start            []int
+		recorded         []int
+		solved           []int
+		expected         string
+	}{
+		{"Renamed account", true, []int{1000, 1001}, []int{1002}, []int{1000, 1001, 1002, 1003}, HandleChangeCarryOver},
+		{"Old handle still exists or unconfirmed", false, []int{1000}, nil, []int{1000, 1001}, HandleChangeResnapshot},
+		{"Different account", true, []int{1000, 1001}, nil, []int{1000, 2000}, HandleChangeResnapshot},
+		{"Missing recorded solve", true, []int{1000}, []int{1001}, []int{1000}, HandleChangeResnapshot},
+		{"Empty start snapshot", true, nil, nil, []int{1000}, HandleChangeCarryOver},
+		{"Superset of a throwaway account", true, []int{1000}, nil, history, HandleChangeResnapshot},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := ChooseHandleChangePolicy(test.oldHandleMissing, test.start, test.recorded, test.solved); got != test.expected {
+				t.Errorf("Expected %s, got %s", test.expected, got)
+			}
+		})
+	}
+}
+
+func TestPreviousBaekjoonIDs(t *testing.T) {
+	changes := []HandleChange{
+		{OldBaekjoonID: "first", NewBaekjoonID: "second"},
+		{OldBaekjoonID: "other", NewBaekjoonID: "another"},
+		{OldBaekjoonID: "second", NewBaekjoonID: "third"},
+	}
+
+	previous := PreviousBaekjoonIDs(changes, "third")
+	if len(previous) != 2 || previous[0] != "first" || previous[1] != "second" {
+		t.Errorf("Expected [first second], got %v", previous)
+	}
+	if previous := PreviousBaekjoonIDs(changes, "unchanged"); len(previous) != 0 {
+		t.Errorf("Expected no previous handles, got %v", previous)
+	}
+}
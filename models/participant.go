@@ -12,8 +12,42 @@ type Participant struct {
 	StartTier         int       `firestore:"startTier"`
 	StartRating       int       `firestore:"startRating"`
 	CreatedAt         time.Time `firestore:"createdAt"`
-	StartProblemIDs   []int     `firestore:"startProblemIds"`
+	StartProblemIDs   []int     `firestore:"startProblemIds"` // 레거시: 등록 시점 TOP 100 문제 ID
 	StartProblemCount int       `firestore:"startProblemCount"`
+	// StartSnapshot 등록 시점 전체 해결 문제 집합 (EncodeProblemIDs 형식)
+	StartSnapshot []byte `firestore:"startSnapshot,omitempty"`
+	// DiscordUserID 이 참가자를 등록한 디스코드 사용자 (대회마다 사용자 한 명당 참가자 하나, 비어 있으면 연결 전)
+	DiscordUserID string `firestore:"discordUserId,omitempty"`
+}
+
+// FindParticipantByDiscordUser 디스코드 사용자와 연결된 참가자를 찾습니다
+func FindParticipantByDiscordUser(participants []Participant, discordUserID string) (Participant, bool) {
+	if discordUserID == "" {
+		return Participant{}, false
+	}
+	for _, participant := range participants {
+		if participant.DiscordUserID == discordUserID {
+			return participant, true
+		}
+	}
+	return Participant{}, false
+}
+
+// HasFullSnapshot 등록 시점 전체 해결 문제 스냅샷이 저장되어 있는지 확인합니다
+func (p *Participant) HasFullSnapshot() bool {
+	return len(p.StartSnapshot) > 0
+}
+
+// GetStartProblemIDs 점수 계산에서 제외할 등록 시점 해결 문제 목록을 반환합니다
+// 전체 스냅샷이 없거나 손상된 경우 레거시 TOP 100 목록을 사용합니다
+func (p *Participant) GetStartProblemIDs() []int {
+	if p.HasFullSnapshot() {
+		ids, err := DecodeProblemIDs(p.StartSnapshot)
+		if err == nil {
+			return ids
+		}
+	}
+	return p.StartProblemIDs
 }
 
 type Competition struct {
@@ -24,16 +58,78 @@ type Competition struct {
 	BlackoutStartDate time.Time `firestore:"blackoutStartDate"`
 	IsActive          bool      `firestore:"isActive"`
 	ShowScoreboard    bool      `firestore:"showScoreboard"`
+	// ScoringRules 대회별 점수 규칙 (nil이면 파일 또는 기본 규칙 사용)
+	ScoringRules *ScoringRules `firestore:"scoringRules,omitempty"`
+	// Eligibility 대회별 참가 자격 요건 (nil이면 기본 요건 사용)
+	Eligibility *EligibilityPolicy `firestore:"eligibility,omitempty"`
+	// Type 대회 유형 (비어 있으면 자유 해결)
+	Type string `firestore:"type,omitempty"`
+	// Problems 문제 목록 대회의 채점 대상 문제 (순서대로 스코어보드 열에 표시)
+	Problems []TargetProblem `firestore:"problems,omitempty"`
+	// ChannelID 이 대회를 기본으로 사용하는 디스코드 채널 (일일 스코어보드도 이 채널로 발송)
+	ChannelID string `firestore:"channelId,omitempty"`
+	// RosterSheetID 참가 명단을 자동 동기화할 스프레드시트 ID (비어 있으면 동기화하지 않음)
+	RosterSheetID string `firestore:"rosterSheetId,omitempty"`
+	// ScoreboardTime 일일 스코어보드 발송 시각 "HH:MM" (KST, 비어 있으면 기본 시각)
+	ScoreboardTime string    `firestore:"scoreboardTime,omitempty"`
+	CreatedAt      time.Time `firestore:"createdAt"`
+	// FinalizedAt 최종 결과를 확정하여 보관한 시각 (확정 전에는 zero)
+	FinalizedAt time.Time `firestore:"finalizedAt,omitempty"`
+	// ParticipantCount 확정 시점의 참가자 수 (보관된 대회 목록 표시용)
+	ParticipantCount int `firestore:"participantCount,omitempty"`
+}
+
+// ScoringWindowEnd 점수 인정 구간의 끝 시각을 반환합니다 (종료일 하루 전체 포함)
+func (c *Competition) ScoringWindowEnd() time.Time {
+	return c.EndDate.AddDate(0, 0, 1)
+}
+
+// IsInScoringWindow 주어진 시각이 점수 인정 구간 [StartDate, EndDate] 안에 있는지 확인합니다
+func (c *Competition) IsInScoringWindow(t time.Time) bool {
+	return !t.Before(c.StartDate) && t.Before(c.ScoringWindowEnd())
 }
 
 type ScoreData struct {
-	ParticipantID string  `json:"participant_id"`
-	Name          string  `json:"name"`
-	BaekjoonID    string  `json:"baekjoon_id"`
-	Score         float64 `json:"score"`
-	RawScore      float64 `json:"raw_score"`
-	League        int     `json:"league"`
-	CurrentTier   int     `json:"current_tier"`
-	CurrentRating int     `json:"current_rating"`
-	ProblemCount  int     `json:"problem_count"`
+	ParticipantID string      `json:"participant_id"`
+	Name          string      `json:"name"`
+	BaekjoonID    string      `json:"baekjoon_id"`
+	Score         float64     `json:"score"`
+	RawScore      float64     `json:"raw_score"`
+	League        int         `json:"league"`
+	LeagueName    string      `json:"league_name"`
+	CurrentTier   int         `json:"current_tier"`
+	CurrentRating int         `json:"current_rating"`
+	ProblemCount  int         `json:"problem_count"`
+	Streak        StreakStats `json:"streak"`
+	StartTier     int         `json:"start_tier"`
+	StartRating   int         `json:"start_rating"`
+	Growth        GrowthStats `json:"growth"`
+	// SolvedTargets 문제 목록 대회에서 해결한 대상 문제 번호
+	SolvedTargets []int `json:"solved_targets,omitempty"`
+	// Team 참가자가 속한 팀 이름 (없으면 빈 문자열)
+	Team string `json:"team,omitempty"`
+}
+
+// ScheduleTime 일일 스코어보드 발송 시각을 시/분으로 반환합니다 (지정되지 않았거나 잘못되면 ok=false)
+func (c *Competition) ScheduleTime() (hour, minute int, ok bool) {
+	return ParseScheduleTime(c.ScoreboardTime)
+}
+
+// ParseScheduleTime "HH:MM" 형식의 시각을 해석합니다
+func ParseScheduleTime(value string) (hour, minute int, ok bool) {
+	parsed, err := time.Parse("15:04", value)
+	if err != nil {
+		return 0, 0, false
+	}
+	return parsed.Hour(), parsed.Minute(), true
+}
+
+// CompetitionForChannel 채널에 지정된 대회 ID를 찾습니다 (없으면 빈 문자열 = 기본 대회)
+func CompetitionForChannel(competitions []Competition, channelID string) string {
+	for _, competition := range competitions {
+		if channelID != "" && competition.ChannelID == channelID {
+			return competition.ID
+		}
+	}
+	return ""
 }
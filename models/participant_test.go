@@ -82,6 +82,33 @@ func TestCompetition_Creation(t *testing.T) {
 	}
 }
 
+func TestCompetitionForChannel(t *testing.T) {
+	competitions := []Competition{
+		{ID: "open"},
+		{ID: "freshman", ChannelID: "channel-1"},
+	}
+
+	if id := CompetitionForChannel(competitions, "channel-1"); id != "freshman" {
+		t.Errorf("Expected freshman for channel-1, got %q", id)
+	}
+	if id := CompetitionForChannel(competitions, "channel-2"); id != "" {
+		t.Errorf("Expected default competition for unbound channel, got %q", id)
+	}
+}
+
+func TestCompetition_ScheduleTime(t *testing.T) {
+	competition := Competition{ScoreboardTime: "21:30"}
+	if hour, minute, ok := competition.ScheduleTime(); !ok || hour != 21 || minute != 30 {
+		t.Errorf("Expected 21:30, got %02d:%02d (ok=%v)", hour, minute, ok)
+	}
+
+	for _, value := range []string{"", "24:00", "9시"} {
+		if _, _, ok := ParseScheduleTime(value); ok {
+			t.Errorf("Expected %q to be rejected", value)
+		}
+	}
+}
+
 func TestScoreData_Creation(t *testing.T) {
 	scoreData := ScoreData{
 		ParticipantID: "test-participant-001",
@@ -115,3 +142,20 @@ func TestScoreData_Creation(t *testing.T) {
 		t.Errorf("Expected problem count 25, got %d", scoreData.ProblemCount)
 	}
 }
+
+func TestFindParticipantByDiscordUser(t *testing.T) {
+	participants := []Participant{
+		{BaekjoonID: "alice", DiscordUserID: "1"},
+		{BaekjoonID: "bob"},
+	}
+
+	if participant, ok := FindParticipantByDiscordUser(participants, "1"); !ok || participant.BaekjoonID != "alice" {
+		t.Errorf("Expected alice for user 1, got %+v", participant)
+	}
+	if _, ok := FindParticipantByDiscordUser(participants, ""); ok {
+		t.Error("Expected empty user ID not to match unlinked participants")
+	}
+	if _, ok := FindParticipantByDiscordUser(participants, "2"); ok {
+		t.Error("Expected unknown user not to match")
+	}
+}
@@ -0,0 +1,145 @@
+package models
+
+import (
+	"sort"
+	"time"
+)
+
+// Capability 관리 명령어 실행에 필요한 권한 이름입니다
+type Capability string
+
+// 역할/사용자에게 부여할 수 있는 권한 (서버 소유자와 관리자 역할은 항상 모든 권한을 가짐)
+const (
+	CapabilityManageCompetition    Capability = "manage_competition"     // 대회·시즌·팀·참가 명단 관리, 참가자 목록·감사 로그 조회
+	CapabilityRemoveParticipant    Capability = "remove_participant"     // 참가자 삭제
+	CapabilityViewCache            Capability = "view_cache"             // API 캐시 통계 조회
+	CapabilityViewHiddenScoreboard Capability = "view_hidden_scoreboard" // 스코어보드 생성, 블랙아웃 중 점수 조회
+)
+
+// Capabilities 설정 가능한 권한 목록 (표시 순서)
+var Capabilities = []Capability{
+	CapabilityManageCompetition,
+	CapabilityRemoveParticipant,
+	CapabilityViewCache,
+	CapabilityViewHiddenScoreboard,
+}
+
+// ParseCapability 권한 이름을 확인합니다
+func ParseCapability(name string) (Capability, bool) {
+	for _, capability := range Capabilities {
+		if string(capability) == name {
+			return capability, true
+		}
+	}
+	return "", false
+}
+
+// 권한을 부여받는 대상 종류
+const (
+	PermissionTargetRole = "role"
+	PermissionTargetUser = "user"
+)
+
+// GuildPermissions 서버별 권한 설정입니다 (key: 권한 이름 → 허용된 Discord 역할/사용자 ID 목록)
+type GuildPermissions struct {
+	GuildID   string              `firestore:"-"`
+	Roles     map[string][]string `firestore:"roles"`
+	Users     map[string][]string `firestore:"users"`
+	UpdatedAt time.Time           `firestore:"updatedAt"`
+}
+
+// NewGuildPermissions 아무 권한도 부여되지 않은 서버 권한 설정을 만듭니다
+func NewGuildPermissions(guildID string) *GuildPermissions {
+	return &GuildPermissions{
+		GuildID: guildID,
+		Roles:   make(map[string][]string),
+		Users:   make(map[string][]string),
+	}
+}
+
+// Allows 사용자 ID 또는 역할 중 하나라도 권한을 부여받았는지 확인합니다
+func (p *GuildPermissions) Allows(capability Capability, userID string, roleIDs []string) bool {
+	if p == nil {
+		return false
+	}
+	if containsID(p.Users[string(capability)], userID) {
+		return true
+	}
+	for _, roleID := range roleIDs {
+		if containsID(p.Roles[string(capability)], roleID) {
+			return true
+		}
+	}
+	return false
+}
+
+// Grant 대상에게 권한을 부여합니다 (이미 부여되어 있으면 false)
+func (p *GuildPermissions) Grant(capability Capability, target, id string) bool {
+	grants := p.grants(target)
+	if grants == nil || containsID(grants[string(capability)], id) {
+		return false
+	}
+	ids := append(grants[string(capability)], id)
+	sort.Strings(ids)
+	grants[string(capability)] = ids
+	return true
+}
+
+// Revoke 대상의 권한을 회수합니다 (부여되어 있지 않았으면 false)
+func (p *GuildPermissions) Revoke(capability Capability, target, id string) bool {
+	grants := p.grants(target)
+	if grants == nil {
+		return false
+	}
+	ids := grants[string(capability)]
+	for i, existing := range ids {
+		if existing == id {
+			remaining := append(ids[:i:i], ids[i+1:]...)
+			if len(remaining) == 0 {
+				delete(grants, string(capability))
+			} else {
+				grants[string(capability)] = remaining
+			}
+			return true
+		}
+	}
+	return false
+}
+
+// RoleIDs 권한을 부여받은 역할 ID 목록
+func (p *GuildPermissions) RoleIDs(capability Capability) []string {
+	return p.Roles[string(capability)]
+}
+
+// UserIDs 권한을 부여받은 사용자 ID 목록
+func (p *GuildPermissions) UserIDs(capability Capability) []string {
+	return p.Users[string(capability)]
+}
+
+// grants 대상 종류에 맞는 권한 맵을 반환합니다 (필요하면 초기화)
+func (p *GuildPermissions) grants(target string) map[string][]string {
+	switch target {
+	case PermissionTargetRole:
+		if p.Roles == nil {
+			p.Roles = make(map[string][]string)
+		}
+		return p.Roles
+	case PermissionTargetUser:
+		if p.Users == nil {
+			p.Users = make(map[string][]string)
+		}
+		return p.Users
+	default:
+		return nil
+	}
+}
+
+// containsID ID 목록에 값이 있는지 확인합니다
+func containsID(ids []string, id string) bool {
+	for _, existing := range ids {
+		if existing == id {
+			return true
+		}
+	}
+	return false
+}
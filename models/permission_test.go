@@ -0,0 +1,72 @@
+package models
+
+import "testing"
+
+func TestGuildPermissionsAllows(t *testing.T) {
+	permissions := NewGuildPermissions("guild")
+	permissions.Grant(CapabilityManageCompetition, PermissionTargetRole, "staff")
+	permissions.Grant(CapabilityViewCache, PermissionTargetUser, "alice")
+
+	tests := []struct {
+		name       string
+		capability Capability
+		userID     string
+		roles      []string
+		expected   bool
+	}{
+		{"Role grant", CapabilityManageCompetition, "bob", []string{"member", "staff"}, true},
+		{"Role without grant", CapabilityManageCompetition, "bob", []string{"member"}, false},
+		{"User grant", CapabilityViewCache, "alice", nil, true},
+		{"Other capability", CapabilityRemoveParticipant, "alice", []string{"staff"}, false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := permissions.Allows(test.capability, test.userID, test.roles); got != test.expected {
+				t.Errorf("Expected %t, got %t", test.expected, got)
+			}
+		})
+	}
+
+	var empty *GuildPermissions
+	if empty.Allows(CapabilityViewCache, "alice", nil) {
+		t.Error("Expected nil permissions to allow nothing")
+	}
+}
+
+func TestGuildPermissionsGrantRevoke(t *testing.T) {
+	permissions := &GuildPermissions{GuildID: "guild"}
+
+	if !permissions.Grant(CapabilityViewCache, PermissionTargetRole, "b") || !permissions.Grant(CapabilityViewCache, PermissionTargetRole, "a") {
+		t.Fatal("Expected new grants to succeed")
+	}
+	if permissions.Grant(CapabilityViewCache, PermissionTargetRole, "a") {
+		t.Error("Expected duplicate grant to be rejected")
+	}
+	if permissions.Grant(CapabilityViewCache, "channel", "a") {
+		t.Error("Expected unknown target to be rejected")
+	}
+	if ids := permissions.RoleIDs(CapabilityViewCache); len(ids) != 2 || ids[0] != "a" || ids[1] != "b" {
+		t.Errorf("Expected sorted role IDs [a b], got %v", ids)
+	}
+
+	if !permissions.Revoke(CapabilityViewCache, PermissionTargetRole, "a") {
+		t.Error("Expected revoke to succeed")
+	}
+	if permissions.Revoke(CapabilityViewCache, PermissionTargetRole, "a") {
+		t.Error("Expected second revoke to report nothing removed")
+	}
+	permissions.Revoke(CapabilityViewCache, PermissionTargetRole, "b")
+	if _, exists := permissions.Roles[string(CapabilityViewCache)]; exists {
+		t.Error("Expected empty capability entry to be removed")
+	}
+}
+
+func TestParseCapability(t *testing.T) {
+	if capability, ok := ParseCapability("view_cache"); !ok || capability != CapabilityViewCache {
+		t.Errorf("Expected view_cache to parse, got %q %t", capability, ok)
+	}
+	if _, ok := ParseCapability("admin"); ok {
+		t.Error("Expected unknown capability to be rejected")
+	}
+}
@@ -0,0 +1,61 @@
+package models
+
+import (
+	"encoding/binary"
+	"fmt"
+	"sort"
+)
+
+// problemIDCodecV1 델타 + uvarint 인코딩 형식 버전
+const problemIDCodecV1 byte = 1
+
+// EncodeProblemIDs 문제 번호 목록을 정렬·중복 제거 후 델타 + uvarint 형식으로 압축합니다
+// 첫 바이트는 형식 버전이므로 빈 목록도 nil이 아닌 1바이트 값으로 인코딩됩니다
+func EncodeProblemIDs(ids []int) []byte {
+	sorted := make([]int, 0, len(ids))
+	for _, id := range ids {
+		if id > 0 {
+			sorted = append(sorted, id)
+		}
+	}
+	sort.Ints(sorted)
+
+	// 대부분 델타가 1~2바이트에 들어가므로 문제 수의 2배로 미리 할당
+	encoded := make([]byte, 1, 1+len(sorted)*2)
+	encoded[0] = problemIDCodecV1
+
+	var buf [binary.MaxVarintLen64]byte
+	previous := 0
+	for _, id := range sorted {
+		if id == previous {
+			continue
+		}
+		n := binary.PutUvarint(buf[:], uint64(id-previous))
+		encoded = append(encoded, buf[:n]...)
+		previous = id
+	}
+	return encoded
+}
+
+// DecodeProblemIDs EncodeProblemIDs로 압축된 데이터를 오름차순 문제 번호 목록으로 복원합니다
+func DecodeProblemIDs(data []byte) ([]int, error) {
+	if len(data) == 0 {
+		return nil, fmt.Errorf("empty problem set data")
+	}
+	if data[0] != problemIDCodecV1 {
+		return nil, fmt.Errorf("unsupported problem set format: %d", data[0])
+	}
+
+	ids := make([]int, 0, len(data))
+	previous := 0
+	for offset := 1; offset < len(data); {
+		delta, n := binary.Uvarint(data[offset:])
+		if n <= 0 {
+			return nil, fmt.Errorf("corrupted problem set data at offset %d", offset)
+		}
+		previous += int(delta)
+		ids = append(ids, previous)
+		offset += n
+	}
+	return ids, nil
+}
@@ -0,0 +1,66 @@
+package models
+
+import (
+	"testing"
+)
+
+func TestEncodeDecodeProblemIDs(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    []int
+		expected []int
+	}{
+		{"Empty set", []int{}, []int{}},
+		{"Sorted input", []int{1000, 1001, 1002}, []int{1000, 1001, 1002}},
+		{"Unsorted input with duplicates", []int{30000, 1000, 2557, 1000}, []int{1000, 2557, 30000}},
+		{"Invalid IDs are dropped", []int{0, -1, 1000}, []int{1000}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			encoded := EncodeProblemIDs(test.input)
+			if len(encoded) == 0 {
+				t.Fatal("Encoded data should never be empty")
+			}
+
+			decoded, err := DecodeProblemIDs(encoded)
+			if err != nil {
+				t.Fatalf("Expected no error, got: %v", err)
+			}
+
+			if len(decoded) != len(test.expected) {
+				t.Fatalf("Expected %d IDs, got %d", len(test.expected), len(decoded))
+			}
+			for i := range decoded {
+				if decoded[i] != test.expected[i] {
+					t.Errorf("Expected ID %d at index %d, got %d", test.expected[i], i, decoded[i])
+				}
+			}
+		})
+	}
+}
+
+func TestEncodeProblemIDs_Compact(t *testing.T) {
+	// 연속된 1000문제는 델타가 1이므로 문제당 1바이트로 저장되어야 합니다
+	ids := make([]int, 1000)
+	for i := range ids {
+		ids[i] = 1000 + i
+	}
+
+	encoded := EncodeProblemIDs(ids)
+	if len(encoded) > 1+2+len(ids) {
+		t.Errorf("Expected compact encoding, got %d bytes for %d IDs", len(encoded), len(ids))
+	}
+}
+
+func TestDecodeProblemIDs_Invalid(t *testing.T) {
+	if _, err := DecodeProblemIDs(nil); err == nil {
+		t.Error("Expected error for empty data")
+	}
+	if _, err := DecodeProblemIDs([]byte{99, 1}); err == nil {
+		t.Error("Expected error for unknown format version")
+	}
+	if _, err := DecodeProblemIDs([]byte{problemIDCodecV1, 0x80}); err == nil {
+		t.Error("Expected error for truncated varint")
+	}
+}
@@ -0,0 +1,60 @@
+package models
+
+import "fmt"
+
+// 대회 유형
+const (
+	CompetitionTypeOpen       = "open"        // 자유 해결 (기본)
+	CompetitionTypeProblemSet = "problem_set" // 지정된 문제 목록만 채점
+)
+
+// TargetProblem 문제 목록 대회에서 채점 대상이 되는 문제입니다
+type TargetProblem struct {
+	ProblemID int     `json:"problemId" firestore:"problemId"`
+	Label     string  `json:"label" firestore:"label"` // 스코어보드 열 이름 (A, B, ...)
+	Points    float64 `json:"points" firestore:"points"`
+}
+
+// IsProblemSet 지정된 문제 목록으로 채점하는 대회인지 확인합니다
+func (c *Competition) IsProblemSet() bool {
+	return c.Type == CompetitionTypeProblemSet && len(c.Problems) > 0
+}
+
+// TargetProblemMap 문제 번호별 채점 대상 문제를 반환합니다 (문제 목록 대회가 아니면 nil)
+func (c *Competition) TargetProblemMap() map[int]TargetProblem {
+	if !c.IsProblemSet() {
+		return nil
+	}
+	targets := make(map[int]TargetProblem, len(c.Problems))
+	for _, problem := range c.Problems {
+		targets[problem.ProblemID] = problem
+	}
+	return targets
+}
+
+// ProblemLabel 0부터 시작하는 순서를 스코어보드 열 이름으로 변환합니다 (A~Z, AA~AZ, ...)
+func ProblemLabel(index int) string {
+	const letters = "ABCDEFGHIJKLMNOPQRSTUVWXYZ"
+	if index < len(letters) {
+		return string(letters[index])
+	}
+	return fmt.Sprintf("%c%c", letters[index/len(letters)-1], letters[index%len(letters)])
+}
+
+// NewTargetProblems 문제 번호와 배점으로 순서대로 열 이름을 붙인 문제 목록을 만듭니다 (중복 제거)
+func NewTargetProblems(problemIDs []int, points []float64) []TargetProblem {
+	seen := make(map[int]bool, len(problemIDs))
+	problems := make([]TargetProblem, 0, len(problemIDs))
+	for i, id := range problemIDs {
+		if id <= 0 || seen[id] {
+			continue
+		}
+		seen[id] = true
+		problems = append(problems, TargetProblem{
+			ProblemID: id,
+			Label:     ProblemLabel(len(problems)),
+			Points:    points[i],
+		})
+	}
+	return problems
+}
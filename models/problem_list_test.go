@@ -0,0 +1,51 @@
+package models
+
+import "testing"
+
+func TestProblemLabel(t *testing.T) {
+	tests := []struct {
+		index    int
+		expected string
+	}{
+		{0, "A"},
+		{25, "Z"},
+		{26, "AA"},
+		{51, "AZ"},
+		{52, "BA"},
+	}
+
+	for _, test := range tests {
+		if got := ProblemLabel(test.index); got != test.expected {
+			t.Errorf("ProblemLabel(%d) = %s, expected %s", test.index, got, test.expected)
+		}
+	}
+}
+
+func TestNewTargetProblems(t *testing.T) {
+	problems := NewTargetProblems([]int{1000, 1000, 0, 2557}, []float64{1, 5, 1, 3})
+
+	if len(problems) != 2 {
+		t.Fatalf("Expected 2 problems after dedupe, got %d", len(problems))
+	}
+	if problems[0].ProblemID != 1000 || problems[0].Label != "A" || problems[0].Points != 1 {
+		t.Errorf("Unexpected first problem: %+v", problems[0])
+	}
+	if problems[1].ProblemID != 2557 || problems[1].Label != "B" || problems[1].Points != 3 {
+		t.Errorf("Unexpected second problem: %+v", problems[1])
+	}
+}
+
+func TestCompetition_IsProblemSet(t *testing.T) {
+	competition := &Competition{Type: CompetitionTypeProblemSet}
+	if competition.IsProblemSet() || competition.TargetProblemMap() != nil {
+		t.Error("Problem set without problems should be treated as open")
+	}
+
+	competition.Problems = NewTargetProblems([]int{1000}, []float64{2})
+	if !competition.IsProblemSet() {
+		t.Error("Expected problem set competition")
+	}
+	if target, ok := competition.TargetProblemMap()[1000]; !ok || target.Points != 2 {
+		t.Errorf("Expected target 1000 with 2 points, got %+v", target)
+	}
+}
@@ -0,0 +1,29 @@
+package models
+
+import (
+	"strings"
+	"time"
+)
+
+// RegistrationChallenge 등록 본인 확인을 위해 발급한 일회용 토큰입니다 (solved.ac 자기소개에 토큰을 넣으면 등록 완료)
+type RegistrationChallenge struct {
+	DiscordUserID  string `firestore:"-"`
+	Name           string `firestore:"name"`
+	BaekjoonID     string `firestore:"baekjoonId"`
+	OrganizationID int    `firestore:"organizationId"`
+	// ReplacesBaekjoonID 백준 ID 변경 신청이면 기존 백준 ID (비어 있으면 신규 등록)
+	ReplacesBaekjoonID string    `firestore:"replacesBaekjoonId,omitempty"`
+	Token              string    `firestore:"token"`
+	CreatedAt          time.Time `firestore:"createdAt"`
+	ExpiresAt          time.Time `firestore:"expiresAt"`
+}
+
+// IsExpired 토큰 유효 시간이 지났는지 확인합니다
+func (c *RegistrationChallenge) IsExpired(now time.Time) bool {
+	return !now.Before(c.ExpiresAt)
+}
+
+// IsSatisfiedBy solved.ac 자기소개에 토큰이 들어 있는지 확인합니다
+func (c *RegistrationChallenge) IsSatisfiedBy(bio string) bool {
+	return c.Token != "" && strings.Contains(bio, c.Token)
+}
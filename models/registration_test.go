@@ -0,0 +1,46 @@
+package models
+
+import (
+	"testing"
+	"time"
+)
+
+func TestRegistrationChallengeIsExpired(t *testing.T) {
+	now := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+	challenge := RegistrationChallenge{CreatedAt: now, ExpiresAt: now.Add(30 * time.Minute)}
+
+	if challenge.IsExpired(now.Add(29 * time.Minute)) {
+		t.Error("Expected challenge to be valid before expiry")
+	}
+	if !challenge.IsExpired(now.Add(30 * time.Minute)) {
+		t.Error("Expected challenge to expire at expiry time")
+	}
+}
+
+func TestRegistrationChallengeIsSatisfiedBy(t *testing.T) {
+	challenge := RegistrationChallenge{Token: "kkemi-1a2b3c"}
+
+	tests := []struct {
+		name     string
+		bio      string
+		expected bool
+	}{
+		{"Token only", "kkemi-1a2b3c", true},
+		{"Token inside bio", "숭실대 알고리즘 kkemi-1a2b3c 화이팅", true},
+		{"Different token", "kkemi-ffffff", false},
+		{"Empty bio", "", false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := challenge.IsSatisfiedBy(test.bio); got != test.expected {
+				t.Errorf("Expected %t, got %t", test.expected, got)
+			}
+		})
+	}
+
+	empty := RegistrationChallenge{}
+	if empty.IsSatisfiedBy("anything") {
+		t.Error("Expected challenge without token to never be satisfied")
+	}
+}
@@ -0,0 +1,136 @@
+package models
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"time"
+)
+
+// 참가 명단 항목의 출처
+const (
+	RosterSourceManual = "manual" // 관리자가 직접 추가
+	RosterSourceCSV    = "csv"    // CSV 가져오기
+	RosterSourceSheets = "sheets" // 스프레드시트 동기화 (동기화 때 시트에서 빠지면 함께 삭제)
+)
+
+// RosterEntry 대회 참가 명단의 한 사람입니다 (정규화한 이름이 키)
+type RosterEntry struct {
+	Name    string    `firestore:"name"`
+	Source  string    `firestore:"source"`
+	AddedBy string    `firestore:"addedBy,omitempty"`
+	AddedAt time.Time `firestore:"addedAt"`
+}
+
+// Key 명단에서 항목을 구분하는 정규화된 이름을 반환합니다
+func (e RosterEntry) Key() string {
+	return NormalizeRosterName(e.Name)
+}
+
+// RosterDiff 명단 변경 내역입니다
+type RosterDiff struct {
+	Added   []string
+	Removed []string
+}
+
+// IsEmpty 변경 사항이 없는지 확인합니다
+func (d RosterDiff) IsEmpty() bool {
+	return len(d.Added) == 0 && len(d.Removed) == 0
+}
+
+// NormalizeRosterName 이름 비교를 위한 정규화 (공백 제거, 소문자 변환)
+func NormalizeRosterName(name string) string {
+	return strings.ReplaceAll(strings.ToLower(strings.TrimSpace(name)), " ", "")
+}
+
+// RosterContains 명단에 이름이 있는지 확인합니다
+func RosterContains(entries []RosterEntry, name string) bool {
+	key := NormalizeRosterName(name)
+	if key == "" {
+		return false
+	}
+	for _, entry := range entries {
+		if entry.Key() == key {
+			return true
+		}
+	}
+	return false
+}
+
+// DiffRosterSource 한 출처에서 가져온 이름 목록과 현재 명단을 비교합니다.
+// 명단에 없는 이름은 추가하고, 같은 출처의 항목 중 목록에서 빠진 이름은 삭제합니다 (다른 출처의 항목은 건드리지 않음).
+func DiffRosterSource(entries []RosterEntry, source string, names []string) RosterDiff {
+	existing := make(map[string]RosterEntry, len(entries))
+	for _, entry := range entries {
+		existing[entry.Key()] = entry
+	}
+
+	var diff RosterDiff
+	incoming := make(map[string]bool, len(names))
+	for _, name := range names {
+		key := NormalizeRosterName(name)
+		if key == "" || incoming[key] {
+			continue
+		}
+		incoming[key] = true
+		if _, ok := existing[key]; !ok {
+			diff.Added = append(diff.Added, strings.TrimSpace(name))
+		}
+	}
+	for key, entry := range existing {
+		if entry.Source == source && !incoming[key] {
+			diff.Removed = append(diff.Removed, entry.Name)
+		}
+	}
+	sort.Strings(diff.Removed)
+	return diff
+}
+
+// ParseRosterCSV CSV에서 이름 목록을 읽습니다.
+// 첫 행에 "이름" 또는 "name" 열이 있으면 그 열을, 없으면 첫 번째 열을 이름으로 사용합니다.
+func ParseRosterCSV(data string) ([]string, error) {
+	reader := csv.NewReader(strings.NewReader(data))
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	var names []string
+	column := 0
+	for row := 0; ; row++ {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("CSV %d번째 줄을 읽을 수 없습니다: %w", row+1, err)
+		}
+		if row == 0 {
+			if header, ok := rosterNameColumn(record); ok {
+				column = header
+				continue
+			}
+		}
+		if column < len(record) {
+			if name := strings.TrimSpace(record[column]); name != "" {
+				names = append(names, name)
+			}
+		}
+	}
+
+	if len(names) == 0 {
+		return nil, fmt.Errorf("CSV에서 이름을 찾을 수 없습니다")
+	}
+	return names, nil
+}
+
+// rosterNameColumn 헤더 행에서 이름 열의 위치를 찾습니다
+func rosterNameColumn(header []string) (int, bool) {
+	for i, cell := range header {
+		cell = strings.ToLower(strings.TrimSpace(cell))
+		if strings.Contains(cell, "이름") || cell == "name" {
+			return i, true
+		}
+	}
+	return 0, false
+}
@@ -0,0 +1,96 @@
+package models
+
+import "testing"
+
+func TestNormalizeRosterName(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+		desc     string
+	}{
+		{"홍길동", "홍길동", "일반적인 한국 이름"},
+		{" 홍길동 ", "홍길동", "앞뒤 공백 제거"},
+		{"홍 길 동", "홍길동", "중간 공백 제거"},
+		{"John Doe", "johndoe", "영어 이름 소문자 변환 및 공백 제거"},
+		{"  JANE  SMITH  ", "janesmith", "복합 공백 및 대소문자 처리"},
+		{"김철수123", "김철수123", "숫자가 포함된 이름"},
+		{"", "", "빈 문자열"},
+	}
+
+	for _, test := range tests {
+		if result := NormalizeRosterName(test.input); result != test.expected {
+			t.Errorf("NormalizeRosterName(%q) = %q, expected %q (%s)", test.input, result, test.expected, test.desc)
+		}
+	}
+}
+
+func TestRosterContains(t *testing.T) {
+	entries := []RosterEntry{{Name: "공서연"}, {Name: "John Doe"}}
+
+	tests := map[string]bool{
+		"공서연":      true,
+		"공 서 연":    true,
+		"john doe": true,
+		"이정안":      false,
+		"":         false,
+	}
+	for name, expected := range tests {
+		if got := RosterContains(entries, name); got != expected {
+			t.Errorf("RosterContains(%q) = %t, expected %t", name, got, expected)
+		}
+	}
+}
+
+func TestDiffRosterSource(t *testing.T) {
+	entries := []RosterEntry{
+		{Name: "김철수", Source: RosterSourceSheets},
+		{Name: "이영희", Source: RosterSourceSheets},
+		{Name: "박민수", Source: RosterSourceManual},
+	}
+
+	diff := DiffRosterSource(entries, RosterSourceSheets, []string{"김철수", "최지우", "최 지우", "박민수"})
+	if len(diff.Added) != 1 || diff.Added[0] != "최지우" {
+		t.Errorf("Expected only 최지우 to be added once, got %v", diff.Added)
+	}
+	if len(diff.Removed) != 1 || diff.Removed[0] != "이영희" {
+		t.Errorf("Expected only the missing sheets entry to be removed, got %v", diff.Removed)
+	}
+
+	// 다른 출처의 항목은 목록에 없어도 삭제하지 않습니다
+	if diff := DiffRosterSource(entries, RosterSourceSheets, []string{"김철수", "이영희"}); !diff.IsEmpty() {
+		t.Errorf("Expected no change, got %+v", diff)
+	}
+}
+
+func TestParseRosterCSV(t *testing.T) {
+	tests := []struct {
+		name     string
+		data     string
+		expected []string
+		wantErr  bool
+	}{
+		{"Header with name column", "학번,이름,학과\n2024001,홍길동,컴퓨터\n2024002, 김철수 ,전자\n", []string{"홍길동", "김철수"}, false},
+		{"English header", "Name,Email\nJohn Doe,a@b.c\n", []string{"John Doe"}, false},
+		{"No header", "홍길동\n김철수\n\n", []string{"홍길동", "김철수"}, false},
+		{"Short rows", "번호,이름\n1\n2,이영희\n", []string{"이영희"}, false},
+		{"Header only", "이름\n", nil, true},
+		{"Broken quote", "이름\n\"홍길동\n", nil, true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			names, err := ParseRosterCSV(test.data)
+			if (err != nil) != test.wantErr {
+				t.Fatalf("Expected error=%t, got %v", test.wantErr, err)
+			}
+			if len(names) != len(test.expected) {
+				t.Fatalf("Expected %v, got %v", test.expected, names)
+			}
+			for i := range names {
+				if names[i] != test.expected[i] {
+					t.Errorf("Expected %v, got %v", test.expected, names)
+				}
+			}
+		})
+	}
+}
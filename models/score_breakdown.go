@@ -0,0 +1,89 @@
+package models
+
+// 문제 분류 (등록 티어 대비 문제 난이도)
+const (
+	ProblemCategoryChallenge = "challenge" // 도전: 등록 티어보다 높은 문제
+	ProblemCategoryBase      = "base"      // 기본: 등록 티어와 같은 문제
+	ProblemCategoryPractice  = "practice"  // 연습: 등록 티어보다 낮은 문제
+)
+
+// BreakdownEntry 점수에 반영된 문제 하나의 계산 내역입니다
+type BreakdownEntry struct {
+	ProblemID  int     `json:"problem_id"`
+	Label      string  `json:"label,omitempty"` // 문제 목록 대회의 열 이름
+	Level      int     `json:"level"`
+	Category   string  `json:"category"`
+	BasePoints float64 `json:"base_points"`
+	Weight     float64 `json:"weight"`
+	// 태그/클래스 보너스 (적용되지 않았으면 Bonus는 비어 있고 배율은 1)
+	Bonus           string  `json:"bonus,omitempty"`
+	BonusMultiplier float64 `json:"bonus_multiplier"`
+	Contribution    float64 `json:"contribution"`
+}
+
+// ScoreBreakdown 참가자 점수가 어떻게 계산되었는지 보여주는 구조화된 내역입니다
+type ScoreBreakdown struct {
+	BaekjoonID string           `json:"baekjoon_id"`
+	League     int              `json:"league"`
+	LeagueName string           `json:"league_name"`
+	Mode       string           `json:"mode"`
+	Entries    []BreakdownEntry `json:"entries"` // 기여 점수 내림차순
+	RawTotal   float64          `json:"raw_total"`
+	Total      float64          `json:"total"` // 반올림된 최종 점수
+	// 점수에서 제외된 문제 요약
+	ExcludedPreRegistration int `json:"excluded_pre_registration"` // 등록 시점에 이미 해결한 문제
+	ExcludedOutsideWindow   int `json:"excluded_outside_window"`   // 대회 기간 밖에서 처음 발견된 문제
+	ExcludedUnranked        int `json:"excluded_unranked"`         // 난이도가 없는 문제
+	ExcludedNotInSet        int `json:"excluded_not_in_set"`       // 문제 목록 대회에서 목록에 없는 문제
+	// 연속 해결 기록 (보너스는 Total에 포함)
+	Streak StreakStats `json:"streak"`
+	// 레이팅 성장 (보너스는 Total에 포함)
+	Growth GrowthStats `json:"growth"`
+}
+
+// CategoryLabel 문제 분류의 표시 이름을 반환합니다
+func CategoryLabel(category string) string {
+	switch category {
+	case ProblemCategoryChallenge:
+		return "도전"
+	case ProblemCategoryBase:
+		return "기본"
+	case ProblemCategoryPractice:
+		return "연습"
+	default:
+		return "기타"
+	}
+}
+
+// ProblemCategory 등록 티어와 문제 레벨을 비교하여 분류합니다
+func ProblemCategory(problemLevel, startTier int) string {
+	if problemLevel > startTier {
+		return ProblemCategoryChallenge
+	} else if problemLevel == startTier {
+		return ProblemCategoryBase
+	}
+	return ProblemCategoryPractice
+}
+
+// CategoryTotals 분류별 문제 수와 기여 점수 합계를 반환합니다
+func (b *ScoreBreakdown) CategoryTotals() (counts map[string]int, points map[string]float64) {
+	counts = make(map[string]int)
+	points = make(map[string]float64)
+	for _, entry := range b.Entries {
+		counts[entry.Category]++
+		points[entry.Category] += entry.Contribution
+	}
+	return counts, points
+}
+
+// BonusTotals 보너스가 적용된 문제 수와 보너스로 늘어난 점수 합계를 반환합니다
+func (b *ScoreBreakdown) BonusTotals() (count int, extra float64) {
+	for _, entry := range b.Entries {
+		if entry.Bonus == "" || entry.BonusMultiplier == 0 {
+			continue
+		}
+		count++
+		extra += entry.Contribution - entry.Contribution/entry.BonusMultiplier
+	}
+	return count, extra
+}
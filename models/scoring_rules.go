@@ -0,0 +1,463 @@
+package models
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/ssugameworks/kkemi/constants"
+
+	"gopkg.in/yaml.v3"
+)
+
+// maxRuleTier 규칙에서 다룰 수 있는 최대 티어 (31 이상은 마스터로 통일)
+const maxRuleTier = 31
+
+// LeagueRule 리그 하나의 등록 티어 범위와 가중치를 정의합니다
+type LeagueRule struct {
+	ID              int     `json:"id" firestore:"id"`
+	Name            string  `json:"name" firestore:"name"`
+	MinTier         int     `json:"minTier" firestore:"minTier"`
+	MaxTier         int     `json:"maxTier" firestore:"maxTier"`
+	UpperMultiplier float64 `json:"upperMultiplier" firestore:"upperMultiplier"` // 등록 티어보다 높은 문제
+	SameMultiplier  float64 `json:"sameMultiplier" firestore:"sameMultiplier"`   // 등록 티어와 같은 문제
+	LowerMultiplier float64 `json:"lowerMultiplier" firestore:"lowerMultiplier"` // 등록 티어보다 낮은 문제
+}
+
+// BonusRule 알고리즘 태그 또는 solved.ac 클래스에 걸린 보너스 배율입니다 (Tag와 Class 중 하나만 지정)
+type BonusRule struct {
+	Tag        string  `json:"tag,omitempty" firestore:"tag,omitempty"`     // solved.ac 태그 키 (예: "dp", "graphs")
+	Class      int     `json:"class,omitempty" firestore:"class,omitempty"` // solved.ac 클래스 (1~10)
+	Multiplier float64 `json:"multiplier" firestore:"multiplier"`
+}
+
+// 점수 산정 방식
+const (
+	ScoringModeLevel      = "level"       // solved.ac 문제 레벨 값을 그대로 사용
+	ScoringModeTierPoints = "tier_points" // TierManager의 티어별 기본 점수 사용
+	ScoringModeCustom     = "custom"      // 규칙에 정의된 TierPoints 점수표 사용
+)
+
+// ScoringRules 대회별 점수 산정 규칙입니다
+type ScoringRules struct {
+	Name    string       `json:"name" firestore:"name"`
+	Leagues []LeagueRule `json:"leagues" firestore:"leagues"`
+	// Mode 기본 점수 산정 방식 (비어 있으면 TierPoints 유무로 custom/level 결정)
+	Mode string `json:"mode,omitempty" firestore:"mode,omitempty"`
+	// TierPoints 문제 레벨별 기본 점수 (키: 레벨 문자열, custom 방식에서 사용)
+	TierPoints map[string]float64 `json:"tierPoints,omitempty" firestore:"tierPoints,omitempty"`
+	// Bonuses 태그/클래스별 보너스 배율 (여러 개가 일치하면 가장 큰 배율 하나만 적용)
+	Bonuses []BonusRule `json:"bonuses,omitempty" firestore:"bonuses,omitempty"`
+	// StreakBonuses 최장 연속 해결 일수 단계별 보너스 (도달한 단계의 점수를 모두 더함)
+	StreakBonuses []StreakBonus `json:"streakBonuses,omitempty" firestore:"streakBonuses,omitempty"`
+	// Growth 레이팅 성장 점수 (없으면 미적용)
+	Growth *GrowthRule `json:"growth,omitempty" firestore:"growth,omitempty"`
+	// Team 팀 점수 집계 방식 (없으면 합계)
+	Team *TeamRule `json:"team,omitempty" firestore:"team,omitempty"`
+}
+
+// IsValidScoringMode 지원하는 점수 산정 방식인지 확인합니다
+func IsValidScoringMode(mode string) bool {
+	switch mode {
+	case ScoringModeLevel, ScoringModeTierPoints, ScoringModeCustom:
+		return true
+	default:
+		return false
+	}
+}
+
+// ScoringModeLabel 점수 산정 방식의 표시 이름을 반환합니다
+func ScoringModeLabel(mode string) string {
+	switch mode {
+	case ScoringModeLevel:
+		return "문제 레벨"
+	case ScoringModeTierPoints:
+		return "티어 기본 점수"
+	case ScoringModeCustom:
+		return "사용자 정의 점수표"
+	default:
+		return "알 수 없음"
+	}
+}
+
+// DefaultScoringRules 기존 루키/프로/마스터 규칙을 그대로 담은 기본 규칙을 반환합니다
+func DefaultScoringRules() *ScoringRules {
+	return &ScoringRules{
+		Name: "기본",
+		Leagues: []LeagueRule{
+			{
+				ID: constants.LeagueRookie, Name: "루키", MinTier: 0, MaxTier: 6,
+				UpperMultiplier: constants.RookieUpperMultiplier,
+				SameMultiplier:  constants.RookieBaseMultiplier,
+				LowerMultiplier: constants.RookieLowerMultiplier,
+			},
+			{
+				ID: constants.LeaguePro, Name: "프로", MinTier: 7, MaxTier: 11,
+				UpperMultiplier: constants.ProUpperMultiplier,
+				SameMultiplier:  constants.ProBaseMultiplier,
+				LowerMultiplier: constants.ProLowerMultiplier,
+			},
+			{
+				ID: constants.LeagueMaster, Name: "마스터", MinTier: 12, MaxTier: maxRuleTier,
+				UpperMultiplier: constants.MasterUpperMultiplier,
+				SameMultiplier:  constants.MasterBaseMultiplier,
+				LowerMultiplier: constants.MasterLowerMultiplier,
+			},
+		},
+	}
+}
+
+// TierPointsFromTierManager TierManager의 티어별 기본 점수로 점수표를 만듭니다
+func TierPointsFromTierManager(tm *TierManager) map[string]float64 {
+	points := make(map[string]float64, maxRuleTier+1)
+	for tier := 0; tier <= maxRuleTier; tier++ {
+		points[strconv.Itoa(tier)] = float64(tm.GetTierPoints(tier))
+	}
+	return points
+}
+
+// ParseScoringRules JSON 규칙을 파싱하고 검증합니다
+func ParseScoringRules(data []byte) (*ScoringRules, error) {
+	var rules ScoringRules
+	if err := json.Unmarshal(data, &rules); err != nil {
+		return nil, fmt.Errorf("규칙 JSON 파싱 실패: %w", err)
+	}
+	if err := rules.Validate(); err != nil {
+		return nil, err
+	}
+	return &rules, nil
+}
+
+// ParseScoringRulesYAML YAML 규칙을 파싱하고 검증합니다 (키 이름은 JSON 규칙과 같음)
+func ParseScoringRulesYAML(data []byte) (*ScoringRules, error) {
+	var document interface{}
+	if err := yaml.Unmarshal(data, &document); err != nil {
+		return nil, fmt.Errorf("규칙 YAML 파싱 실패: %w", err)
+	}
+	// JSON 태그와 검증을 그대로 쓰도록 JSON으로 바꿔 파싱합니다
+	converted, err := json.Marshal(stringifyYAMLKeys(document))
+	if err != nil {
+		return nil, fmt.Errorf("규칙 YAML 파싱 실패: %w", err)
+	}
+	return ParseScoringRules(converted)
+}
+
+// stringifyYAMLKeys 숫자 키(예: tierPoints의 11:)가 있는 YAML 맵을 JSON으로 바꿀 수 있게 키를 문자열로 바꿉니다
+func stringifyYAMLKeys(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, item := range v {
+			v[key] = stringifyYAMLKeys(item)
+		}
+		return v
+	case map[interface{}]interface{}:
+		converted := make(map[string]interface{}, len(v))
+		for key, item := range v {
+			converted[fmt.Sprint(key)] = stringifyYAMLKeys(item)
+		}
+		return converted
+	case []interface{}:
+		for i, item := range v {
+			v[i] = stringifyYAMLKeys(item)
+		}
+		return v
+	default:
+		return value
+	}
+}
+
+// LoadScoringRulesFile 파일에서 규칙을 읽어옵니다 (확장자가 .yaml/.yml이면 YAML, 그 밖에는 JSON)
+func LoadScoringRulesFile(path string) (*ScoringRules, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("규칙 파일을 읽을 수 없습니다: %w", err)
+	}
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".yaml", ".yml":
+		return ParseScoringRulesYAML(data)
+	default:
+		return ParseScoringRules(data)
+	}
+}
+
+// Validate 모든 등록 티어(0~31)가 정확히 하나의 리그에 속하고 값이 올바른지 검증합니다
+func (r *ScoringRules) Validate() error {
+	if len(r.Leagues) == 0 {
+		return fmt.Errorf("리그가 하나 이상 필요합니다")
+	}
+
+	ids := make(map[int]bool)
+	owner := make(map[int]string)
+	for _, league := range r.Leagues {
+		if league.Name == "" {
+			return fmt.Errorf("리그 %d의 이름이 비어있습니다", league.ID)
+		}
+		if ids[league.ID] {
+			return fmt.Errorf("리그 ID %d가 중복되었습니다", league.ID)
+		}
+		ids[league.ID] = true
+
+		if league.MinTier < 0 || league.MaxTier > maxRuleTier || league.MinTier > league.MaxTier {
+			return fmt.Errorf("%s 리그의 티어 범위 %d~%d가 올바르지 않습니다 (0~%d)", league.Name, league.MinTier, league.MaxTier, maxRuleTier)
+		}
+		if league.UpperMultiplier < 0 || league.SameMultiplier < 0 || league.LowerMultiplier < 0 {
+			return fmt.Errorf("%s 리그의 가중치는 음수일 수 없습니다", league.Name)
+		}
+
+		for tier := league.MinTier; tier <= league.MaxTier; tier++ {
+			if other, exists := owner[tier]; exists {
+				return fmt.Errorf("티어 %d가 %s 리그와 %s 리그에 중복으로 속합니다", tier, other, league.Name)
+			}
+			owner[tier] = league.Name
+		}
+	}
+
+	for tier := 0; tier <= maxRuleTier; tier++ {
+		if _, exists := owner[tier]; !exists {
+			return fmt.Errorf("티어 %d가 어느 리그에도 속하지 않습니다", tier)
+		}
+	}
+
+	if r.Mode != "" && !IsValidScoringMode(r.Mode) {
+		return fmt.Errorf("점수 방식 '%s'는 지원하지 않습니다 (%s, %s, %s)", r.Mode, ScoringModeLevel, ScoringModeTierPoints, ScoringModeCustom)
+	}
+	if r.Mode == ScoringModeCustom && len(r.TierPoints) == 0 {
+		return fmt.Errorf("%s 방식에는 tierPoints 점수표가 필요합니다", ScoringModeCustom)
+	}
+
+	for key, points := range r.TierPoints {
+		level, err := strconv.Atoi(key)
+		if err != nil || level < 0 || level > maxRuleTier {
+			return fmt.Errorf("점수표의 레벨 '%s'가 올바르지 않습니다 (0~%d)", key, maxRuleTier)
+		}
+		if points < 0 {
+			return fmt.Errorf("레벨 %d의 점수는 음수일 수 없습니다", level)
+		}
+	}
+
+	for i, bonus := range r.Bonuses {
+		if (bonus.Tag == "") == (bonus.Class == 0) {
+			return fmt.Errorf("보너스 %d번은 tag와 class 중 하나만 지정해야 합니다", i+1)
+		}
+		if bonus.Class != 0 && (bonus.Class < constants.MinSolvedACClass || bonus.Class > constants.MaxSolvedACClass) {
+			return fmt.Errorf("보너스 클래스 %d가 올바르지 않습니다 (%d~%d)", bonus.Class, constants.MinSolvedACClass, constants.MaxSolvedACClass)
+		}
+		if bonus.Multiplier <= 0 {
+			return fmt.Errorf("%s 보너스 배율은 0보다 커야 합니다", bonus.Label())
+		}
+	}
+
+	streakDays := make(map[int]bool)
+	for _, streak := range r.StreakBonuses {
+		if streak.Days < constants.MinStreakBonusDays {
+			return fmt.Errorf("연속 해결 보너스 일수 %d가 올바르지 않습니다 (%d일 이상)", streak.Days, constants.MinStreakBonusDays)
+		}
+		if streakDays[streak.Days] {
+			return fmt.Errorf("연속 해결 보너스 %d일 단계가 중복되었습니다", streak.Days)
+		}
+		streakDays[streak.Days] = true
+		if streak.Points < 0 {
+			return fmt.Errorf("연속 해결 %d일 보너스 점수는 음수일 수 없습니다", streak.Days)
+		}
+	}
+
+	if r.Growth != nil {
+		if err := r.Growth.Validate(); err != nil {
+			return err
+		}
+	}
+
+	if r.Team != nil {
+		if err := r.Team.Validate(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// LeagueFor 등록 티어가 속한 리그 규칙을 반환합니다
+func (r *ScoringRules) LeagueFor(startTier int) *LeagueRule {
+	tier := clampRuleTier(startTier)
+	for i := range r.Leagues {
+		if tier >= r.Leagues[i].MinTier && tier <= r.Leagues[i].MaxTier {
+			return &r.Leagues[i]
+		}
+	}
+	return nil
+}
+
+// LeagueByID 리그 ID로 규칙을 찾습니다
+func (r *ScoringRules) LeagueByID(id int) *LeagueRule {
+	for i := range r.Leagues {
+		if r.Leagues[i].ID == id {
+			return &r.Leagues[i]
+		}
+	}
+	return nil
+}
+
+// SortedLeagues 등록 티어가 낮은 리그부터 정렬된 사본을 반환합니다 (표시용)
+func (r *ScoringRules) SortedLeagues() []LeagueRule {
+	leagues := make([]LeagueRule, len(r.Leagues))
+	copy(leagues, r.Leagues)
+	sort.Slice(leagues, func(i, j int) bool {
+		return leagues[i].MinTier < leagues[j].MinTier
+	})
+	return leagues
+}
+
+// EffectiveMode 실제로 적용되는 점수 산정 방식을 반환합니다
+func (r *ScoringRules) EffectiveMode() string {
+	if r.Mode != "" {
+		return r.Mode
+	}
+	if len(r.TierPoints) > 0 {
+		return ScoringModeCustom
+	}
+	return ScoringModeLevel
+}
+
+// HasTagBonuses 태그 보너스가 하나라도 있는지 확인합니다
+func (r *ScoringRules) HasTagBonuses() bool {
+	for _, bonus := range r.Bonuses {
+		if bonus.Tag != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// BonusClasses 보너스가 걸린 클래스 목록을 반환합니다
+func (r *ScoringRules) BonusClasses() []int {
+	var classes []int
+	for _, bonus := range r.Bonuses {
+		if bonus.Class != 0 {
+			classes = append(classes, bonus.Class)
+		}
+	}
+	return classes
+}
+
+// BonusFor 문제의 태그와 클래스 소속 여부로 적용할 보너스를 찾습니다 (가장 큰 배율, 없으면 nil)
+func (r *ScoringRules) BonusFor(tagKeys []string, inClass func(class int) bool) *BonusRule {
+	var best *BonusRule
+	for i := range r.Bonuses {
+		bonus := &r.Bonuses[i]
+		matched := false
+		if bonus.Tag != "" {
+			for _, key := range tagKeys {
+				if strings.EqualFold(key, bonus.Tag) {
+					matched = true
+					break
+				}
+			}
+		} else if inClass != nil {
+			matched = inClass(bonus.Class)
+		}
+
+		if matched && (best == nil || bonus.Multiplier > best.Multiplier) {
+			best = bonus
+		}
+	}
+	return best
+}
+
+// StreakBonusFor 최장 연속 해결 일수로 받을 수 있는 보너스 점수 합계를 반환합니다
+func (r *ScoringRules) StreakBonusFor(longest int) float64 {
+	total := 0.0
+	for _, streak := range r.StreakBonuses {
+		if longest >= streak.Days {
+			total += streak.Points
+		}
+	}
+	return total
+}
+
+// GrowthBonusFor 레이팅/티어 상승분에 대한 성장 점수를 반환합니다 (하락은 0점)
+func (r *ScoringRules) GrowthBonusFor(ratingGain, tierGain int) float64 {
+	if r.Growth == nil {
+		return 0
+	}
+	bonus := 0.0
+	if ratingGain > 0 {
+		bonus += float64(ratingGain) * r.Growth.PointsPerRating
+	}
+	if tierGain > 0 {
+		bonus += float64(tierGain) * r.Growth.TierUpBonus
+	}
+	return bonus
+}
+
+// TeamRuleOrDefault 팀 점수 집계 규칙을 반환합니다 (없으면 합계 방식)
+func (r *ScoringRules) TeamRuleOrDefault() TeamRule {
+	if r.Team == nil {
+		return DefaultTeamRule()
+	}
+	return *r.Team
+}
+
+// WithMode 점수 산정 방식만 바꾼 사본을 반환합니다
+func (r *ScoringRules) WithMode(mode string) *ScoringRules {
+	clone := *r
+	clone.Leagues = make([]LeagueRule, len(r.Leagues))
+	copy(clone.Leagues, r.Leagues)
+	clone.Bonuses = append([]BonusRule(nil), r.Bonuses...)
+	clone.StreakBonuses = append([]StreakBonus(nil), r.StreakBonuses...)
+	if r.Growth != nil {
+		growth := *r.Growth
+		clone.Growth = &growth
+	}
+	if r.Team != nil {
+		team := *r.Team
+		clone.Team = &team
+	}
+	clone.Mode = mode
+	return &clone
+}
+
+// PointsFor 문제 레벨의 기본 점수를 점수 산정 방식에 따라 반환합니다
+func (r *ScoringRules) PointsFor(level int) float64 {
+	switch r.EffectiveMode() {
+	case ScoringModeTierPoints:
+		return float64(GetTierManager().GetTierPoints(clampRuleTier(level)))
+	case ScoringModeCustom:
+		if points, ok := r.TierPoints[strconv.Itoa(clampRuleTier(level))]; ok {
+			return points
+		}
+	}
+	// solved.ac 난이도 값을 그대로 사용 (Bronze V = 1, Bronze IV = 2, ...)
+	return float64(level)
+}
+
+// Multiplier 리그 규칙에 따라 문제 난이도와 등록 티어를 비교한 가중치를 반환합니다
+func (l *LeagueRule) Multiplier(problemLevel, startTier int) float64 {
+	switch ProblemCategory(problemLevel, startTier) {
+	case ProblemCategoryChallenge:
+		return l.UpperMultiplier
+	case ProblemCategoryBase:
+		return l.SameMultiplier
+	default:
+		return l.LowerMultiplier
+	}
+}
+
+// Label 보너스 조건의 표시 이름을 반환합니다
+func (b *BonusRule) Label() string {
+	if b.Tag != "" {
+		return "#" + b.Tag
+	}
+	return fmt.Sprintf("CLASS %d", b.Class)
+}
+
+// clampRuleTier 31보다 높은 티어는 마스터(31)로 취급합니다
+func clampRuleTier(tier int) int {
+	if tier > maxRuleTier {
+		return maxRuleTier
+	}
+	return tier
+}
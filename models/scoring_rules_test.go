@@ -0,0 +1,172 @@
+package models
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/ssugameworks/kkemi/constants"
+)
+
+func TestDefaultScoringRules_MatchesLegacyLeagues(t *testing.T) {
+	rules := DefaultScoringRules()
+	if err := rules.Validate(); err != nil {
+		t.Fatalf("Default rules should be valid: %v", err)
+	}
+
+	tests := []struct {
+		startTier int
+		expected  int
+	}{
+		{0, constants.LeagueRookie},
+		{6, constants.LeagueRookie},
+		{7, constants.LeaguePro},
+		{11, constants.LeaguePro},
+		{12, constants.LeagueMaster},
+		{35, constants.LeagueMaster}, // 31 초과 티어는 마스터로 취급
+	}
+	for _, test := range tests {
+		if league := rules.LeagueFor(test.startTier); league == nil || league.ID != test.expected {
+			t.Errorf("Tier %d: expected league %d, got %+v", test.startTier, test.expected, league)
+		}
+	}
+}
+
+func TestScoringRules_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		mutate  func(r *ScoringRules)
+		wantErr string
+	}{
+		{"no leagues", func(r *ScoringRules) { r.Leagues = nil }, "리그가 하나 이상"},
+		{"overlapping ranges", func(r *ScoringRules) { r.Leagues[1].MinTier = 6 }, "중복으로"},
+		{"uncovered tier", func(r *ScoringRules) { r.Leagues[2].MinTier = 13 }, "티어 12"},
+		{"duplicate id", func(r *ScoringRules) { r.Leagues[1].ID = r.Leagues[0].ID }, "중복되었습니다"},
+		{"negative multiplier", func(r *ScoringRules) { r.Leagues[0].UpperMultiplier = -1 }, "음수"},
+		{"invalid tier point key", func(r *ScoringRules) { r.TierPoints = map[string]float64{"gold": 1} }, "gold"},
+		{"bonus with tag and class", func(r *ScoringRules) { r.Bonuses = []BonusRule{{Tag: "dp", Class: 3, Multiplier: 2}} }, "하나만"},
+		{"bonus class out of range", func(r *ScoringRules) { r.Bonuses = []BonusRule{{Class: 11, Multiplier: 2}} }, "클래스 11"},
+		{"non-positive bonus", func(r *ScoringRules) { r.Bonuses = []BonusRule{{Tag: "dp", Multiplier: 0}} }, "#dp"},
+		{"streak bonus too short", func(r *ScoringRules) { r.StreakBonuses = []StreakBonus{{Days: 1, Points: 5}} }, "일수 1"},
+		{"duplicate streak bonus", func(r *ScoringRules) {
+			r.StreakBonuses = []StreakBonus{{Days: 3, Points: 5}, {Days: 3, Points: 10}}
+		}, "3일 단계가 중복"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			rules := DefaultScoringRules()
+			test.mutate(rules)
+			err := rules.Validate()
+			if err == nil || !strings.Contains(err.Error(), test.wantErr) {
+				t.Errorf("Expected error containing %q, got %v", test.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestParseScoringRules(t *testing.T) {
+	data := []byte(`{
+		"name": "두 리그",
+		"leagues": [
+			{"id": 0, "name": "입문", "minTier": 0, "maxTier": 10, "upperMultiplier": 2, "sameMultiplier": 1, "lowerMultiplier": 0.5},
+			{"id": 1, "name": "고수", "minTier": 11, "maxTier": 31, "upperMultiplier": 1, "sameMultiplier": 1, "lowerMultiplier": 1}
+		],
+		"tierPoints": {"11": 18}
+	}`)
+
+	rules, err := ParseScoringRules(data)
+	if err != nil {
+		t.Fatalf("Expected valid rules, got %v", err)
+	}
+	if rules.LeagueFor(10).Name != "입문" || rules.LeagueFor(11).Name != "고수" {
+		t.Error("League ranges were not parsed correctly")
+	}
+	if rules.PointsFor(11) != 18 || rules.PointsFor(12) != 12 {
+		t.Errorf("Expected table point 18 and level fallback 12, got %v and %v", rules.PointsFor(11), rules.PointsFor(12))
+	}
+
+	if _, err := ParseScoringRules([]byte(`{"name": "broken"`)); err == nil {
+		t.Error("Expected error for malformed JSON")
+	}
+}
+
+func TestLoadScoringRulesFileYAML(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "rules.yaml")
+	data := []byte(`name: 두 리그
+leagues:
+  - {id: 0, name: 입문, minTier: 0, maxTier: 10, upperMultiplier: 2, sameMultiplier: 1, lowerMultiplier: 0.5}
+  - {id: 1, name: 고수, minTier: 11, maxTier: 31, upperMultiplier: 1, sameMultiplier: 1, lowerMultiplier: 1}
+tierPoints:
+  11: 18
+`)
+	if err := os.WriteFile(path, data, 0o600); err != nil {
+		t.Fatalf("Failed to write rules file: %v", err)
+	}
+
+	rules, err := LoadScoringRulesFile(path)
+	if err != nil {
+		t.Fatalf("Expected valid YAML rules, got %v", err)
+	}
+	if rules.Name != "두 리그" || rules.LeagueFor(11).Name != "고수" || rules.PointsFor(11) != 18 {
+		t.Errorf("YAML rules were not parsed like JSON rules: %+v", rules)
+	}
+
+	if _, err := ParseScoringRulesYAML([]byte("leagues: [")); err == nil {
+		t.Error("Expected error for malformed YAML")
+	}
+}
+
+func TestTierPointsFromTierManager(t *testing.T) {
+	points := TierPointsFromTierManager(GetTierManager())
+	if points["12"] != 20 || points["30"] != 75 {
+		t.Errorf("Expected Gold IV = 20 and Ruby I = 75, got %v and %v", points["12"], points["30"])
+	}
+}
+
+func TestScoringRules_Modes(t *testing.T) {
+	rules := DefaultScoringRules()
+	if rules.EffectiveMode() != ScoringModeLevel || rules.PointsFor(12) != 12 {
+		t.Errorf("Default rules should score by level, got mode %s", rules.EffectiveMode())
+	}
+
+	tierPoints := rules.WithMode(ScoringModeTierPoints)
+	if tierPoints.PointsFor(12) != 20 || tierPoints.PointsFor(35) != 80 {
+		t.Errorf("Expected Gold IV = 20 and Master = 80, got %v and %v", tierPoints.PointsFor(12), tierPoints.PointsFor(35))
+	}
+	if rules.Mode != "" {
+		t.Error("WithMode should not modify the original rules")
+	}
+
+	if err := rules.WithMode(ScoringModeCustom).Validate(); err == nil {
+		t.Error("Custom mode without a point table should be rejected")
+	}
+	if err := rules.WithMode("exponential").Validate(); err == nil {
+		t.Error("Unknown mode should be rejected")
+	}
+}
+
+func TestScoringRules_BonusFor(t *testing.T) {
+	rules := DefaultScoringRules()
+	rules.Bonuses = []BonusRule{
+		{Tag: "dp", Multiplier: 1.5},
+		{Tag: "graphs", Multiplier: 1.2},
+		{Class: 3, Multiplier: 2},
+	}
+	if err := rules.Validate(); err != nil {
+		t.Fatalf("Expected bonus rules to be valid, got %v", err)
+	}
+
+	inClass3 := func(class int) bool { return class == 3 }
+
+	if bonus := rules.BonusFor([]string{"DP", "graphs"}, nil); bonus == nil || bonus.Label() != "#dp" {
+		t.Errorf("Expected largest matching tag bonus #dp, got %v", bonus)
+	}
+	if bonus := rules.BonusFor([]string{"graphs"}, inClass3); bonus == nil || bonus.Label() != "CLASS 3" {
+		t.Errorf("Expected class bonus to win, got %v", bonus)
+	}
+	if bonus := rules.BonusFor([]string{"math"}, nil); bonus != nil {
+		t.Errorf("Expected no bonus, got %v", bonus)
+	}
+}
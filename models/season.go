@@ -0,0 +1,148 @@
+package models
+
+import (
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/ssugameworks/kkemi/constants"
+)
+
+// 시즌 포인트 산정 방식
+const (
+	SeasonScoringPlacement  = "placement"  // 리그 순위별 고정 포인트 (기본)
+	SeasonScoringNormalized = "normalized" // 리그 1위 점수 대비 비율 (100점 만점)
+)
+
+// Season 여러 대회의 결과를 합산하는 기간입니다 (종료일이 기간 안에 있는 확정 대회를 포함)
+type Season struct {
+	ID        string    `firestore:"-"`
+	Name      string    `firestore:"name"`
+	StartDate time.Time `firestore:"startDate"`
+	EndDate   time.Time `firestore:"endDate"`
+	Scoring   string    `firestore:"scoring"`
+	CreatedAt time.Time `firestore:"createdAt"`
+}
+
+// CompetitionArchive 시즌 집계에 사용하는 확정 대회와 보관된 결과입니다
+type CompetitionArchive struct {
+	Competition Competition
+	Results     []ArchivedResult
+}
+
+// SeasonStanding 시즌 순위표의 한 행입니다 (key: 소문자 백준 ID)
+type SeasonStanding struct {
+	BaekjoonID   string  `json:"baekjoon_id"`
+	Name         string  `json:"name"`
+	Points       float64 `json:"points"`
+	Competitions int     `json:"competitions"` // 참가한 대회 수
+	BestRank     int     `json:"best_rank"`    // 대회별 순위 중 가장 높은 순위
+}
+
+// IsValidSeasonScoring 지원하는 시즌 포인트 산정 방식인지 확인합니다
+func IsValidSeasonScoring(scoring string) bool {
+	return scoring == SeasonScoringPlacement || scoring == SeasonScoringNormalized
+}
+
+// SeasonScoringLabel 시즌 포인트 산정 방식의 표시 이름을 반환합니다
+func SeasonScoringLabel(scoring string) string {
+	if scoring == SeasonScoringNormalized {
+		return "점수 비율"
+	}
+	return "순위 포인트"
+}
+
+// Includes 대회 종료일이 시즌 기간(종료일 하루 전체 포함) 안에 있는지 확인합니다
+func (s *Season) Includes(competition *Competition) bool {
+	return !competition.EndDate.Before(s.StartDate) && competition.EndDate.Before(s.EndDate.AddDate(0, 0, 1))
+}
+
+// CurrentSeason 주어진 시각이 속한 시즌을 찾습니다 (없으면 가장 최근에 끝난 시즌)
+func CurrentSeason(seasons []Season, now time.Time) (Season, bool) {
+	var latest *Season
+	for i := range seasons {
+		season := &seasons[i]
+		if !now.Before(season.StartDate) && now.Before(season.EndDate.AddDate(0, 0, 1)) {
+			return *season, true
+		}
+		if season.EndDate.Before(now) && (latest == nil || season.EndDate.After(latest.EndDate)) {
+			latest = season
+		}
+	}
+	if latest == nil {
+		return Season{}, false
+	}
+	return *latest, true
+}
+
+// PlacementPoints 순위에 해당하는 순위 포인트를 반환합니다 (표 밖의 순위는 참가 포인트)
+func PlacementPoints(rank int) float64 {
+	if rank >= 1 && rank <= len(constants.SeasonPlacementPoints) {
+		return constants.SeasonPlacementPoints[rank-1]
+	}
+	return constants.SeasonParticipationPoints
+}
+
+// AggregateSeason 대회별 결과를 참가자별 시즌 포인트로 합산하여 포인트 내림차순으로 반환합니다
+// 순위 포인트는 리그 내 순위로, 점수 비율은 같은 리그(문제 목록 대회는 전체) 1위 점수 대비로 계산합니다
+func AggregateSeason(scoring string, archives []CompetitionArchive) []SeasonStanding {
+	standings := make(map[string]*SeasonStanding)
+
+	for _, archive := range archives {
+		topScores := make(map[int]float64)
+		for _, result := range archive.Results {
+			group := seasonGroup(&archive.Competition, result)
+			topScores[group] = math.Max(topScores[group], result.Score.Score)
+		}
+
+		for _, result := range archive.Results {
+			var points float64
+			if scoring == SeasonScoringNormalized {
+				if top := topScores[seasonGroup(&archive.Competition, result)]; top > 0 {
+					points = result.Score.Score / top * 100
+				}
+			} else {
+				points = PlacementPoints(result.Rank)
+			}
+
+			key := strings.ToLower(result.Score.BaekjoonID)
+			standing, ok := standings[key]
+			if !ok {
+				standing = &SeasonStanding{BaekjoonID: result.Score.BaekjoonID}
+				standings[key] = standing
+			}
+			// 가장 최근 대회의 이름을 표시
+			standing.Name = result.Score.Name
+			standing.Points += points
+			standing.Competitions++
+			if result.Rank > 0 && (standing.BestRank == 0 || result.Rank < standing.BestRank) {
+				standing.BestRank = result.Rank
+			}
+		}
+	}
+
+	results := make([]SeasonStanding, 0, len(standings))
+	for _, standing := range standings {
+		standing.Points = math.Round(standing.Points*10) / 10
+		results = append(results, *standing)
+	}
+	sort.Slice(results, func(i, j int) bool {
+		if results[i].Points != results[j].Points {
+			return results[i].Points > results[j].Points
+		}
+		if results[i].Competitions != results[j].Competitions {
+			return results[i].Competitions > results[j].Competitions
+		}
+		return results[i].BaekjoonID < results[j].BaekjoonID
+	})
+	return results
+}
+
+// seasonGroup 점수 비율을 비교할 그룹을 반환합니다 (문제 목록 대회는 리그 구분 없이 하나)
+func seasonGroup(competition *Competition, result ArchivedResult) int {
+	if competition.IsProblemSet() {
+		return 0
+	}
+	return result.Score.League
+}
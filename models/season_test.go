@@ -0,0 +1,83 @@
+package models
+
+import (
+	"testing"
+	"time"
+)
+
+func TestAggregateSeason(t *testing.T) {
+	archives := []CompetitionArchive{
+		{
+			Competition: Competition{ID: "c1"},
+			Results: []ArchivedResult{
+				{Rank: 1, Score: ScoreData{BaekjoonID: "alice", Name: "앨리스", League: 1, Score: 50}},
+				{Rank: 2, Score: ScoreData{BaekjoonID: "bob", League: 1, Score: 25}},
+				{Rank: 1, Score: ScoreData{BaekjoonID: "carol", League: 2, Score: 200}},
+			},
+		},
+		{
+			Competition: Competition{ID: "c2"},
+			Results: []ArchivedResult{
+				{Rank: 1, Score: ScoreData{BaekjoonID: "Bob", League: 1, Score: 40}},
+				{Rank: 2, Score: ScoreData{BaekjoonID: "alice", Name: "앨리스2", League: 1, Score: 20}},
+			},
+		},
+	}
+
+	t.Run("Placement", func(t *testing.T) {
+		standings := AggregateSeason(SeasonScoringPlacement, archives)
+		if len(standings) != 3 {
+			t.Fatalf("Expected 3 members (BaekjoonID is case-insensitive), got %d", len(standings))
+		}
+		// alice 25+18, bob 18+25, carol 25
+		if standings[0].BaekjoonID != "alice" || standings[0].Points != 43 || standings[0].Competitions != 2 {
+			t.Errorf("Expected alice first with 43 points, got %+v", standings[0])
+		}
+		if standings[0].Name != "앨리스2" || standings[0].BestRank != 1 {
+			t.Errorf("Expected latest name and best rank, got %+v", standings[0])
+		}
+		if standings[2].BaekjoonID != "carol" || standings[2].Points != 25 {
+			t.Errorf("Expected carol last with 25 points, got %+v", standings[2])
+		}
+	})
+
+	t.Run("Normalized", func(t *testing.T) {
+		standings := AggregateSeason(SeasonScoringNormalized, archives)
+		// alice 100+50, bob 50+100, carol 100 (리그마다 1위 점수 기준)
+		if standings[0].Points != 150 || standings[1].Points != 150 || standings[2].Points != 100 {
+			t.Errorf("Expected normalised points 150, 150, 100, got %+v", standings)
+		}
+	})
+}
+
+func TestPlacementPoints(t *testing.T) {
+	if PlacementPoints(1) != 25 || PlacementPoints(10) != 2 {
+		t.Errorf("Unexpected placement points: 1st=%v 10th=%v", PlacementPoints(1), PlacementPoints(10))
+	}
+	if PlacementPoints(11) != 1 || PlacementPoints(0) != 1 {
+		t.Errorf("Expected participation points outside the table")
+	}
+}
+
+func TestSeasonIncludesAndCurrent(t *testing.T) {
+	spring := Season{Name: "spring", StartDate: time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC), EndDate: time.Date(2025, 6, 30, 0, 0, 0, 0, time.UTC)}
+	fall := Season{Name: "fall", StartDate: time.Date(2025, 9, 1, 0, 0, 0, 0, time.UTC), EndDate: time.Date(2025, 12, 31, 0, 0, 0, 0, time.UTC)}
+
+	if !spring.Includes(&Competition{EndDate: time.Date(2025, 6, 30, 0, 0, 0, 0, time.UTC)}) {
+		t.Error("Expected competition ending on the last season day to be included")
+	}
+	if spring.Includes(&Competition{EndDate: time.Date(2025, 7, 1, 0, 0, 0, 0, time.UTC)}) {
+		t.Error("Expected competition ending after the season to be excluded")
+	}
+
+	seasons := []Season{spring, fall}
+	if season, ok := CurrentSeason(seasons, time.Date(2025, 10, 1, 0, 0, 0, 0, time.UTC)); !ok || season.Name != "fall" {
+		t.Errorf("Expected fall to be current, got %+v", season)
+	}
+	if season, ok := CurrentSeason(seasons, time.Date(2025, 8, 1, 0, 0, 0, 0, time.UTC)); !ok || season.Name != "spring" {
+		t.Errorf("Expected most recently ended season between seasons, got %+v", season)
+	}
+	if _, ok := CurrentSeason(seasons, time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)); ok {
+		t.Error("Expected no season before the first one starts")
+	}
+}
@@ -0,0 +1,147 @@
+package models
+
+import (
+	"sort"
+	"strings"
+	"time"
+)
+
+// SnapshotEntry 스냅샷 시점의 참가자 한 명의 점수와 순위입니다
+type SnapshotEntry struct {
+	BaekjoonID   string  `json:"baekjoon_id" firestore:"baekjoonId"`
+	Name         string  `json:"name" firestore:"name"`
+	League       int     `json:"league" firestore:"league"`
+	LeagueName   string  `json:"league_name" firestore:"leagueName"`
+	Score        float64 `json:"score" firestore:"score"`
+	RawScore     float64 `json:"raw_score" firestore:"rawScore"`
+	Rank         int     `json:"rank" firestore:"rank"` // 리그 내 순위
+	ProblemCount int     `json:"problem_count" firestore:"problemCount"`
+	// SolvedTargets 문제 목록 대회에서 해결한 대상 문제 번호 (스냅샷 기준 스코어보드 재현용)
+	SolvedTargets []int  `json:"solved_targets,omitempty" firestore:"solvedTargets,omitempty"`
+	Team          string `json:"team,omitempty" firestore:"team,omitempty"`
+}
+
+// ScoreSnapshot 정기 실행 때 저장하는 특정 시점의 점수표입니다
+type ScoreSnapshot struct {
+	ID      string          `json:"id" firestore:"-"`
+	TakenAt time.Time       `json:"taken_at" firestore:"takenAt"`
+	Entries []SnapshotEntry `json:"entries" firestore:"entries"`
+}
+
+// TrendPoint 참가자 점수 추이의 한 시점입니다
+type TrendPoint struct {
+	TakenAt      time.Time
+	Score        float64
+	Rank         int
+	League       int
+	ProblemCount int
+}
+
+// NewScoreSnapshot 점수 데이터와 리그 내 순위(key: BaekjoonID)로 스냅샷을 만듭니다
+func NewScoreSnapshot(scores []ScoreData, ranks map[string]int, takenAt time.Time) ScoreSnapshot {
+	entries := make([]SnapshotEntry, 0, len(scores))
+	for _, score := range scores {
+		entries = append(entries, SnapshotEntry{
+			BaekjoonID:    score.BaekjoonID,
+			Name:          score.Name,
+			League:        score.League,
+			LeagueName:    score.LeagueName,
+			Score:         score.Score,
+			RawScore:      score.RawScore,
+			Rank:          ranks[score.BaekjoonID],
+			ProblemCount:  score.ProblemCount,
+			SolvedTargets: score.SolvedTargets,
+			Team:          score.Team,
+		})
+	}
+	sort.SliceStable(entries, func(i, j int) bool {
+		if entries[i].League != entries[j].League {
+			return entries[i].League < entries[j].League
+		}
+		if entries[i].Rank != entries[j].Rank {
+			return entries[i].Rank < entries[j].Rank
+		}
+		return entries[i].BaekjoonID < entries[j].BaekjoonID
+	})
+	return ScoreSnapshot{TakenAt: takenAt, Entries: entries}
+}
+
+// Entry 백준 ID로 스냅샷 항목을 찾습니다 (대소문자 무시)
+func (s *ScoreSnapshot) Entry(baekjoonID string) (SnapshotEntry, bool) {
+	for _, entry := range s.Entries {
+		if strings.EqualFold(entry.BaekjoonID, baekjoonID) {
+			return entry, true
+		}
+	}
+	return SnapshotEntry{}, false
+}
+
+// Scores 스냅샷을 스코어보드에 다시 그릴 수 있는 점수 데이터로 되돌립니다
+func (s *ScoreSnapshot) Scores() []ScoreData {
+	scores := make([]ScoreData, 0, len(s.Entries))
+	for _, entry := range s.Entries {
+		scores = append(scores, ScoreData{
+			Name:          entry.Name,
+			BaekjoonID:    entry.BaekjoonID,
+			Score:         entry.Score,
+			RawScore:      entry.RawScore,
+			League:        entry.League,
+			LeagueName:    entry.LeagueName,
+			ProblemCount:  entry.ProblemCount,
+			SolvedTargets: entry.SolvedTargets,
+			Team:          entry.Team,
+		})
+	}
+	return scores
+}
+
+// RankChange 이전 스냅샷 대비 순위 변화를 반환합니다 (양수는 상승, 이전 기록이 없거나 리그가 바뀌면 ok=false)
+func (s *ScoreSnapshot) RankChange(baekjoonID string, league, rank int) (delta int, ok bool) {
+	if s == nil {
+		return 0, false
+	}
+	previous, found := s.Entry(baekjoonID)
+	if !found || previous.League != league || previous.Rank == 0 {
+		return 0, false
+	}
+	return previous.Rank - rank, true
+}
+
+// SnapshotBefore 주어진 시각 이전에 찍힌 스냅샷 중 가장 최근 것과 그 위치를 반환합니다 (snapshots는 시간순)
+func SnapshotBefore(snapshots []ScoreSnapshot, before time.Time) (ScoreSnapshot, int, bool) {
+	for i := len(snapshots) - 1; i >= 0; i-- {
+		if snapshots[i].TakenAt.Before(before) {
+			return snapshots[i], i, true
+		}
+	}
+	return ScoreSnapshot{}, -1, false
+}
+
+// ScoreTrend 시간순 스냅샷에서 참가자의 점수·순위 추이를 뽑습니다
+func ScoreTrend(snapshots []ScoreSnapshot, baekjoonID string) []TrendPoint {
+	points := make([]TrendPoint, 0, len(snapshots))
+	for i := range snapshots {
+		if entry, found := snapshots[i].Entry(baekjoonID); found {
+			points = append(points, TrendPoint{
+				TakenAt:      snapshots[i].TakenAt,
+				Score:        entry.Score,
+				Rank:         entry.Rank,
+				League:       entry.League,
+				ProblemCount: entry.ProblemCount,
+			})
+		}
+	}
+	return points
+}
+
+// DailyTrend 추이를 KST 날짜별 마지막 시점 하나씩으로 줄입니다 (points는 시간순)
+func DailyTrend(points []TrendPoint) []TrendPoint {
+	daily := make([]TrendPoint, 0, len(points))
+	for i, point := range points {
+		if i+1 < len(points) && KSTDayIndex(points[i+1].TakenAt) == KSTDayIndex(point.TakenAt) {
+			continue
+		}
+		daily = append(daily, point)
+	}
+	return daily
+}
@@ -0,0 +1,92 @@
+package models
+
+import (
+	"testing"
+	"time"
+)
+
+func TestNewScoreSnapshot(t *testing.T) {
+	scores := []ScoreData{
+		{BaekjoonID: "bob", League: 1, Score: 10, RawScore: 10.4, ProblemCount: 2},
+		{BaekjoonID: "alice", League: 1, Score: 30, RawScore: 30, ProblemCount: 5},
+		{BaekjoonID: "carol", League: 0, Score: 5, RawScore: 5},
+	}
+	ranks := map[string]int{"alice": 1, "bob": 2, "carol": 1}
+	takenAt := time.Date(2025, 3, 1, 9, 0, 0, 0, time.UTC)
+
+	snapshot := NewScoreSnapshot(scores, ranks, takenAt)
+
+	if snapshot.TakenAt != takenAt || len(snapshot.Entries) != 3 {
+		t.Fatalf("Unexpected snapshot: %+v", snapshot)
+	}
+	if snapshot.Entries[0].BaekjoonID != "carol" || snapshot.Entries[1].BaekjoonID != "alice" {
+		t.Errorf("Expected entries ordered by league then rank, got %+v", snapshot.Entries)
+	}
+
+	restored := snapshot.Scores()
+	if restored[2].BaekjoonID != "bob" || restored[2].RawScore != 10.4 || restored[2].ProblemCount != 2 {
+		t.Errorf("Expected scores to round-trip, got %+v", restored[2])
+	}
+}
+
+func TestScoreSnapshot_RankChange(t *testing.T) {
+	previous := &ScoreSnapshot{Entries: []SnapshotEntry{
+		{BaekjoonID: "alice", League: 1, Rank: 4},
+		{BaekjoonID: "bob", League: 1, Rank: 1},
+	}}
+
+	if delta, ok := previous.RankChange("Alice", 1, 1); !ok || delta != 3 {
+		t.Errorf("Expected alice up 3, got %d (%v)", delta, ok)
+	}
+	if delta, ok := previous.RankChange("bob", 1, 2); !ok || delta != -1 {
+		t.Errorf("Expected bob down 1, got %d (%v)", delta, ok)
+	}
+	if _, ok := previous.RankChange("bob", 2, 1); ok {
+		t.Error("Expected league change to have no comparable rank")
+	}
+	if _, ok := previous.RankChange("newbie", 1, 3); ok {
+		t.Error("Expected new participant to have no previous rank")
+	}
+	var none *ScoreSnapshot
+	if _, ok := none.RankChange("alice", 1, 1); ok {
+		t.Error("Expected nil snapshot to report no change")
+	}
+}
+
+func TestSnapshotBeforeAndTrend(t *testing.T) {
+	day := func(d int) time.Time { return time.Date(2025, 3, d, 9, 0, 0, 0, time.UTC) }
+	snapshots := []ScoreSnapshot{
+		{TakenAt: day(1), Entries: []SnapshotEntry{{BaekjoonID: "alice", Score: 10, Rank: 2}}},
+		{TakenAt: day(2), Entries: []SnapshotEntry{{BaekjoonID: "bob", Score: 5, Rank: 1}}},
+		{TakenAt: day(3), Entries: []SnapshotEntry{{BaekjoonID: "alice", Score: 25, Rank: 1}}},
+	}
+
+	if _, index, ok := SnapshotBefore(snapshots, day(3)); !ok || index != 1 {
+		t.Errorf("Expected snapshot taken on day 2, got index %d", index)
+	}
+	if _, _, ok := SnapshotBefore(snapshots, day(1)); ok {
+		t.Error("Expected no snapshot before the first one")
+	}
+
+	trend := ScoreTrend(snapshots, "alice")
+	if len(trend) != 2 || trend[0].Score != 10 || trend[1].Rank != 1 {
+		t.Errorf("Expected two trend points for alice, got %+v", trend)
+	}
+}
+
+func TestDailyTrend(t *testing.T) {
+	// KST 기준 3월 1일 두 번, 3월 2일 한 번 (UTC 16시는 KST 다음날 01시)
+	points := []TrendPoint{
+		{TakenAt: time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC), Score: 5, ProblemCount: 1},
+		{TakenAt: time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC), Score: 8, ProblemCount: 2},
+		{TakenAt: time.Date(2025, 3, 1, 16, 0, 0, 0, time.UTC), Score: 12, ProblemCount: 4},
+	}
+
+	daily := DailyTrend(points)
+	if len(daily) != 2 || daily[0].Score != 8 || daily[1].ProblemCount != 4 {
+		t.Errorf("Expected last point of each KST day, got %+v", daily)
+	}
+	if len(DailyTrend(nil)) != 0 {
+		t.Error("Expected no points for empty trend")
+	}
+}
@@ -0,0 +1,83 @@
+package models
+
+import (
+	"time"
+
+	"github.com/ssugameworks/kkemi/constants"
+)
+
+// secondsPerDay 하루의 초 단위 길이
+const secondsPerDay = 24 * 60 * 60
+
+// StreakStats 참가자의 연속 해결(잔디) 기록입니다
+type StreakStats struct {
+	Current   int     `json:"current"`    // 오늘(또는 어제)까지 이어지는 연속 해결 일수
+	Longest   int     `json:"longest"`    // 대회 기간 중 가장 긴 연속 해결 일수
+	Broken    int     `json:"broken"`     // 어제 끊긴 연속 기록의 길이 (끊기지 않았으면 0)
+	SolveDays int     `json:"solve_days"` // 문제를 해결한 날의 수
+	Bonus     float64 `json:"bonus"`      // 규칙에 따라 지급되는 연속 해결 보너스
+}
+
+// StreakBonus 최장 연속 해결 일수가 Days 이상이면 Points를 더하는 보너스 단계입니다
+type StreakBonus struct {
+	Days   int     `json:"days" firestore:"days"`
+	Points float64 `json:"points" firestore:"points"`
+}
+
+// KSTDayIndex 시각을 KST 기준 날짜 번호(1970-01-01부터 경과 일수)로 변환합니다
+func KSTDayIndex(t time.Time) int {
+	seconds := t.Unix() + constants.KSTOffsetSeconds
+	day := seconds / secondsPerDay
+	if seconds < 0 && seconds%secondsPerDay != 0 {
+		day--
+	}
+	return int(day)
+}
+
+// ComputeStreak 해결한 날짜 번호 집합으로 오늘 기준 연속 해결 기록을 계산합니다
+func ComputeStreak(solveDays map[int]bool, today int) StreakStats {
+	stats := StreakStats{}
+	for day := range solveDays {
+		if day > today {
+			continue
+		}
+		stats.SolveDays++
+		// 연속 구간의 시작일에서만 길이를 세어 중복 계산을 피합니다
+		if solveDays[day-1] {
+			continue
+		}
+		length := runLength(solveDays, day, today)
+		if length > stats.Longest {
+			stats.Longest = length
+		}
+	}
+
+	// 오늘 아직 풀지 않았더라도 어제까지 이어졌다면 진행 중인 기록으로 봅니다
+	if solveDays[today] {
+		stats.Current = runLengthBackward(solveDays, today)
+	} else if solveDays[today-1] {
+		stats.Current = runLengthBackward(solveDays, today-1)
+	} else if solveDays[today-2] {
+		stats.Broken = runLengthBackward(solveDays, today-2)
+	}
+
+	return stats
+}
+
+// runLength start부터 limit까지 연속으로 해결한 일수를 셉니다
+func runLength(solveDays map[int]bool, start, limit int) int {
+	length := 0
+	for day := start; day <= limit && solveDays[day]; day++ {
+		length++
+	}
+	return length
+}
+
+// runLengthBackward end에서 거꾸로 연속으로 해결한 일수를 셉니다
+func runLengthBackward(solveDays map[int]bool, end int) int {
+	length := 0
+	for day := end; solveDays[day]; day-- {
+		length++
+	}
+	return length
+}
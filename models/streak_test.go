@@ -0,0 +1,56 @@
+package models
+
+import (
+	"testing"
+	"time"
+)
+
+func TestKSTDayIndex(t *testing.T) {
+	kst := time.FixedZone("KST", 9*60*60)
+	lateNight := time.Date(2025, 3, 1, 23, 59, 0, 0, kst)
+	nextMorning := time.Date(2025, 3, 2, 0, 1, 0, 0, kst)
+
+	if KSTDayIndex(nextMorning)-KSTDayIndex(lateNight) != 1 {
+		t.Errorf("Expected KST midnight to start a new day")
+	}
+	// UTC로는 같은 날이지만 KST로는 다른 날
+	if KSTDayIndex(lateNight.UTC()) != KSTDayIndex(lateNight) {
+		t.Errorf("Day index should not depend on the time zone of the value")
+	}
+}
+
+func TestComputeStreak(t *testing.T) {
+	days := func(indexes ...int) map[int]bool {
+		set := make(map[int]bool)
+		for _, index := range indexes {
+			set[index] = true
+		}
+		return set
+	}
+
+	tests := []struct {
+		name    string
+		days    map[int]bool
+		today   int
+		current int
+		longest int
+		broken  int
+	}{
+		{"solved today", days(8, 9, 10), 10, 3, 3, 0},
+		{"not yet today", days(8, 9), 10, 2, 2, 0},
+		{"broken yesterday", days(5, 6, 7, 8), 10, 0, 4, 4},
+		{"long gap", days(1, 2, 3), 10, 0, 3, 0},
+		{"longest earlier", days(1, 2, 3, 4, 9, 10), 10, 2, 4, 0},
+		{"future days ignored", days(10, 11, 12), 10, 1, 1, 0},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			stats := ComputeStreak(test.days, test.today)
+			if stats.Current != test.current || stats.Longest != test.longest || stats.Broken != test.broken {
+				t.Errorf("Expected current=%d longest=%d broken=%d, got %+v",
+					test.current, test.longest, test.broken, stats)
+			}
+		})
+	}
+}
@@ -0,0 +1,146 @@
+package models
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+// 팀 점수 집계 방식
+const (
+	TeamAggregationSum     = "sum"     // 팀원 점수 합계 (기본)
+	TeamAggregationAverage = "average" // 팀원 점수 평균
+	TeamAggregationTopN    = "top_n"   // 상위 N명 점수 합계
+)
+
+// Team 대회 참가자들이 속한 팀입니다 (한 참가자는 한 팀에만 속할 수 있음)
+type Team struct {
+	ID        string    `json:"id" firestore:"-"`
+	Name      string    `json:"name" firestore:"name"`
+	Members   []string  `json:"members" firestore:"members"` // 팀원 백준 ID
+	CreatedAt time.Time `json:"created_at" firestore:"createdAt"`
+}
+
+// TeamRule 팀 점수 집계 규칙입니다
+type TeamRule struct {
+	Aggregation string `json:"aggregation" firestore:"aggregation"`
+	TopN        int    `json:"topN,omitempty" firestore:"topN,omitempty"` // top_n 방식에서 합산할 인원
+}
+
+// TeamScore 팀 스코어보드의 한 행입니다
+type TeamScore struct {
+	Name    string      `json:"name"`
+	Members []ScoreData `json:"members"` // 점수 내림차순
+	Score   float64     `json:"score"`
+	Counted int         `json:"counted"` // 집계에 반영된 팀원 수
+}
+
+// DefaultTeamRule 팀 규칙이 없을 때 사용하는 합계 방식 규칙을 반환합니다
+func DefaultTeamRule() TeamRule {
+	return TeamRule{Aggregation: TeamAggregationSum}
+}
+
+// Validate 팀 집계 규칙이 올바른지 검증합니다
+func (t *TeamRule) Validate() error {
+	switch t.Aggregation {
+	case TeamAggregationSum, TeamAggregationAverage:
+		return nil
+	case TeamAggregationTopN:
+		if t.TopN < 1 {
+			return fmt.Errorf("top_n 팀 집계 방식은 1 이상의 topN이 필요합니다")
+		}
+		return nil
+	default:
+		return fmt.Errorf("알 수 없는 팀 집계 방식: %s (sum, average, top_n 중 하나)", t.Aggregation)
+	}
+}
+
+// Label 팀 집계 방식의 표시 이름을 반환합니다
+func (t TeamRule) Label() string {
+	switch t.Aggregation {
+	case TeamAggregationAverage:
+		return "평균"
+	case TeamAggregationTopN:
+		return fmt.Sprintf("상위 %d명 합계", t.TopN)
+	default:
+		return "합계"
+	}
+}
+
+// HasMember 백준 ID가 팀원인지 확인합니다 (대소문자 무시)
+func (t *Team) HasMember(baekjoonID string) bool {
+	for _, member := range t.Members {
+		if strings.EqualFold(member, baekjoonID) {
+			return true
+		}
+	}
+	return false
+}
+
+// TeamOf 참가자가 속한 팀을 찾습니다
+func TeamOf(teams []Team, baekjoonID string) (Team, bool) {
+	for _, team := range teams {
+		if team.HasMember(baekjoonID) {
+			return team, true
+		}
+	}
+	return Team{}, false
+}
+
+// AggregateTeamScores 팀원 점수를 규칙에 따라 집계하여 점수 내림차순(동점이면 팀 이름순)으로 반환합니다
+// 점수 데이터가 없는 팀원(삭제된 참가자 등)은 무시하며, 집계할 팀원이 없는 팀은 제외합니다
+func AggregateTeamScores(teams []Team, scores []ScoreData, rule TeamRule) []TeamScore {
+	byID := make(map[string]ScoreData, len(scores))
+	for _, score := range scores {
+		byID[strings.ToLower(score.BaekjoonID)] = score
+	}
+
+	results := make([]TeamScore, 0, len(teams))
+	for _, team := range teams {
+		members := make([]ScoreData, 0, len(team.Members))
+		for _, member := range team.Members {
+			if score, ok := byID[strings.ToLower(member)]; ok {
+				members = append(members, score)
+			}
+		}
+		if len(members) == 0 {
+			continue
+		}
+
+		sort.SliceStable(members, func(i, j int) bool {
+			if members[i].Score != members[j].Score {
+				return members[i].Score > members[j].Score
+			}
+			return members[i].BaekjoonID < members[j].BaekjoonID
+		})
+
+		counted := members
+		if rule.Aggregation == TeamAggregationTopN && len(counted) > rule.TopN {
+			counted = counted[:rule.TopN]
+		}
+
+		total := 0.0
+		for _, member := range counted {
+			total += member.Score
+		}
+		if rule.Aggregation == TeamAggregationAverage {
+			total /= float64(len(counted))
+		}
+
+		results = append(results, TeamScore{
+			Name:    team.Name,
+			Members: members,
+			Score:   total,
+			Counted: len(counted),
+		})
+	}
+
+	sort.SliceStable(results, func(i, j int) bool {
+		if results[i].Score != results[j].Score {
+			return results[i].Score > results[j].Score
+		}
+		return results[i].Name < results[j].Name
+	})
+	return results
+}
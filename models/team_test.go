@@ -0,0 +1,83 @@
+package models
+
+import "testing"
+
+func TestAggregateTeamScores(t *testing.T) {
+	teams := []Team{
+		{Name: "alpha", Members: []string{"a1", "A2", "a3"}},
+		{Name: "bravo", Members: []string{"b1", "ghost"}},
+		{Name: "empty", Members: []string{}},
+	}
+	scores := []ScoreData{
+		{BaekjoonID: "a1", Score: 10},
+		{BaekjoonID: "a2", Score: 30},
+		{BaekjoonID: "a3", Score: 20},
+		{BaekjoonID: "b1", Score: 45},
+	}
+
+	tests := []struct {
+		name      string
+		rule      TeamRule
+		expected  []string
+		topScore  float64
+		topCount  int
+		lastScore float64
+	}{
+		{"Sum", TeamRule{Aggregation: TeamAggregationSum}, []string{"alpha", "bravo"}, 60, 3, 45},
+		{"Average", TeamRule{Aggregation: TeamAggregationAverage}, []string{"bravo", "alpha"}, 45, 1, 20},
+		{"Top N", TeamRule{Aggregation: TeamAggregationTopN, TopN: 2}, []string{"alpha", "bravo"}, 50, 2, 45},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			results := AggregateTeamScores(teams, scores, test.rule)
+			if len(results) != len(test.expected) {
+				t.Fatalf("Expected %d teams, got %d", len(test.expected), len(results))
+			}
+			for i, name := range test.expected {
+				if results[i].Name != name {
+					t.Errorf("Expected %s at rank %d, got %s", name, i+1, results[i].Name)
+				}
+			}
+			if results[0].Score != test.topScore || results[0].Counted != test.topCount {
+				t.Errorf("Unexpected top team: %+v", results[0])
+			}
+			if results[1].Score != test.lastScore {
+				t.Errorf("Expected last team score %f, got %f", test.lastScore, results[1].Score)
+			}
+		})
+	}
+}
+
+func TestTeamRule_Validate(t *testing.T) {
+	valid := []TeamRule{
+		{Aggregation: TeamAggregationSum},
+		{Aggregation: TeamAggregationAverage},
+		{Aggregation: TeamAggregationTopN, TopN: 3},
+	}
+	for _, rule := range valid {
+		if err := rule.Validate(); err != nil {
+			t.Errorf("Expected %+v to be valid, got: %v", rule, err)
+		}
+	}
+
+	invalid := []TeamRule{
+		{Aggregation: "median"},
+		{Aggregation: TeamAggregationTopN},
+	}
+	for _, rule := range invalid {
+		if err := rule.Validate(); err == nil {
+			t.Errorf("Expected %+v to be invalid", rule)
+		}
+	}
+}
+
+func TestTeamOf(t *testing.T) {
+	teams := []Team{{Name: "alpha", Members: []string{"Alice"}}}
+	if team, ok := TeamOf(teams, "alice"); !ok || team.Name != "alpha" {
+		t.Errorf("Expected alice in alpha, got %+v", team)
+	}
+	if _, ok := TeamOf(teams, "bob"); ok {
+		t.Error("Expected bob to have no team")
+	}
+}
@@ -1,12 +1,14 @@
 package scheduler
 
 import (
+	"context"
 	"sync"
 	"time"
 
 	"github.com/ssugameworks/kkemi/bot"
 	"github.com/ssugameworks/kkemi/config"
 	"github.com/ssugameworks/kkemi/constants"
+	"github.com/ssugameworks/kkemi/models"
 	"github.com/ssugameworks/kkemi/sheets"
 	"github.com/ssugameworks/kkemi/utils"
 
@@ -21,11 +23,25 @@ type Scheduler struct {
 	ticker            *time.Ticker
 	customTicker      *time.Ticker
 	sheetsTicker      *time.Ticker
+	trackerTicker     *time.Ticker
+	competitionTicker *time.Ticker
 	stopChan          chan bool
 	customStopChan    chan bool
 	sheetsStopChan    chan bool
+	trackerStopChan   chan bool
+	competitionStop   chan bool
 	mu                sync.Mutex
 	stopped           bool
+	// lastSent 대회별 시각으로 스코어보드를 마지막으로 보낸 날짜 (중복 발송 방지)
+	lastSent map[string]string
+	// finalizeRetries 자동 확정에 실패한 대회의 재시도 상태 (key: 대회 ID)
+	finalizeRetries map[string]finalizeRetry
+}
+
+// finalizeRetry 자동 확정 실패 횟수와 다음 시도 시각입니다
+type finalizeRetry struct {
+	attempts int
+	next     time.Time
 }
 
 func NewScheduler(session *discordgo.Session, config *config.Config, scoreboardManager *bot.ScoreboardManager) *Scheduler {
@@ -43,6 +59,10 @@ func NewScheduler(session *discordgo.Session, config *config.Config, scoreboardM
 		stopChan:          make(chan bool),
 		customStopChan:    make(chan bool),
 		sheetsStopChan:    make(chan bool),
+		trackerStopChan:   make(chan bool),
+		competitionStop:   make(chan bool),
+		lastSent:          make(map[string]string),
+		finalizeRetries:   make(map[string]finalizeRetry),
 	}
 }
 
@@ -72,17 +92,59 @@ func (s *Scheduler) StartSheetsUpdate() {
 	s.sheetsTicker = time.NewTicker(30 * time.Minute)
 
 	go func() {
+		// 시작 직후 한 번 동기화하여 재시작 직후에도 명단으로 등록을 확인할 수 있게 함
+		s.syncRosters()
 		for {
 			select {
 			case <-s.sheetsTicker.C:
+				s.syncRosters()
 				s.updateSheetsScoreboard()
+				s.updateSeasonSheet()
 			case <-s.sheetsStopChan:
 				return
 			}
 		}
 	}()
 
-	utils.Info("Sheets update scheduler started (30-minute interval)")
+	utils.Info("Sheets update and roster sync scheduler started (30-minute interval)")
+}
+
+// StartSolveTracking 주기적으로 참가자들의 해결 문제를 조회하여 최초 발견 시각을 기록합니다
+func (s *Scheduler) StartSolveTracking() {
+	s.trackerTicker = time.NewTicker(constants.SolveTrackerInterval)
+
+	go func() {
+		// 시작 직후 한 번 수집하여 재시작 동안의 공백을 줄임
+		s.trackSolves()
+		for {
+			select {
+			case <-s.trackerTicker.C:
+				s.trackSolves()
+			case <-s.trackerStopChan:
+				return
+			}
+		}
+	}()
+
+	utils.Info("Solve tracker scheduler started (%s interval)", constants.SolveTrackerInterval)
+}
+
+// StartCompetitionSchedules 발송 시각을 따로 지정한 대회의 일일 스코어보드를 매분 확인하여 보냅니다
+func (s *Scheduler) StartCompetitionSchedules() {
+	s.competitionTicker = time.NewTicker(constants.CompetitionScheduleCheckInterval)
+
+	go func() {
+		for {
+			select {
+			case <-s.competitionTicker.C:
+				s.sendScheduledCompetitionScoreboards(utils.GetCurrentTimeKST())
+			case <-s.competitionStop:
+				return
+			}
+		}
+	}()
+
+	utils.Info("Per-competition scoreboard scheduler started")
 }
 
 func (s *Scheduler) StartCustomSchedule(hour, minute int) {
@@ -124,20 +186,55 @@ func (s *Scheduler) StartCustomSchedule(hour, minute int) {
 	utils.Info("Daily scoreboard scheduler set to run daily at %02d:%02d", hour, minute)
 }
 
+// sendDailyScoreboard 발송 시각을 따로 지정하지 않은 활성 대회들의 일일 스코어보드를 보냅니다
 func (s *Scheduler) sendDailyScoreboard() {
-	if s.config.Discord.ChannelID == "" {
-		utils.Error("Cannot send scoreboard: channel ID not configured")
+	competitions := s.scoreboardManager.GetStorage().GetActiveCompetitions()
+	if len(competitions) == 0 {
+		utils.Debug("No active competition - skipping daily scoreboard")
 		return
 	}
 
-	// 활성화된 대회가 있는지 확인
-	storage := s.scoreboardManager.GetStorage()
-	competition := storage.GetCompetition()
-	if competition == nil || !competition.IsActive {
-		utils.Debug("No active competition - skipping daily scoreboard")
+	for _, competition := range competitions {
+		if _, _, ok := competition.ScheduleTime(); ok {
+			continue
+		}
+		s.sendCompetitionScoreboard(competition)
+	}
+}
+
+// sendScheduledCompetitionScoreboards 지금이 발송 시각인 대회의 스코어보드를 하루 한 번 보냅니다
+func (s *Scheduler) sendScheduledCompetitionScoreboards(now time.Time) {
+	today := now.Format(constants.DateFormat)
+	for _, competition := range s.scoreboardManager.GetStorage().GetActiveCompetitions() {
+		hour, minute, ok := competition.ScheduleTime()
+		if !ok || now.Hour() != hour || now.Minute() != minute {
+			continue
+		}
+
+		s.mu.Lock()
+		alreadySent := s.lastSent[competition.ID] == today
+		s.lastSent[competition.ID] = today
+		s.mu.Unlock()
+
+		if !alreadySent {
+			s.sendCompetitionScoreboard(competition)
+		}
+	}
+}
+
+// sendCompetitionScoreboard 대회 채널(없으면 기본 채널)로 대회의 일일 스코어보드를 보냅니다
+func (s *Scheduler) sendCompetitionScoreboard(competition models.Competition) {
+	channelID := competition.ChannelID
+	if channelID == "" {
+		channelID = s.config.Discord.ChannelID
+	}
+	if channelID == "" {
+		utils.Error("Cannot send scoreboard for %s: channel ID not configured", competition.Name)
 		return
 	}
 
+	storage := s.scoreboardManager.GetStorage().ForCompetition(competition.ID)
+
 	// 대회 기간 내인지 확인
 	now := utils.GetCurrentTimeKST()
 	if now.Before(competition.StartDate) || now.After(competition.EndDate) {
@@ -150,18 +247,23 @@ func (s *Scheduler) sendDailyScoreboard() {
 		now.Month() == competition.EndDate.Month() &&
 		now.Day() == competition.EndDate.Day()
 
+	manager := s.scoreboardManager.ForStorage(storage)
 	if storage.IsBlackoutPeriod() && !isLastDay {
+		// 스코어보드는 보내지 않아도 점수 추이를 위해 스냅샷은 남깁니다
+		if err := manager.TakeSnapshot(); err != nil {
+			utils.Error("Failed to take score snapshot for %s: %v", competition.Name, err)
+		}
 		utils.Debug("Blackout period and not last day - skipping daily scoreboard")
 		return
 	}
 
-	err := s.scoreboardManager.SendDailyScoreboard(s.session, s.config.Discord.ChannelID)
+	err := manager.SendDailyScoreboard(s.session, channelID)
 	if err != nil {
-		utils.Error("Failed to send daily scoreboard: %v", err)
+		utils.Error("Failed to send daily scoreboard for %s: %v", competition.Name, err)
 		return
 	}
 
-	utils.Info("Daily scoreboard sent successfully")
+	utils.Info("Daily scoreboard sent successfully for %s", competition.Name)
 }
 
 func (s *Scheduler) updateSheetsScoreboard() {
@@ -170,7 +272,7 @@ func (s *Scheduler) updateSheetsScoreboard() {
 		return
 	}
 
-	// 활성화된 대회가 있는지 확인
+	// 스프레드시트는 하나이므로 기본 대회(가장 최근에 만든 활성 대회)만 기록
 	storage := s.scoreboardManager.GetStorage()
 	competition := storage.GetCompetition()
 	if competition == nil || !competition.IsActive {
@@ -200,7 +302,8 @@ func (s *Scheduler) updateSheetsScoreboard() {
 	}
 
 	// 스프레드시트 업데이트
-	err = s.sheetsClient.UpdateScoreboardSheet(constants.GetScoreboardSpreadsheetID(), scores)
+	teamScores, _ := s.scoreboardManager.TeamScores(scores)
+	err = s.sheetsClient.UpdateScoreboardSheet(constants.GetScoreboardSpreadsheetID(), scores, teamScores)
 	if err != nil {
 		utils.Error("Failed to update sheets: %v", err)
 		return
@@ -209,6 +312,153 @@ func (s *Scheduler) updateSheetsScoreboard() {
 	utils.Info("Successfully updated sheets scoreboard")
 }
 
+// updateSeasonSheet 현재 시즌 순위를 시즌 시트 탭에 기록합니다
+func (s *Scheduler) updateSeasonSheet() {
+	if s.sheetsClient == nil {
+		return
+	}
+
+	season, ok := models.CurrentSeason(s.scoreboardManager.GetStorage().GetSeasons(), utils.GetCurrentTimeKST())
+	if !ok {
+		utils.Debug("No season configured - skipping season sheet update")
+		return
+	}
+
+	standings, competitionCount, err := s.scoreboardManager.SeasonStandings(season)
+	if err != nil {
+		utils.Error("Failed to aggregate season standings for sheets: %v", err)
+		return
+	}
+
+	if err := s.sheetsClient.UpdateSeasonSheet(constants.GetScoreboardSpreadsheetID(), season, competitionCount, standings); err != nil {
+		utils.Error("Failed to update season sheet: %v", err)
+	}
+}
+
+// syncRosters 스프레드시트 동기화를 켠 활성 대회의 명단을 각자 지정한 시트와 맞추고, 바뀐 내용을 감사 로그에 남깁니다
+func (s *Scheduler) syncRosters() {
+	if s.sheetsClient == nil {
+		return
+	}
+
+	// 같은 시트를 쓰는 대회끼리 묶어 시트마다 한 번만 읽습니다
+	storage := s.scoreboardManager.GetStorage()
+	bySheet := make(map[string][]models.Competition)
+	for _, competition := range storage.GetActiveCompetitions() {
+		if competition.RosterSheetID != "" {
+			bySheet[competition.RosterSheetID] = append(bySheet[competition.RosterSheetID], competition)
+		}
+	}
+	if len(bySheet) == 0 {
+		utils.Debug("No competition syncs its roster from a spreadsheet - skipping roster sync")
+		return
+	}
+
+	for spreadsheetID, competitions := range bySheet {
+		names, err := s.sheetsClient.GetParticipantNames(spreadsheetID)
+		if err != nil {
+			utils.Error("Failed to read roster spreadsheet %s: %v", spreadsheetID, err)
+			continue
+		}
+		if len(names) == 0 {
+			// 시트를 잘못 읽어 명단이 통째로 지워지는 일을 막습니다
+			utils.Warn("Roster spreadsheet %s returned no names - skipping roster sync", spreadsheetID)
+			continue
+		}
+
+		for _, competition := range competitions {
+			scoped := storage.ForCompetition(competition.ID)
+			diff, err := bot.SyncRoster(scoped, models.RosterSourceSheets, names, constants.SystemComponentName)
+			if err != nil {
+				utils.Error("Failed to sync roster for %s: %v", competition.Name, err)
+				continue
+			}
+			if !diff.IsEmpty() {
+				bot.RecordRosterSync(s.session, scoped, s.config.Discord.AuditChannelID, competition, diff)
+			}
+		}
+	}
+}
+
+// trackSolves 모든 활성 대회의 참가자 해결 기록을 갱신합니다
+func (s *Scheduler) trackSolves() {
+	storage := s.scoreboardManager.GetStorage()
+	competitions := storage.GetActiveCompetitions()
+	if len(competitions) == 0 {
+		utils.Debug("No active competition - skipping solve tracking")
+		return
+	}
+
+	now := utils.GetCurrentTimeKST()
+	for _, competition := range competitions {
+		manager := s.scoreboardManager.ForStorage(storage.ForCompetition(competition.ID))
+
+		// 점수 인정 구간이 끝나고 유예 시간이 지난 대회는 결과를 확정하여 보관
+		if !now.Before(competition.ScoringWindowEnd().Add(constants.AutoFinalizeDelay)) {
+			s.finalizeCompetition(manager, competition, now)
+			continue
+		}
+
+		tracked, err := manager.GetSolveTracker().PollAll(context.Background())
+		if err != nil {
+			utils.Error("Failed to track solves for %s: %v", competition.Name, err)
+			continue
+		}
+
+		utils.Debug("Tracked solves for %d participants in %s", tracked, competition.Name)
+	}
+}
+
+// finalizeCompetition 끝난 대회의 결과를 확정하고 최종 스코어보드를 대회 채널로 보냅니다.
+// 실패하면 간격을 두 배씩 늘려 다시 시도하고, 마지막 시도에서는 조회에 실패한 참가자를 마지막 기록으로 채워 확정합니다.
+func (s *Scheduler) finalizeCompetition(manager *bot.ScoreboardManager, competition models.Competition, now time.Time) {
+	retry := s.finalizeRetries[competition.ID]
+	if now.Before(retry.next) {
+		return
+	}
+
+	allowPartial := retry.attempts+1 >= constants.MaxAutoFinalizeAttempts
+	results, failed, err := manager.FinalizeCompetition(allowPartial)
+	if err != nil {
+		retry.attempts++
+		delay := finalizeRetryDelay(retry.attempts)
+		retry.next = now.Add(delay)
+		s.finalizeRetries[competition.ID] = retry
+		utils.Error("Failed to finalize competition %s (attempt %d): %v", competition.Name, retry.attempts, err)
+		bot.ReportFinalizeRetry(s.session, s.config.Discord.AuditChannelID, competition, retry.attempts, delay, failed, err)
+		return
+	}
+	delete(s.finalizeRetries, competition.ID)
+	utils.Info("Finalized competition %s with %d results", competition.Name, len(results))
+	bot.RecordAutoFinalize(s.session, manager.GetStorage(), s.config.Discord.AuditChannelID, competition, results, failed)
+
+	channelID := competition.ChannelID
+	if channelID == "" {
+		channelID = s.config.Discord.ChannelID
+	}
+	finalized := manager.GetStorage().GetCompetition()
+	if channelID == "" || finalized == nil || len(results) == 0 {
+		return
+	}
+
+	embed := manager.FormatArchivedScoreboard(finalized, results)
+	if err := bot.SendScoreboardEmbed(s.session, channelID, embed, nil); err != nil {
+		utils.Error("DISCORD API ERROR: Failed to send final results for %s: %v", competition.Name, err)
+	}
+}
+
+// finalizeRetryDelay 실패 횟수에 따른 다음 자동 확정 시도까지의 대기 시간입니다 (최대 AutoFinalizeDelay)
+func finalizeRetryDelay(attempts int) time.Duration {
+	delay := constants.AutoFinalizeRetryDelay
+	for i := 1; i < attempts && delay < constants.AutoFinalizeDelay; i++ {
+		delay *= 2
+	}
+	if delay > constants.AutoFinalizeDelay {
+		delay = constants.AutoFinalizeDelay
+	}
+	return delay
+}
+
 func (s *Scheduler) Stop() {
 	s.mu.Lock()
 	defer s.mu.Unlock()
@@ -225,6 +475,8 @@ func (s *Scheduler) Stop() {
 
 	s.stopCustomSchedulerUnsafe()
 	s.stopSheetsSchedulerUnsafe()
+	s.stopTrackerSchedulerUnsafe()
+	s.stopCompetitionSchedulerUnsafe()
 
 	// 채널 정리 - 논블로킹으로 신호 전송
 	select {
@@ -266,3 +518,29 @@ func (s *Scheduler) stopSheetsSchedulerUnsafe() {
 	default:
 	}
 }
+
+func (s *Scheduler) stopTrackerSchedulerUnsafe() {
+	if s.trackerTicker != nil {
+		s.trackerTicker.Stop()
+		s.trackerTicker = nil
+	}
+
+	// 채널 정리 - 논블로킹으로 신호 전송
+	select {
+	case s.trackerStopChan <- true:
+	default:
+	}
+}
+
+func (s *Scheduler) stopCompetitionSchedulerUnsafe() {
+	if s.competitionTicker != nil {
+		s.competitionTicker.Stop()
+		s.competitionTicker = nil
+	}
+
+	// 채널 정리 - 논블로킹으로 신호 전송
+	select {
+	case s.competitionStop <- true:
+	default:
+	}
+}
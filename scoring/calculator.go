@@ -3,142 +3,343 @@ package scoring
 import (
 	"context"
 	"math"
+	"sort"
+	"time"
 
 	"github.com/ssugameworks/kkemi/api"
-	"github.com/ssugameworks/kkemi/constants"
 	"github.com/ssugameworks/kkemi/interfaces"
 	"github.com/ssugameworks/kkemi/models"
+	"github.com/ssugameworks/kkemi/utils"
 )
 
 type ScoreCalculator struct {
 	client      interfaces.APIClient
 	tierManager *models.TierManager
+	rules       *models.ScoringRules
+	// targets 문제 목록 대회의 채점 대상 문제 (nil이면 모든 문제 채점)
+	targets map[int]models.TargetProblem
 }
 
 func NewScoreCalculator(apiClient interfaces.APIClient, tierManager *models.TierManager) interfaces.ScoreCalculator {
+	return NewScoreCalculatorWithRules(apiClient, tierManager, models.DefaultScoringRules())
+}
+
+// NewScoreCalculatorWithRules 대회에 규칙이 없을 때 사용할 기본 규칙을 지정하여 생성합니다
+func NewScoreCalculatorWithRules(apiClient interfaces.APIClient, tierManager *models.TierManager, rules *models.ScoringRules) interfaces.ScoreCalculator {
 	return &ScoreCalculator{
 		client:      apiClient,
 		tierManager: tierManager,
+		rules:       rules,
+	}
+}
+
+// ForCompetition 대회에 저장된 규칙이 있으면 그 규칙으로 평가하는 계산기를 반환합니다
+func (calculator *ScoreCalculator) ForCompetition(competition *models.Competition) interfaces.ScoreCalculator {
+	if competition == nil || (competition.ScoringRules == nil && !competition.IsProblemSet()) {
+		return calculator
+	}
+
+	rules := calculator.rules
+	if competition.ScoringRules != nil {
+		rules = competition.ScoringRules
+	}
+	return &ScoreCalculator{
+		client:      calculator.client,
+		tierManager: calculator.tierManager,
+		rules:       rules,
+		targets:     competition.TargetProblemMap(),
+	}
+}
+
+// GetRules 현재 평가에 사용하는 규칙을 반환합니다
+func (calculator *ScoreCalculator) GetRules() *models.ScoringRules {
+	if calculator.rules == nil {
+		return models.DefaultScoringRules()
 	}
+	return calculator.rules
 }
 
 func (calculator *ScoreCalculator) CalculateScore(ctx context.Context, handle string, startTier int, startProblemIDs []int) (float64, error) {
-	top100, err := calculator.client.GetUserTop100(ctx, handle)
+	solved, err := calculator.client.GetUserSolvedProblems(ctx, handle)
 	if err != nil {
 		return 0, err
 	}
 
-	return calculator.CalculateScoreWithTop100(top100, startTier, startProblemIDs), nil
+	return calculator.CalculateScoreWithSolved(solved, startTier, startProblemIDs), nil
 }
 
+// CalculateScoreWithTop100 TOP 100 응답만으로 점수를 계산합니다
+//
+// Deprecated: TOP 100 밖의 신규 문제가 누락되므로 CalculateScoreWithSolved 사용을 권장합니다
 func (calculator *ScoreCalculator) CalculateScoreWithTop100(top100 *api.Top100Response, startTier int, startProblemIDs []int) float64 {
-	// 시작 시점 문제 ID들을 맵으로 변환
-	startProblemsMap := make(map[int]bool)
-	for _, id := range startProblemIDs {
-		startProblemsMap[id] = true
-	}
+	return calculator.CalculateScoreWithSolved(top100.Items, startTier, startProblemIDs)
+}
+
+// CalculateScoreWithSolved 전체 해결 문제 목록과 등록 시점 스냅샷을 비교하여 점수를 계산합니다
+func (calculator *ScoreCalculator) CalculateScoreWithSolved(solved []api.ProblemInfo, startTier int, startProblemIDs []int) float64 {
+	return calculator.CalculateBreakdown(solved, startTier, startProblemIDs).Total
+}
+
+// CalculateBreakdown 점수에 반영된 문제별 분류, 가중치, 기여 점수를 계산합니다
+func (calculator *ScoreCalculator) CalculateBreakdown(solved []api.ProblemInfo, startTier int, startProblemIDs []int) *models.ScoreBreakdown {
+	rules := calculator.GetRules()
 
 	// 참가자의 리그 결정 (등록 시점 티어 기준)
 	userLeague := calculator.getUserLeague(startTier)
 
-	totalScore := 0.0
+	breakdown := &models.ScoreBreakdown{
+		League:                  userLeague,
+		LeagueName:              calculator.GetLeagueName(userLeague),
+		Mode:                    rules.EffectiveMode(),
+		Entries:                 make([]models.BreakdownEntry, 0),
+		ExcludedPreRegistration: countPreRegistration(solved, startProblemIDs),
+	}
 
-	for _, problem := range top100.Items {
-		// 참가 시점에 이미 해결한 문제는 제외
-		if startProblemsMap[problem.ProblemID] {
+	classProblems := calculator.loadBonusClasses(rules)
+
+	for _, problem := range newProblems(solved, startProblemIDs) {
+		// 문제 목록 대회는 목록에 있는 문제만 배점대로 채점
+		if calculator.targets != nil {
+			target, ok := calculator.targets[problem.ProblemID]
+			if !ok {
+				breakdown.ExcludedNotInSet++
+				continue
+			}
+			breakdown.Entries = append(breakdown.Entries, targetEntry(target, problem, startTier))
+			breakdown.RawTotal += target.Points
 			continue
 		}
 
 		problemLevel := problem.Level
-		// solved.ac 난이도 값을 그대로 점수로 사용 (Bronze V = 1, Bronze IV = 2, ...)
-		difficultyValue := float64(problemLevel)
-		if difficultyValue == 0 {
+		// Unranked 문제는 점수표와 관계없이 제외
+		if problemLevel == 0 {
+			breakdown.ExcludedUnranked++
 			continue
 		}
 
-		// 새로운 가중치 계산 (리그별 + 문제 난이도 vs 시작 티어)
+		basePoints := rules.PointsFor(problemLevel)
+		// 리그별 가중치 (문제 난이도 vs 시작 티어)
 		weight := calculator.getWeightByLeague(problemLevel, startTier, userLeague)
-		score := difficultyValue * weight
-		totalScore += score
+		entry := models.BreakdownEntry{
+			ProblemID:       problem.ProblemID,
+			Level:           problemLevel,
+			Category:        models.ProblemCategory(problemLevel, startTier),
+			BasePoints:      basePoints,
+			Weight:          weight,
+			BonusMultiplier: 1,
+		}
+
+		// 태그/클래스 보너스 (대회 규칙에 보너스가 있을 때만 메타데이터 조회)
+		if len(rules.Bonuses) > 0 {
+			inClass := func(class int) bool { return classProblems[class][problem.ProblemID] }
+			if bonus := rules.BonusFor(calculator.problemTagKeys(rules, problem), inClass); bonus != nil {
+				entry.Bonus = bonus.Label()
+				entry.BonusMultiplier = bonus.Multiplier
+			}
+		}
+
+		contribution := basePoints * weight * entry.BonusMultiplier
+		entry.Contribution = contribution
+
+		breakdown.Entries = append(breakdown.Entries, entry)
+		breakdown.RawTotal += contribution
 	}
 
+	sort.SliceStable(breakdown.Entries, func(i, j int) bool {
+		if breakdown.Entries[i].Contribution != breakdown.Entries[j].Contribution {
+			return breakdown.Entries[i].Contribution > breakdown.Entries[j].Contribution
+		}
+		return breakdown.Entries[i].ProblemID < breakdown.Entries[j].ProblemID
+	})
+
 	// 최종 점수는 반올림하여 정수로 반환 (테스트 기대치와 일치)
-	return math.Round(totalScore)
+	breakdown.Total = math.Round(breakdown.RawTotal)
+	return breakdown
 }
 
-// getUserLeague 사용자의 등록 시점 티어를 기준으로 리그를 결정합니다
-func (calculator *ScoreCalculator) getUserLeague(startTier int) int {
-	// 루키: Unrated ~ Silver V (티어 0-6)
-	if startTier <= 6 {
-		return constants.LeagueRookie
+// CountNewProblems 등록 이후 새로 해결한 문제 수를 반환합니다
+func (calculator *ScoreCalculator) CountNewProblems(solved []api.ProblemInfo, startProblemIDs []int) int {
+	problems := newProblems(solved, startProblemIDs)
+	if calculator.targets == nil {
+		return len(problems)
 	}
-	// 프로: Silver IV ~ Gold V (티어 7-11)
-	if startTier <= 11 {
-		return constants.LeaguePro
+
+	count := 0
+	for _, problem := range problems {
+		if _, ok := calculator.targets[problem.ProblemID]; ok {
+			count++
+		}
 	}
-	// 마스터: Gold IV ~ (티어 12 이상)
-	return constants.LeagueMaster
+	return count
 }
 
-// getWeightByLeague 리그별 가중치를 계산합니다
-func (calculator *ScoreCalculator) getWeightByLeague(problemLevel, startTier, userLeague int) float64 {
-	switch userLeague {
-	case constants.LeagueRookie:
-		return calculator.getRookieWeight(problemLevel, startTier)
-	case constants.LeaguePro:
-		return calculator.getProWeight(problemLevel, startTier)
-	case constants.LeagueMaster:
-		return calculator.getMasterWeight(problemLevel, startTier)
-	default:
-		return 1.0
+// targetEntry 문제 목록 대회의 대상 문제를 가중치·보너스 없이 배점 그대로 내역으로 만듭니다
+func targetEntry(target models.TargetProblem, problem api.ProblemInfo, startTier int) models.BreakdownEntry {
+	return models.BreakdownEntry{
+		ProblemID:       problem.ProblemID,
+		Label:           target.Label,
+		Level:           problem.Level,
+		Category:        models.ProblemCategory(problem.Level, startTier),
+		BasePoints:      target.Points,
+		Weight:          1,
+		BonusMultiplier: 1,
+		Contribution:    target.Points,
+	}
+}
+
+// FilterSolvesInWindow 최초 발견 시각이 대회 기간 [StartDate, EndDate] 안에 있는 문제만 남깁니다
+// 발견 기록이 없는 문제는 해결 시점을 알 수 없으므로 인정하지 않습니다
+func (calculator *ScoreCalculator) FilterSolvesInWindow(solved []api.ProblemInfo, firstSeen map[int]time.Time, competition *models.Competition) []api.ProblemInfo {
+	result := make([]api.ProblemInfo, 0, len(solved))
+	for _, problem := range solved {
+		seenAt, ok := firstSeen[problem.ProblemID]
+		if !ok || !competition.IsInScoringWindow(seenAt) {
+			continue
+		}
+		result = append(result, problem)
+	}
+	return result
+}
+
+// CalculateStreak 대회 기간 내 새로 해결한 문제의 최초 발견일로 연속 해결 기록과 보너스를 계산합니다
+func (calculator *ScoreCalculator) CalculateStreak(solved []api.ProblemInfo, firstSeen map[int]time.Time, startProblemIDs []int, today time.Time) models.StreakStats {
+	solveDays := make(map[int]bool)
+	for _, problem := range newProblems(solved, startProblemIDs) {
+		if seenAt, ok := firstSeen[problem.ProblemID]; ok {
+			solveDays[models.KSTDayIndex(seenAt)] = true
+		}
 	}
+
+	stats := models.ComputeStreak(solveDays, models.KSTDayIndex(today))
+	stats.Bonus = calculator.GetRules().StreakBonusFor(stats.Longest)
+	return stats
+}
+
+// CalculateGrowth 등록 시점 대비 레이팅/티어 변화와 규칙에 따른 성장 점수를 계산합니다
+func (calculator *ScoreCalculator) CalculateGrowth(startTier, startRating, currentTier, currentRating int) models.GrowthStats {
+	growth := models.GrowthStats{
+		RatingGain: currentRating - startRating,
+		TierGain:   currentTier - startTier,
+	}
+	growth.Bonus = calculator.GetRules().GrowthBonusFor(growth.RatingGain, growth.TierGain)
+	return growth
+}
+
+// loadBonusClasses 보너스가 걸린 클래스별 소속 문제 집합을 가져옵니다 (조회 실패한 클래스는 보너스 없음)
+func (calculator *ScoreCalculator) loadBonusClasses(rules *models.ScoringRules) map[int]map[int]bool {
+	classProblems := make(map[int]map[int]bool)
+	if calculator.client == nil {
+		return classProblems
+	}
+
+	for _, class := range rules.BonusClasses() {
+		if _, loaded := classProblems[class]; loaded {
+			continue
+		}
+		problemIDs, err := calculator.client.GetClassProblemIDs(context.Background(), class)
+		if err != nil {
+			utils.Warn("Failed to fetch class %d problems for bonus: %v", class, err)
+			continue
+		}
+		problemSet := make(map[int]bool, len(problemIDs))
+		for _, id := range problemIDs {
+			problemSet[id] = true
+		}
+		classProblems[class] = problemSet
+	}
+	return classProblems
+}
+
+// problemTagKeys 문제의 태그 키 목록을 반환합니다 (목록에 태그가 없으면 캐시된 문제 정보로 보충)
+func (calculator *ScoreCalculator) problemTagKeys(rules *models.ScoringRules, problem api.ProblemInfo) []string {
+	tags := problem.Tags
+	if len(tags) == 0 && rules.HasTagBonuses() && calculator.client != nil {
+		info, err := calculator.client.GetProblemInfo(context.Background(), problem.ProblemID)
+		if err != nil {
+			utils.Warn("Failed to fetch problem %d info for bonus: %v", problem.ProblemID, err)
+			return nil
+		}
+		tags = info.Tags
+	}
+
+	keys := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		keys = append(keys, tag.Key)
+	}
+	return keys
+}
+
+// CountPreRegistration 등록 시점 스냅샷에 포함되어 제외된 문제 수를 반환합니다
+// 스냅샷 문제는 최초 발견 기록이 없어 기간 필터에서 빠지므로 필터링 전 전체 해결 목록을 넘겨야 합니다
+func (calculator *ScoreCalculator) CountPreRegistration(solved []api.ProblemInfo, startProblemIDs []int) int {
+	return countPreRegistration(solved, startProblemIDs)
+}
+
+// countPreRegistration 등록 시점 스냅샷에 포함되어 제외된 문제 수를 셉니다
+func countPreRegistration(solved []api.ProblemInfo, startProblemIDs []int) int {
+	startProblemsMap := make(map[int]bool, len(startProblemIDs))
+	for _, id := range startProblemIDs {
+		startProblemsMap[id] = true
+	}
+
+	counted := make(map[int]bool)
+	for _, problem := range solved {
+		if startProblemsMap[problem.ProblemID] {
+			counted[problem.ProblemID] = true
+		}
+	}
+	return len(counted)
 }
 
-// getRookieWeight 루키 리그 가중치를 계산합니다
-func (calculator *ScoreCalculator) getRookieWeight(problemLevel, startTier int) float64 {
-	if problemLevel > startTier {
-		return constants.RookieUpperMultiplier // × 1.4
-	} else if problemLevel == startTier {
-		return constants.RookieBaseMultiplier // × 1.0
-	} else {
-		return constants.RookieLowerMultiplier // × 0.5
+// newProblems 등록 시점 스냅샷에 없는 문제만 중복 없이 골라냅니다
+func newProblems(solved []api.ProblemInfo, startProblemIDs []int) []api.ProblemInfo {
+	// 시작 시점 문제 ID들을 맵으로 변환
+	startProblemsMap := make(map[int]bool, len(startProblemIDs))
+	for _, id := range startProblemIDs {
+		startProblemsMap[id] = true
 	}
+
+	counted := make(map[int]bool)
+	result := make([]api.ProblemInfo, 0)
+	for _, problem := range solved {
+		// 참가 시점에 이미 해결한 문제는 제외
+		if startProblemsMap[problem.ProblemID] {
+			continue
+		}
+		// 같은 문제는 한 번만 인정
+		if counted[problem.ProblemID] {
+			continue
+		}
+		counted[problem.ProblemID] = true
+		result = append(result, problem)
+	}
+	return result
 }
 
-// getProWeight 프로 리그 가중치를 계산합니다
-func (calculator *ScoreCalculator) getProWeight(problemLevel, startTier int) float64 {
-	if problemLevel > startTier {
-		return constants.ProUpperMultiplier // × 1.2
-	} else if problemLevel == startTier {
-		return constants.ProBaseMultiplier // × 1.0
-	} else {
-		return constants.ProLowerMultiplier // × 0.8
+// getUserLeague 사용자의 등록 시점 티어를 기준으로 리그를 결정합니다
+func (calculator *ScoreCalculator) getUserLeague(startTier int) int {
+	rules := calculator.GetRules()
+	if league := rules.LeagueFor(startTier); league != nil {
+		return league.ID
 	}
+	return rules.Leagues[0].ID
 }
 
-// getMasterWeight 마스터 리그 가중치를 계산합니다
-func (calculator *ScoreCalculator) getMasterWeight(problemLevel, startTier int) float64 {
-	if problemLevel > startTier {
-		return constants.MasterUpperMultiplier // × 1.0
-	} else if problemLevel == startTier {
-		return constants.MasterBaseMultiplier // × 1.0
-	} else {
-		return constants.MasterLowerMultiplier // × 1.0
+// getWeightByLeague 리그 규칙에 정의된 상위/동일/하위 가중치를 계산합니다
+func (calculator *ScoreCalculator) getWeightByLeague(problemLevel, startTier, userLeague int) float64 {
+	league := calculator.GetRules().LeagueByID(userLeague)
+	if league == nil {
+		return 1.0
 	}
+	return league.Multiplier(problemLevel, startTier)
 }
 
 // GetLeagueName 리그 번호를 리그 이름으로 변환합니다
 func (calculator *ScoreCalculator) GetLeagueName(league int) string {
-	switch league {
-	case constants.LeagueRookie:
-		return "루키"
-	case constants.LeaguePro:
-		return "프로"
-	case constants.LeagueMaster:
-		return "마스터"
-	default:
-		return "알 수 없음"
+	if rule := calculator.GetRules().LeagueByID(league); rule != nil {
+		return rule.Name
 	}
+	return "알 수 없음"
 }
 
 // GetUserLeague 외부에서 사용할 수 있도록 노출합니다
@@ -4,14 +4,18 @@ import (
 	"context"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/ssugameworks/kkemi/api"
+	"github.com/ssugameworks/kkemi/constants"
 	"github.com/ssugameworks/kkemi/models"
 )
 
 type mockAPIClient struct {
 	userInfo *api.UserInfo
 	top100   *api.Top100Response
+	solved   []api.ProblemInfo
+	classes  map[int][]int
 	err      error
 }
 
@@ -31,6 +35,32 @@ func (m *mockAPIClient) GetUserOrganizations(ctx context.Context, handle string)
 	return []api.Organization{}, nil
 }
 
+func (m *mockAPIClient) GetOrganization(ctx context.Context, organizationID int) (*api.Organization, error) {
+	return nil, nil
+}
+
+func (m *mockAPIClient) GetUserSolvedProblems(ctx context.Context, handle string) ([]api.ProblemInfo, error) {
+	if m.err != nil {
+		return nil, m.err
+	}
+	if m.solved != nil {
+		return m.solved, nil
+	}
+	return m.top100.Items, nil
+}
+
+func (m *mockAPIClient) GetProblemInfo(ctx context.Context, problemID int) (*api.ProblemInfo, error) {
+	return &api.ProblemInfo{ProblemID: problemID}, nil
+}
+
+func (m *mockAPIClient) GetClassProblemIDs(ctx context.Context, class int) ([]int, error) {
+	return m.classes[class], nil
+}
+
+func (m *mockAPIClient) SearchProblems(ctx context.Context, query string, page int) (*api.ProblemSearchResponse, error) {
+	return &api.ProblemSearchResponse{}, nil
+}
+
 func TestScoreCalculator_CalculateScore(t *testing.T) {
 	tierManager := models.NewTierManager()
 
@@ -237,3 +267,245 @@ func TestScoreCalculator_Integration(t *testing.T) {
 		}
 	})
 }
+
+func TestScoreCalculator_CalculateScoreWithSolved(t *testing.T) {
+	tierManager := models.NewTierManager()
+	calculator := &ScoreCalculator{
+		tierManager: tierManager,
+	}
+
+	// TOP 100 밖으로 밀려나는 쉬운 문제도 전체 해결 목록에는 포함됩니다
+	solved := []api.ProblemInfo{
+		{ProblemID: 1000, Level: 1},  // Bronze V (루키 리그 하위 티어)
+		{ProblemID: 1001, Level: 6},  // Silver V (루키 리그 동일 티어)
+		{ProblemID: 1002, Level: 8},  // Silver III (루키 리그 상위 티어)
+		{ProblemID: 1002, Level: 8},  // 페이지 경계에서 중복 수신된 문제
+		{ProblemID: 2000, Level: 10}, // 등록 전에 해결한 문제
+	}
+
+	t.Run("Every new problem counted exactly once", func(t *testing.T) {
+		score := calculator.CalculateScoreWithSolved(solved, 6, []int{2000})
+		// 1*0.5 + 6*1.0 + 8*1.4 = 0.5 + 6 + 11.2 = 17.7 → 18
+		if score != 18 {
+			t.Errorf("Expected score 18, got %f", score)
+		}
+	})
+
+	t.Run("New problem count excludes snapshot and duplicates", func(t *testing.T) {
+		count := calculator.CountNewProblems(solved, []int{2000})
+		if count != 3 {
+			t.Errorf("Expected 3 new problems, got %d", count)
+		}
+	})
+}
+
+func TestScoreCalculator_FilterSolvesInWindow(t *testing.T) {
+	calculator := &ScoreCalculator{tierManager: models.NewTierManager()}
+
+	kst := time.FixedZone("KST", 9*60*60)
+	competition := &models.Competition{
+		StartDate: time.Date(2025, 3, 1, 0, 0, 0, 0, kst),
+		EndDate:   time.Date(2025, 3, 31, 0, 0, 0, 0, kst),
+	}
+
+	solved := []api.ProblemInfo{
+		{ProblemID: 1, Level: 5}, // 시작 전에 발견
+		{ProblemID: 2, Level: 5}, // 시작 시각에 발견
+		{ProblemID: 3, Level: 5}, // 종료일 당일 밤에 발견
+		{ProblemID: 4, Level: 5}, // 종료일 다음 날 발견
+		{ProblemID: 5, Level: 5}, // 발견 기록 없음
+	}
+	firstSeen := map[int]time.Time{
+		1: time.Date(2025, 2, 28, 23, 59, 0, 0, kst),
+		2: time.Date(2025, 3, 1, 0, 0, 0, 0, kst),
+		3: time.Date(2025, 3, 31, 23, 59, 0, 0, kst),
+		4: time.Date(2025, 4, 1, 0, 0, 0, 0, kst),
+	}
+
+	filtered := calculator.FilterSolvesInWindow(solved, firstSeen, competition)
+	if len(filtered) != 2 || filtered[0].ProblemID != 2 || filtered[1].ProblemID != 3 {
+		t.Errorf("Expected problems [2 3] inside window, got %+v", filtered)
+	}
+}
+
+func TestScoreCalculator_ForCompetition(t *testing.T) {
+	tierManager := models.NewTierManager()
+	base := NewScoreCalculator(&mockAPIClient{}, tierManager)
+
+	customRules := &models.ScoringRules{
+		Name: "단일 리그",
+		Leagues: []models.LeagueRule{
+			{ID: 0, Name: "통합", MinTier: 0, MaxTier: 31, UpperMultiplier: 2, SameMultiplier: 1, LowerMultiplier: 0},
+		},
+		TierPoints: models.TierPointsFromTierManager(tierManager),
+	}
+
+	solved := []api.ProblemInfo{
+		{ProblemID: 1, Level: 12}, // Gold IV: 20점, 상위 ×2
+		{ProblemID: 2, Level: 11}, // Gold V: 18점, 동일 ×1
+		{ProblemID: 3, Level: 10}, // Silver I: 16점, 하위 ×0
+	}
+
+	t.Run("Competition without rules keeps base calculator", func(t *testing.T) {
+		calculator := base.ForCompetition(&models.Competition{})
+		if calculator.GetRules().Name != models.DefaultScoringRules().Name {
+			t.Errorf("Expected default rules, got %s", calculator.GetRules().Name)
+		}
+	})
+
+	t.Run("Competition rules drive leagues, weights and points", func(t *testing.T) {
+		calculator := base.ForCompetition(&models.Competition{ScoringRules: customRules})
+		if league := calculator.GetUserLeague(20); calculator.GetLeagueName(league) != "통합" {
+			t.Errorf("Expected single league, got %s", calculator.GetLeagueName(league))
+		}
+		// 20*2 + 18*1 + 16*0 = 58
+		if score := calculator.CalculateScoreWithSolved(solved, 11, nil); score != 58 {
+			t.Errorf("Expected score 58, got %f", score)
+		}
+	})
+}
+
+func TestScoreCalculator_CalculateBreakdown(t *testing.T) {
+	calculator := &ScoreCalculator{tierManager: models.NewTierManager()}
+
+	solved := []api.ProblemInfo{
+		{ProblemID: 1000, Level: 5}, // 등록 전 해결
+		{ProblemID: 2000, Level: 8}, // 도전: 8 × 1.4
+		{ProblemID: 3000, Level: 6}, // 기본: 6 × 1.0
+		{ProblemID: 4000, Level: 3}, // 연습: 3 × 0.5
+		{ProblemID: 5000, Level: 0}, // Unranked
+	}
+
+	breakdown := calculator.CalculateBreakdown(solved, 6, []int{1000})
+
+	if len(breakdown.Entries) != 3 {
+		t.Fatalf("Expected 3 counted problems, got %d", len(breakdown.Entries))
+	}
+	if breakdown.ExcludedPreRegistration != 1 {
+		t.Errorf("Expected 1 pre-registration problem, got %d", breakdown.ExcludedPreRegistration)
+	}
+	if breakdown.ExcludedUnranked != 1 {
+		t.Errorf("Expected 1 unranked problem, got %d", breakdown.ExcludedUnranked)
+	}
+
+	first := breakdown.Entries[0]
+	if first.ProblemID != 2000 || first.Category != models.ProblemCategoryChallenge || first.Weight != constants.RookieUpperMultiplier {
+		t.Errorf("Expected challenge problem 2000 first, got %+v", first)
+	}
+	if breakdown.Entries[2].Category != models.ProblemCategoryPractice {
+		t.Errorf("Expected practice problem last, got %+v", breakdown.Entries[2])
+	}
+
+	if breakdown.Total != calculator.CalculateScoreWithSolved(solved, 6, []int{1000}) {
+		t.Errorf("Breakdown total %f does not match score", breakdown.Total)
+	}
+}
+
+func TestScoreCalculator_CalculateBreakdownWithBonuses(t *testing.T) {
+	rules := models.DefaultScoringRules()
+	rules.Bonuses = []models.BonusRule{
+		{Tag: "dp", Multiplier: 2},
+		{Class: 3, Multiplier: 1.5},
+	}
+	client := &mockAPIClient{classes: map[int][]int{3: {3000}}}
+	calculator := NewScoreCalculatorWithRules(client, models.NewTierManager(), rules)
+
+	solved := []api.ProblemInfo{
+		{ProblemID: 2000, Level: 6, Tags: []api.ProblemTag{{Key: "dp"}}}, // 6 × 1.0 × 2
+		{ProblemID: 3000, Level: 6},                                      // 6 × 1.0 × 1.5
+		{ProblemID: 4000, Level: 6},                                      // 6 × 1.0
+	}
+
+	breakdown := calculator.CalculateBreakdown(solved, 6, nil)
+
+	bonuses := make(map[int]string)
+	for _, entry := range breakdown.Entries {
+		bonuses[entry.ProblemID] = entry.Bonus
+	}
+	if bonuses[2000] != "#dp" || bonuses[3000] != "CLASS 3" || bonuses[4000] != "" {
+		t.Errorf("Unexpected bonuses applied: %v", bonuses)
+	}
+	if breakdown.Total != 27 {
+		t.Errorf("Expected total 27 (12 + 9 + 6), got %f", breakdown.Total)
+	}
+	if count, extra := breakdown.BonusTotals(); count != 2 || extra != 9 {
+		t.Errorf("Expected 2 bonus problems adding 9 points, got %d / %f", count, extra)
+	}
+}
+
+func TestScoreCalculator_CalculateStreak(t *testing.T) {
+	rules := models.DefaultScoringRules()
+	rules.StreakBonuses = []models.StreakBonus{{Days: 3, Points: 5}, {Days: 7, Points: 10}}
+	calculator := NewScoreCalculatorWithRules(&mockAPIClient{}, models.NewTierManager(), rules)
+
+	kst := time.FixedZone("KST", 9*60*60)
+	day := func(d int) time.Time { return time.Date(2025, 3, d, 21, 0, 0, 0, kst) }
+
+	solved := []api.ProblemInfo{
+		{ProblemID: 1000, Level: 5},
+		{ProblemID: 2000, Level: 5},
+		{ProblemID: 3000, Level: 5},
+		{ProblemID: 4000, Level: 5},
+	}
+	firstSeen := map[int]time.Time{
+		1000: day(1), // 등록 전 해결 (스냅샷) - 제외
+		2000: day(2),
+		3000: day(3),
+		4000: day(4),
+	}
+
+	stats := calculator.CalculateStreak(solved, firstSeen, []int{1000}, day(4))
+	if stats.Current != 3 || stats.Longest != 3 {
+		t.Errorf("Expected 3 day streak, got %+v", stats)
+	}
+	if stats.Bonus != 5 {
+		t.Errorf("Expected streak bonus 5, got %f", stats.Bonus)
+	}
+}
+
+func TestScoreCalculator_CalculateGrowth(t *testing.T) {
+	rules := models.DefaultScoringRules()
+	rules.Growth = &models.GrowthRule{PointsPerRating: 0.5, TierUpBonus: 20}
+	calculator := NewScoreCalculatorWithRules(&mockAPIClient{}, models.NewTierManager(), rules)
+
+	growth := calculator.CalculateGrowth(10, 800, 11, 900)
+	if growth.RatingGain != 100 || growth.TierGain != 1 {
+		t.Errorf("Unexpected growth: %+v", growth)
+	}
+	if growth.Bonus != 70 {
+		t.Errorf("Expected growth bonus 70 (50 + 20), got %f", growth.Bonus)
+	}
+}
+
+func TestScoreCalculator_ProblemSetBreakdown(t *testing.T) {
+	competition := &models.Competition{
+		Type:     models.CompetitionTypeProblemSet,
+		Problems: models.NewTargetProblems([]int{1000, 2000, 3000}, []float64{1, 3, 5}),
+	}
+	calculator := (&ScoreCalculator{tierManager: models.NewTierManager()}).ForCompetition(competition)
+
+	solved := []api.ProblemInfo{
+		{ProblemID: 1000, Level: 1}, // 등록 전 해결
+		{ProblemID: 2000, Level: 0}, // 목록 문제는 난이도와 무관하게 배점 적용
+		{ProblemID: 3000, Level: 15},
+		{ProblemID: 4000, Level: 10}, // 목록 외
+	}
+
+	breakdown := calculator.CalculateBreakdown(solved, 6, []int{1000})
+
+	if len(breakdown.Entries) != 2 {
+		t.Fatalf("Expected 2 counted problems, got %d", len(breakdown.Entries))
+	}
+	if breakdown.ExcludedNotInSet != 1 {
+		t.Errorf("Expected 1 problem outside the set, got %d", breakdown.ExcludedNotInSet)
+	}
+	if breakdown.Total != 8 {
+		t.Errorf("Expected total 8, got %f", breakdown.Total)
+	}
+	if breakdown.Entries[0].Label != "C" {
+		t.Errorf("Expected problem C first, got %+v", breakdown.Entries[0])
+	}
+	if count := calculator.CountNewProblems(solved, []int{1000}); count != 2 {
+		t.Errorf("Expected 2 new target problems, got %d", count)
+	}
+}
@@ -3,6 +3,7 @@ package sheets
 import (
 	"context"
 	"fmt"
+	"math"
 	"os"
 	"sort"
 	"strings"
@@ -43,24 +44,34 @@ func NewSheetsClient() (*SheetsClient, error) {
 	}, nil
 }
 
-// IsNameInParticipantList 주어진 이름이 참가자 명단에 있는지 확인합니다
-func (c *SheetsClient) IsNameInParticipantList(name string) (bool, error) {
+// GetParticipantNames 참가자 명단 스프레드시트의 이름 열을 읽어옵니다
+func (c *SheetsClient) GetParticipantNames(spreadsheetID string) ([]string, error) {
 	// 스프레드시트 데이터 읽기
 	resp, err := c.service.Spreadsheets.Values.Get(
-		constants.GetParticipantSpreadsheetID(),
+		spreadsheetID,
 		constants.ParticipantSheetRange,
 	).Do()
 	if err != nil {
-		return false, fmt.Errorf("failed to read spreadsheet: %w", err)
+		return nil, fmt.Errorf("failed to read spreadsheet: %w", err)
+	}
+
+	names, err := participantNames(resp.Values)
+	if err != nil {
+		return nil, err
 	}
+	utils.Info("Loaded %d names from participant spreadsheet %s", len(names), spreadsheetID)
+	return names, nil
+}
 
-	if len(resp.Values) == 0 {
+// participantNames 스프레드시트 값에서 이름 열의 값을 순서대로 꺼냅니다 (빈 칸 제외)
+func participantNames(values [][]interface{}) ([]string, error) {
+	if len(values) == 0 {
 		utils.Warn("Spreadsheet is empty")
-		return false, nil
+		return []string{}, nil
 	}
 
 	// 헤더 행에서 "이름 (ex.홍길동)" 컬럼 찾기
-	headers := resp.Values[0]
+	headers := values[0]
 	nameColumnIndex := -1
 	for i, header := range headers {
 		if headerStr, ok := header.(string); ok {
@@ -72,37 +83,19 @@ func (c *SheetsClient) IsNameInParticipantList(name string) (bool, error) {
 	}
 
 	if nameColumnIndex == -1 {
-		return false, fmt.Errorf("name column '%s' not found in spreadsheet", constants.ParticipantNameColumn)
+		return nil, fmt.Errorf("name column '%s' not found in spreadsheet", constants.ParticipantNameColumn)
 	}
 
-	// 데이터 행에서 이름 검색
-	normalizedTargetName := normalizeKoreanName(name)
-	for i := 1; i < len(resp.Values); i++ { // 헤더 행 제외
-		row := resp.Values[i]
+	names := make([]string, 0, len(values)-1)
+	for i := 1; i < len(values); i++ { // 헤더 행 제외
+		row := values[i]
 		if nameColumnIndex < len(row) {
-			if cellValue, ok := row[nameColumnIndex].(string); ok {
-				normalizedCellName := normalizeKoreanName(cellValue)
-				if normalizedCellName == normalizedTargetName {
-					utils.Info("Name '%s' found in participant list at row %d", name, i+1)
-					return true, nil
-				}
+			if cellValue, ok := row[nameColumnIndex].(string); ok && strings.TrimSpace(cellValue) != "" {
+				names = append(names, strings.TrimSpace(cellValue))
 			}
 		}
 	}
-
-	utils.Info("Name '%s' not found in participant list", name)
-	return false, nil
-}
-
-// normalizeKoreanName 한글 이름을 정규화합니다 (공백 제거, 대소문자 통일 등)
-func normalizeKoreanName(name string) string {
-	// 앞뒤 공백 제거
-	normalized := strings.TrimSpace(name)
-	// 중간 공백 제거
-	normalized = strings.ReplaceAll(normalized, " ", "")
-	// 소문자로 변환 (영어가 포함된 경우)
-	normalized = strings.ToLower(normalized)
-	return normalized
+	return names, nil
 }
 
 // setupGoogleCredentials Google 인증 정보를 설정합니다
@@ -117,8 +110,8 @@ func setupGoogleCredentials() string {
 	return firebaseCredentials
 }
 
-// UpdateScoreboardSheet 스코어보드 정보를 스프레드시트에 업데이트합니다
-func (c *SheetsClient) UpdateScoreboardSheet(spreadsheetID string, scores []models.ScoreData) error {
+// UpdateScoreboardSheet 스코어보드 정보를 스프레드시트에 업데이트합니다 (팀이 있으면 팀 순위도 함께 기록)
+func (c *SheetsClient) UpdateScoreboardSheet(spreadsheetID string, scores []models.ScoreData, teamScores []models.TeamScore) error {
 	if len(scores) == 0 {
 		utils.Warn("No scores to update in spreadsheet")
 		return nil
@@ -148,23 +141,21 @@ func (c *SheetsClient) UpdateScoreboardSheet(spreadsheetID string, scores []mode
 
 	// 전체 헤더 행
 	headers := []interface{}{
-		"순위", "이름", "백준ID", "점수", "리그", "티어", "레이팅", "신규해결문제", "백준프로필",
+		"순위", "이름", "백준ID", "점수", "리그", "티어", "레이팅", "레이팅 성장", "신규해결문제", "백준프로필",
 	}
 	values = append(values, headers)
 
 	// 리그별로 점수 분류 및 정렬
 	leagueScores := groupScoresByLeague(scores)
-	leagueOrder := []int{0, 1, 2} // LeagueRookie, LeaguePro, LeagueMaster
 
-	for _, league := range leagueOrder {
-		if len(leagueScores[league]) == 0 {
-			continue
+	for _, league := range leagueOrder(leagueScores) {
+		// 리그 헤더 추가 (대회 규칙의 리그 이름 우선)
+		leagueName := leagueScores[league][0].LeagueName
+		if leagueName == "" {
+			leagueName = getLeagueName(league)
 		}
-
-		// 리그 헤더 추가
-		leagueName := getLeagueName(league)
 		leagueHeader := []interface{}{
-			fmt.Sprintf("🎯 %s 리그", leagueName), "", "", "", "", "", "", "", "",
+			fmt.Sprintf("🎯 %s 리그", leagueName), "", "", "", "", "", "", "", "", "",
 		}
 		values = append(values, leagueHeader)
 
@@ -190,6 +181,7 @@ func (c *SheetsClient) UpdateScoreboardSheet(spreadsheetID string, scores []mode
 				leagueName,
 				tierName,
 				score.CurrentRating,
+				score.Growth.RatingGain,
 				score.ProblemCount,
 				profileLink,
 			}
@@ -197,10 +189,21 @@ func (c *SheetsClient) UpdateScoreboardSheet(spreadsheetID string, scores []mode
 			lastRawScore = score.RawScore
 		}
 
+		// 리그별 성장왕 (티어 상승 우선)
+		if improved := models.MostImproved(leagueScores[league], constants.MaxMostImprovedPerLeague); len(improved) > 0 {
+			values = append(values, formatMostImprovedRow(improved))
+		}
+
 		// 빈 행 추가 (리그 간 구분)
 		values = append(values, []interface{}{})
 	}
 
+	// 팀 순위
+	if len(teamScores) > 0 {
+		values = append(values, formatTeamRows(teamScores)...)
+		values = append(values, []interface{}{})
+	}
+
 	// 푸터 추가
 	values = append(values, []interface{}{})
 	footerRow := []interface{}{
@@ -232,6 +235,45 @@ func (c *SheetsClient) UpdateScoreboardSheet(spreadsheetID string, scores []mode
 	return nil
 }
 
+// formatMostImprovedRow 리그 성장왕 행을 만듭니다
+func formatMostImprovedRow(improved []models.ScoreData) []interface{} {
+	entries := make([]string, 0, len(improved))
+	for _, score := range improved {
+		entry := fmt.Sprintf("%s %+d", score.BaekjoonID, score.Growth.RatingGain)
+		if score.Growth.TierGain > 0 {
+			entry += fmt.Sprintf(" (%s → %s)", getTierName(score.StartTier), getTierName(score.CurrentTier))
+		}
+		entries = append(entries, entry)
+	}
+	return []interface{}{"📈 성장왕", strings.Join(entries, ", ")}
+}
+
+// formatTeamRows 팀 순위 헤더와 팀별 행을 만듭니다
+func formatTeamRows(teamScores []models.TeamScore) [][]interface{} {
+	rows := [][]interface{}{{"👥 팀 순위", "", "", "", "", "", "", "", "", ""}}
+
+	var lastScore float64 = -1.0
+	var rank int
+	for i, teamScore := range teamScores {
+		if teamScore.Score != lastScore {
+			rank = i + 1
+		}
+		lastScore = teamScore.Score
+
+		members := make([]string, 0, len(teamScore.Members))
+		for _, member := range teamScore.Members {
+			members = append(members, member.BaekjoonID)
+		}
+		rows = append(rows, []interface{}{
+			rank,
+			teamScore.Name,
+			strings.Join(members, ", "),
+			math.Round(teamScore.Score*10) / 10,
+		})
+	}
+	return rows
+}
+
 // clearSheet 시트의 모든 데이터를 클리어합니다
 func (c *SheetsClient) clearSheet(spreadsheetID string) error {
 	_, err := c.service.Spreadsheets.Values.Clear(
@@ -284,6 +326,18 @@ func groupScoresByLeague(scores []models.ScoreData) map[int][]models.ScoreData {
 	return leagueScores
 }
 
+// leagueOrder 점수가 있는 리그 번호를 오름차순으로 반환합니다
+func leagueOrder(leagueScores map[int][]models.ScoreData) []int {
+	order := make([]int, 0, len(leagueScores))
+	for league, scores := range leagueScores {
+		if len(scores) > 0 {
+			order = append(order, league)
+		}
+	}
+	sort.Ints(order)
+	return order
+}
+
 // getLeagueName 리그 번호를 리그 이름으로 변환합니다
 func getLeagueName(league int) string {
 	switch league {
@@ -1,35 +1,12 @@
 package sheets
 
 import (
-	"context"
-	"fmt"
 	"os"
 	"testing"
-)
-
-func TestNormalizeKoreanName(t *testing.T) {
-	tests := []struct {
-		input    string
-		expected string
-		desc     string
-	}{
-		{"홍길동", "홍길동", "Normal Korean name"},
-		{" 홍길동 ", "홍길동", "Korean name with spaces"},
-		{"홍 길 동", "홍길동", "Korean name with internal spaces"},
-		{"JOHN", "john", "English name to lowercase"},
-		{"John Doe", "johndoe", "English name with space"},
-		{" JANE ", "jane", "English with spaces and uppercase"},
-		{"김철수123", "김철수123", "Korean with numbers"},
-		{"", "", "Empty string"},
-	}
 
-	for _, test := range tests {
-		result := normalizeKoreanName(test.input)
-		if result != test.expected {
-			t.Errorf("normalizeKoreanName(%q) = %q, expected %q (%s)", test.input, result, test.expected, test.desc)
-		}
-	}
-}
+	"github.com/ssugameworks/kkemi/constants"
+	"github.com/ssugameworks/kkemi/models"
+)
 
 func TestSetupGoogleCredentials(t *testing.T) {
 	// 기존 환경변수 백업
@@ -82,8 +59,8 @@ func TestSheetsClientIntegration(t *testing.T) {
 	t.Log("Google Sheets client created successfully")
 }
 
-// 실제 스프레드시트에서 이름 검색 테스트 (환경변수가 설정된 경우에만 실행)
-func TestIsNameInParticipantListIntegration(t *testing.T) {
+// 실제 스프레드시트에서 명단 읽기 테스트 (환경변수가 설정된 경우에만 실행)
+func TestGetParticipantNamesIntegration(t *testing.T) {
 	// 환경변수 확인
 	if os.Getenv("FIREBASE_CREDENTIALS_JSON") == "" {
 		t.Skip("FIREBASE_CREDENTIALS_JSON not set, skipping integration test")
@@ -95,30 +72,11 @@ func TestIsNameInParticipantListIntegration(t *testing.T) {
 		t.Fatalf("Failed to create sheets client: %v", err)
 	}
 
-	// 실제 스프레드시트에서 이름 검색 테스트
-	testCases := []struct {
-		name        string
-		description string
-	}{
-		{"이정안", "실제 참가자 이름"},        // 실제 스프레드시트에 있는 이름
-		{"홍준우", "존재하지 않는 이름"},       // 실제 스프레드시트에 없는 이름
-		{"쿠쿠루삥뽕", "확실히 존재하지 않는 이름"}, // 확실히 없을 것 같은 이름
-	}
-
-	for _, tc := range testCases {
-		found, err := client.IsNameInParticipantList(tc.name)
-		if err != nil {
-			t.Errorf("IsNameInParticipantList(%q) returned error: %v", tc.name, err)
-			continue
-		}
-
-		t.Logf("IsNameInParticipantList(%q) = %v (%s)", tc.name, found, tc.description)
-
-		// 결과가 boolean이어야 함
-		if found != true && found != false {
-			t.Errorf("IsNameInParticipantList(%q) returned non-boolean value", tc.name)
-		}
+	names, err := client.GetParticipantNames(constants.GetParticipantSpreadsheetID())
+	if err != nil {
+		t.Fatalf("GetParticipantNames returned error: %v", err)
 	}
+	t.Logf("GetParticipantNames returned %d names", len(names))
 }
 
 // 스프레드시트 접근 권한 테스트
@@ -134,10 +92,8 @@ func TestSheetsAccessPermissions(t *testing.T) {
 		t.Fatalf("Failed to create sheets client: %v", err)
 	}
 
-	// 빈 이름으로 검색하여 스프레드시트 접근 가능 여부 확인
-	_, err = client.IsNameInParticipantList("")
-
-	// 빈 이름은 찾을 수 없지만, 에러가 없다면 스프레드시트 접근은 성공
+	// 명단을 읽어 스프레드시트 접근 가능 여부 확인
+	_, err = client.GetParticipantNames(constants.GetParticipantSpreadsheetID())
 	if err != nil {
 		// 에러 메시지 분석
 		if isPermissionError(err) {
@@ -147,7 +103,7 @@ func TestSheetsAccessPermissions(t *testing.T) {
 			t.Errorf("Spreadsheet not found: %v", err)
 			t.Log("Check if the spreadsheet ID is correct")
 		} else {
-			t.Logf("Other error (this might be expected for empty name): %v", err)
+			t.Errorf("Failed to read participant names: %v", err)
 		}
 	} else {
 		t.Log("Successfully accessed spreadsheet")
@@ -191,8 +147,8 @@ func (m *mockSheetsService) GetValues() ([][]interface{}, error) {
 	return m.testData, nil
 }
 
-// Mock을 사용한 이름 검증 테스트
-func TestIsNameInParticipantListWithMockData(t *testing.T) {
+// Mock 데이터로 명단 이름 열 읽기 테스트
+func TestParticipantNamesWithMockData(t *testing.T) {
 	tests := []struct {
 		name        string
 		testData    [][]interface{}
@@ -204,7 +160,7 @@ func TestIsNameInParticipantListWithMockData(t *testing.T) {
 		{
 			name: "찾는 이름이 있는 경우",
 			testData: [][]interface{}{
-				{"번호", "이름 (ex.홍길동)", "학번", "학과"},
+				{"번호", constants.ParticipantNameColumn, "학번", "학과"},
 				{"1", "김철수", "12345", "컴퓨터공학과"},
 				{"2", "이영희", "12346", "전자공학과"},
 				{"3", "박민수", "12347", "기계공학과"},
@@ -217,7 +173,7 @@ func TestIsNameInParticipantListWithMockData(t *testing.T) {
 		{
 			name: "공백이 있는 이름 검색",
 			testData: [][]interface{}{
-				{"번호", "이름 (ex.홍길동)", "학번"},
+				{"번호", constants.ParticipantNameColumn, "학번"},
 				{"1", "김 철 수", "12345"},
 				{"2", "이영희", "12346"},
 			},
@@ -229,7 +185,7 @@ func TestIsNameInParticipantListWithMockData(t *testing.T) {
 		{
 			name: "대소문자 다른 영어 이름",
 			testData: [][]interface{}{
-				{"번호", "이름 (ex.홍길동)", "학번"},
+				{"번호", constants.ParticipantNameColumn, "학번"},
 				{"1", "John Doe", "12345"},
 				{"2", "JANE SMITH", "12346"},
 			},
@@ -241,7 +197,7 @@ func TestIsNameInParticipantListWithMockData(t *testing.T) {
 		{
 			name: "찾는 이름이 없는 경우",
 			testData: [][]interface{}{
-				{"번호", "이름 (ex.홍길동)", "학번"},
+				{"번호", constants.ParticipantNameColumn, "학번"},
 				{"1", "김철수", "12345"},
 				{"2", "이영희", "12346"},
 			},
@@ -253,7 +209,7 @@ func TestIsNameInParticipantListWithMockData(t *testing.T) {
 		{
 			name: "빈 스프레드시트",
 			testData: [][]interface{}{
-				{"번호", "이름 (ex.홍길동)", "학번"},
+				{"번호", constants.ParticipantNameColumn, "학번"},
 			},
 			searchName:  "김철수",
 			expected:    false,
@@ -275,13 +231,9 @@ func TestIsNameInParticipantListWithMockData(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			// 테스트용 클라이언트 생성
-			client := &SheetsClient{
-				ctx: context.Background(),
-			}
-
-			// Mock 데이터를 사용하여 직접 검증 로직 테스트
-			result, err := client.searchNameInMockData(tt.testData, tt.searchName)
+			// Mock 데이터를 사용하여 실제 API 호출 없이 이름 열 읽기 로직 테스트
+			names, err := participantNames(tt.testData)
+			result := containsName(names, tt.searchName)
 
 			// 에러 확인
 			if tt.expectError && err == nil {
@@ -301,43 +253,14 @@ func TestIsNameInParticipantListWithMockData(t *testing.T) {
 	}
 }
 
-// Mock 데이터에서 이름을 검색하는 헬퍼 함수 (실제 API 호출 없이 로직 테스트)
-func (c *SheetsClient) searchNameInMockData(values [][]interface{}, name string) (bool, error) {
-	if len(values) == 0 {
-		return false, nil
-	}
-
-	// 헤더 행에서 "이름 (ex.홍길동)" 컬럼 찾기
-	headers := values[0]
-	nameColumnIndex := -1
-	for i, header := range headers {
-		if headerStr, ok := header.(string); ok {
-			if containsInner(headerStr, "이름 (ex.홍길동)") {
-				nameColumnIndex = i
-				break
-			}
-		}
-	}
-
-	if nameColumnIndex == -1 {
-		return false, fmt.Errorf("name column '이름 (ex.홍길동)' not found in spreadsheet")
-	}
-
-	// 데이터 행에서 이름 검색
-	normalizedTargetName := normalizeKoreanName(name)
-	for i := 1; i < len(values); i++ { // 헤더 행 제외
-		row := values[i]
-		if nameColumnIndex < len(row) {
-			if cellValue, ok := row[nameColumnIndex].(string); ok {
-				normalizedCellName := normalizeKoreanName(cellValue)
-				if normalizedCellName == normalizedTargetName {
-					return true, nil
-				}
-			}
+// containsName 정규화한 이름으로 명단에 있는지 확인하는 헬퍼 함수
+func containsName(names []string, name string) bool {
+	for _, candidate := range names {
+		if models.NormalizeRosterName(candidate) == models.NormalizeRosterName(name) {
+			return true
 		}
 	}
-
-	return false, nil
+	return false
 }
 
 // 실제 스프레드시트 헤더 확인용 테스트
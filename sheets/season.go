@@ -0,0 +1,94 @@
+package sheets
+
+import (
+	"fmt"
+
+	"github.com/ssugameworks/kkemi/constants"
+	"github.com/ssugameworks/kkemi/models"
+	"github.com/ssugameworks/kkemi/utils"
+
+	"google.golang.org/api/sheets/v4"
+)
+
+// UpdateSeasonSheet 시즌 순위를 전용 시트 탭에 기록합니다 (탭이 없으면 새로 만듭니다)
+func (c *SheetsClient) UpdateSeasonSheet(spreadsheetID string, season models.Season, competitionCount int, standings []models.SeasonStanding) error {
+	if err := c.ensureSheetTab(spreadsheetID, constants.SeasonSheetName); err != nil {
+		return err
+	}
+
+	sheetRange := fmt.Sprintf("'%s'!A:Z", constants.SeasonSheetName)
+	if _, err := c.service.Spreadsheets.Values.Clear(spreadsheetID, sheetRange, &sheets.ClearValuesRequest{}).Do(); err != nil {
+		utils.Warn("Failed to clear season sheet: %v", err)
+	}
+
+	valueRange := &sheets.ValueRange{Values: formatSeasonRows(season, competitionCount, standings)}
+	_, err := c.service.Spreadsheets.Values.Update(
+		spreadsheetID,
+		fmt.Sprintf("'%s'!A1", constants.SeasonSheetName),
+		valueRange,
+	).ValueInputOption("RAW").Do()
+	if err != nil {
+		return fmt.Errorf("failed to update season sheet: %w", err)
+	}
+
+	utils.Info("Successfully updated season sheet %s with %d members", season.Name, len(standings))
+	return nil
+}
+
+// formatSeasonRows 시즌 제목, 헤더와 참가자별 시즌 포인트 행을 만듭니다
+func formatSeasonRows(season models.Season, competitionCount int, standings []models.SeasonStanding) [][]interface{} {
+	now := utils.GetCurrentTimeKST()
+	rows := [][]interface{}{
+		{
+			fmt.Sprintf("🏅 %s 시즌 순위", season.Name),
+			fmt.Sprintf("%s ~ %s", utils.FormatDate(season.StartDate), utils.FormatDate(season.EndDate)),
+			models.SeasonScoringLabel(season.Scoring),
+			fmt.Sprintf("대회 %d개", competitionCount),
+			"",
+			fmt.Sprintf("업데이트: %s", now.Format("2006-01-02 15:04:05 KST")),
+		},
+		{},
+		{"순위", "이름", "백준ID", "시즌 포인트", "참가 대회", "최고 순위"},
+	}
+
+	var lastPoints float64 = -1.0
+	var rank int
+	for i, standing := range standings {
+		if standing.Points != lastPoints {
+			rank = i + 1
+		}
+		lastPoints = standing.Points
+		rows = append(rows, []interface{}{
+			rank,
+			standing.Name,
+			standing.BaekjoonID,
+			standing.Points,
+			standing.Competitions,
+			standing.BestRank,
+		})
+	}
+	return rows
+}
+
+// ensureSheetTab 지정한 이름의 시트 탭이 없으면 추가합니다
+func (c *SheetsClient) ensureSheetTab(spreadsheetID, title string) error {
+	spreadsheet, err := c.service.Spreadsheets.Get(spreadsheetID).Fields("sheets.properties.title").Do()
+	if err != nil {
+		return fmt.Errorf("failed to get spreadsheet: %w", err)
+	}
+	for _, sheet := range spreadsheet.Sheets {
+		if sheet.Properties != nil && sheet.Properties.Title == title {
+			return nil
+		}
+	}
+
+	request := &sheets.BatchUpdateSpreadsheetRequest{
+		Requests: []*sheets.Request{
+			{AddSheet: &sheets.AddSheetRequest{Properties: &sheets.SheetProperties{Title: title}}},
+		},
+	}
+	if _, err := c.service.Spreadsheets.BatchUpdate(spreadsheetID, request).Do(); err != nil {
+		return fmt.Errorf("failed to add sheet tab %s: %w", title, err)
+	}
+	return nil
+}
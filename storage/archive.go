@@ -0,0 +1,109 @@
+package storage
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/ssugameworks/kkemi/models"
+	"github.com/ssugameworks/kkemi/utils"
+
+	"cloud.google.com/go/firestore"
+	"google.golang.org/api/iterator"
+)
+
+// resultsCollection 대회의 확정 결과 컬렉션 참조를 반환합니다.
+func (s *FirebaseStorage) resultsCollection(competitionID string) *firestore.CollectionRef {
+	return s.client().Collection("competitions").Doc(competitionID).Collection("results")
+}
+
+// FinalizeCompetition 작업 대상 대회의 최종 결과를 보관하고 대회를 비활성화합니다.
+// 참가자·해결 기록·팀 문서는 그대로 남겨 두고, 결과는 참가자별 문서로 저장합니다.
+func (s *FirebaseStorage) FinalizeCompetition(results []models.ArchivedResult) error {
+	competition := s.GetCompetition()
+	if competition == nil || !competition.IsActive {
+		return fmt.Errorf("no active competition to finalize")
+	}
+
+	for _, result := range results {
+		result := result
+		err := s.executeWithRetry(func() error {
+			_, err := s.resultsCollection(competition.ID).Doc(result.Score.BaekjoonID).Set(s.ctx, result)
+			return err
+		})
+		if err != nil {
+			return fmt.Errorf("failed to archive result for %s: %w", result.Score.BaekjoonID, err)
+		}
+	}
+
+	_, err := s.client().Collection("competitions").Doc(competition.ID).Update(s.ctx, []firestore.Update{
+		{Path: "isActive", Value: false},
+		{Path: "finalizedAt", Value: time.Now()},
+		{Path: "participantCount", Value: len(results)},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to mark competition as finalized: %w", err)
+	}
+
+	utils.Info("Finalized competition %s with %d results", competition.Name, len(results))
+	return nil
+}
+
+// GetArchivedCompetitions 결과가 확정된 대회를 최근에 확정된 순으로 조회합니다.
+func (s *FirebaseStorage) GetArchivedCompetitions() []models.Competition {
+	competitions := make([]models.Competition, 0)
+	iter := s.client().Collection("competitions").Where("isActive", "==", false).Documents(s.ctx)
+	for {
+		doc, err := iter.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			utils.Error("Failed to get archived competitions: %v", err)
+			return competitions
+		}
+
+		var c models.Competition
+		doc.DataTo(&c)
+		c.ID = doc.Ref.ID
+		// 확정 없이 비활성화된 예전 대회는 보관된 결과가 없으므로 제외
+		if c.IsFinalized() {
+			competitions = append(competitions, c)
+		}
+	}
+
+	models.SortArchivedCompetitions(competitions)
+	return competitions
+}
+
+// GetArchivedResults 작업 대상 대회의 보관된 결과를 리그·순위 순으로 조회합니다.
+func (s *FirebaseStorage) GetArchivedResults() ([]models.ArchivedResult, error) {
+	competition := s.GetCompetition()
+	if competition == nil {
+		return nil, fmt.Errorf("competition not found")
+	}
+	if !competition.IsFinalized() {
+		return nil, fmt.Errorf("competition %s is not finalized", competition.ID)
+	}
+
+	results := make([]models.ArchivedResult, 0, competition.ParticipantCount)
+	iter := s.resultsCollection(competition.ID).Documents(s.ctx)
+	for {
+		doc, err := iter.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to iterate archived results: %w", err)
+		}
+
+		var result models.ArchivedResult
+		if err := doc.DataTo(&result); err != nil {
+			utils.Warn("Failed to decode archived result %s: %v", doc.Ref.ID, err)
+			continue
+		}
+		results = append(results, result)
+	}
+
+	models.SortArchivedResults(results)
+	return results, nil
+}
@@ -0,0 +1,42 @@
+package storage
+
+import (
+	"fmt"
+
+	"github.com/ssugameworks/kkemi/models"
+
+	"cloud.google.com/go/firestore"
+	"google.golang.org/api/iterator"
+)
+
+// AppendAuditEntry 관리 작업 기록을 감사 로그에 추가합니다.
+func (s *FirebaseStorage) AppendAuditEntry(entry models.AuditEntry) error {
+	if _, _, err := s.client().Collection("auditLog").Add(s.ctx, entry); err != nil {
+		return fmt.Errorf("failed to append audit entry %s: %w", entry.Action, err)
+	}
+	return nil
+}
+
+// GetAuditEntries 최근 감사 로그를 최신순으로 최대 limit개 조회합니다.
+func (s *FirebaseStorage) GetAuditEntries(limit int) ([]models.AuditEntry, error) {
+	entries := make([]models.AuditEntry, 0, limit)
+	iter := s.client().Collection("auditLog").OrderBy("timestamp", firestore.Desc).Limit(limit).Documents(s.ctx)
+	defer iter.Stop()
+	for {
+		doc, err := iter.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to load audit entries: %w", err)
+		}
+
+		var entry models.AuditEntry
+		if err := doc.DataTo(&entry); err != nil {
+			return nil, fmt.Errorf("failed to decode audit entry %s: %w", doc.Ref.ID, err)
+		}
+		entry.ID = doc.Ref.ID
+		entries = append(entries, entry)
+	}
+	return entries, nil
+}
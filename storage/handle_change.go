@@ -0,0 +1,165 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ssugameworks/kkemi/models"
+	"github.com/ssugameworks/kkemi/utils"
+
+	"cloud.google.com/go/firestore"
+	"google.golang.org/api/iterator"
+)
+
+// handleChangesCollection 대회의 백준 ID 변경 기록 컬렉션 참조를 반환합니다.
+func (s *FirebaseStorage) handleChangesCollection(competitionID string) *firestore.CollectionRef {
+	return s.client().Collection("competitions").Doc(competitionID).Collection("handleChanges")
+}
+
+// ChangeParticipantHandle 참가자의 백준 ID를 바꾸고 변경 기록을 남깁니다.
+// 참가자·해결 기록 문서는 백준 ID를 문서 ID로 쓰므로 새 문서로 옮기고, 팀 소속도 함께 바꿉니다.
+func (s *FirebaseStorage) ChangeParticipantHandle(change models.HandleChange) error {
+	if !utils.IsValidBaekjoonID(change.NewBaekjoonID) {
+		return fmt.Errorf("invalid Baekjoon ID: %s", change.NewBaekjoonID)
+	}
+	competition := s.GetCompetition()
+	if competition == nil || !competition.IsActive {
+		return fmt.Errorf("no active competition")
+	}
+
+	var startSnapshot []byte
+	var startProblemCount int
+	if change.Policy == models.HandleChangeResnapshot {
+		var err error
+		startSnapshot, startProblemCount, err = fetchStartSnapshot(s.apiClient, change.NewBaekjoonID)
+		if err != nil {
+			return err
+		}
+	}
+
+	err := s.executeWithRetry(func() error {
+		// 재연결 뒤 재시도할 때 새 클라이언트를 쓰도록 참조는 매번 새로 만듭니다
+		participants := s.client().Collection("competitions").Doc(competition.ID).Collection("participants")
+		oldRef := participants.Doc(change.OldBaekjoonID)
+		newRef := participants.Doc(change.NewBaekjoonID)
+		oldSolvesRef := s.solvesDoc(competition.ID, change.OldBaekjoonID)
+		return s.client().RunTransaction(s.ctx, func(ctx context.Context, tx *firestore.Transaction) error {
+			// 트랜잭션의 읽기는 모든 쓰기보다 먼저 해야 합니다
+			docs, err := tx.GetAll([]*firestore.DocumentRef{oldRef, newRef, oldSolvesRef})
+			if err != nil {
+				return err
+			}
+			if !docs[0].Exists() {
+				return fmt.Errorf("participant not found: %s", change.OldBaekjoonID)
+			}
+			if docs[1].Exists() {
+				return fmt.Errorf("participant with Baekjoon ID %s already exists", change.NewBaekjoonID)
+			}
+			teams, err := tx.Documents(s.teamsCollection(competition.ID).Where("members", "array-contains", change.OldBaekjoonID)).GetAll()
+			if err != nil {
+				return err
+			}
+
+			var participant models.Participant
+			if err := docs[0].DataTo(&participant); err != nil {
+				return err
+			}
+			if err := tx.Create(newRef, renameParticipant(participant, change, startSnapshot, startProblemCount)); err != nil {
+				return err
+			}
+			if err := tx.Delete(oldRef); err != nil {
+				return err
+			}
+
+			if docs[2].Exists() {
+				if change.Policy == models.HandleChangeCarryOver {
+					var solves solveRecordDoc
+					if err := docs[2].DataTo(&solves); err != nil {
+						return err
+					}
+					if err := tx.Set(s.solvesDoc(competition.ID, change.NewBaekjoonID), solves); err != nil {
+						return err
+					}
+				}
+				if err := tx.Delete(oldSolvesRef); err != nil {
+					return err
+				}
+			}
+
+			for _, doc := range teams {
+				var team models.Team
+				if err := doc.DataTo(&team); err != nil {
+					return err
+				}
+				members := renameMember(team.Members, change.OldBaekjoonID, change.NewBaekjoonID)
+				if err := tx.Update(doc.Ref, []firestore.Update{{Path: "members", Value: members}}); err != nil {
+					return err
+				}
+			}
+
+			return tx.Create(s.handleChangesCollection(competition.ID).NewDoc(), change)
+		})
+	})
+	if err != nil {
+		return fmt.Errorf("failed to change handle %s → %s: %w", change.OldBaekjoonID, change.NewBaekjoonID, err)
+	}
+
+	utils.Info("Changed participant handle %s → %s (%s)", change.OldBaekjoonID, change.NewBaekjoonID, change.Policy)
+	return nil
+}
+
+// GetHandleChanges 현재 대회의 백준 ID 변경 기록을 시간순으로 조회합니다.
+func (s *FirebaseStorage) GetHandleChanges() ([]models.HandleChange, error) {
+	competition := s.GetCompetition()
+	if competition == nil {
+		return []models.HandleChange{}, nil
+	}
+
+	changes := make([]models.HandleChange, 0)
+	iter := s.handleChangesCollection(competition.ID).OrderBy("changedAt", firestore.Asc).Documents(s.ctx)
+	defer iter.Stop()
+	for {
+		doc, err := iter.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to iterate handle changes: %w", err)
+		}
+
+		var change models.HandleChange
+		if err := doc.DataTo(&change); err != nil {
+			return nil, fmt.Errorf("failed to decode handle change %s: %w", doc.Ref.ID, err)
+		}
+		change.ID = doc.Ref.ID
+		changes = append(changes, change)
+	}
+	return changes, nil
+}
+
+// renameParticipant 새 백준 ID를 가진 참가자를 만듭니다.
+// 다른 계정으로 바꾸는 경우 등록 시점 스냅샷을 새 계정의 현재 해결 문제로 교체하고, 레거시 TOP 100 목록은 비웁니다.
+func renameParticipant(participant models.Participant, change models.HandleChange, startSnapshot []byte, startProblemCount int) models.Participant {
+	participant.BaekjoonID = change.NewBaekjoonID
+	if change.OrganizationID != 0 {
+		participant.OrganizationID = change.OrganizationID
+	}
+	if change.Policy == models.HandleChangeResnapshot {
+		participant.StartSnapshot = startSnapshot
+		participant.StartProblemCount = startProblemCount
+		participant.StartProblemIDs = nil
+	}
+	return participant
+}
+
+// renameMember 팀원 목록에서 기존 백준 ID를 새 백준 ID로 바꿉니다.
+func renameMember(members []string, oldBaekjoonID, newBaekjoonID string) []string {
+	renamed := make([]string, len(members))
+	for i, member := range members {
+		if member == oldBaekjoonID {
+			member = newBaekjoonID
+		}
+		renamed[i] = member
+	}
+	return renamed
+}
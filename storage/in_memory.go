@@ -1,8 +1,9 @@
 package storage
 
 import (
-	"context"
 	"fmt"
+	"sort"
+	"strings"
 	"sync"
 	"time"
 
@@ -12,46 +13,112 @@ import (
 	"github.com/ssugameworks/kkemi/utils"
 )
 
+// memoryCompetition 대회 하나와 그 대회에 속한 데이터
+type memoryCompetition struct {
+	competition  models.Competition
+	participants map[string]models.Participant           // key: BaekjoonID
+	solves       map[string]map[int]time.Time            // key: BaekjoonID → 문제 ID별 최초 발견 시각
+	teams        map[string]models.Team                  // key: 소문자 팀 이름
+	results      []models.ArchivedResult                 // 확정된 최종 결과
+	snapshots    []models.ScoreSnapshot                  // 시간순 점수 스냅샷
+	challenges   map[string]models.RegistrationChallenge // key: 디스코드 사용자 ID
+	renames      []models.HandleChange                   // 백준 ID 변경 기록
+	roster       map[string]models.RosterEntry           // key: 정규화한 이름
+}
+
+// memoryState 같은 저장소에서 파생된 대회별 뷰들이 공유하는 상태
+type memoryState struct {
+	mu           sync.RWMutex
+	competitions map[string]*memoryCompetition      // key: 대회 ID
+	seasons      map[string]models.Season           // key: 소문자 시즌 이름
+	permissions  map[string]models.GuildPermissions // key: 서버 ID
+	audit        []models.AuditEntry                // 기록 순 감사 로그
+}
+
 // InMemoryStorage 테스트/개발용 비영구 저장소 구현
 type InMemoryStorage struct {
-	mu           sync.RWMutex
-	apiClient    interfaces.APIClient
-	competition  *models.Competition
-	participants map[string]models.Participant // key: BaekjoonID
+	state     *memoryState
+	apiClient interfaces.APIClient
+	// competitionID 작업 대상 대회 (비어 있으면 가장 최근에 만든 활성 대회)
+	competitionID string
 }
 
 // NewInMemoryStorage 새 인메모리 저장소 생성
 func NewInMemoryStorage(apiClient interfaces.APIClient) *InMemoryStorage {
 	return &InMemoryStorage{
-		apiClient:    apiClient,
-		participants: make(map[string]models.Participant),
+		state: &memoryState{
+			competitions: make(map[string]*memoryCompetition),
+			seasons:      make(map[string]models.Season),
+			permissions:  make(map[string]models.GuildPermissions),
+		},
+		apiClient: apiClient,
 	}
 }
 
-// AddParticipant 참가자 추가
-func (s *InMemoryStorage) AddParticipant(name, baekjoonID string, startTier, startRating int, organizationID int) error {
-	s.mu.Lock()
-	defer s.mu.Unlock()
+// ForCompetition 지정한 대회를 대상으로 하는 저장소 뷰 반환 (데이터는 공유)
+func (s *InMemoryStorage) ForCompetition(competitionID string) interfaces.StorageRepository {
+	return &InMemoryStorage{
+		state:         s.state,
+		apiClient:     s.apiClient,
+		competitionID: competitionID,
+	}
+}
 
+// currentLocked 작업 대상 대회 조회 (호출자가 잠금 보유)
+func (s *InMemoryStorage) currentLocked() *memoryCompetition {
+	if s.competitionID != "" {
+		return s.state.competitions[s.competitionID]
+	}
+
+	var latest *memoryCompetition
+	for _, comp := range s.state.competitions {
+		if !comp.competition.IsActive {
+			continue
+		}
+		if latest == nil || comp.competition.CreatedAt.After(latest.competition.CreatedAt) {
+			latest = comp
+		}
+	}
+	return latest
+}
+
+// activeLocked 작업 대상 대회가 활성 상태일 때만 반환 (호출자가 잠금 보유)
+func (s *InMemoryStorage) activeLocked() *memoryCompetition {
+	comp := s.currentLocked()
+	if comp == nil || !comp.competition.IsActive {
+		return nil
+	}
+	return comp
+}
+
+// AddParticipant 참가자 추가
+// 시작 스냅샷은 solved.ac를 여러 번 호출하므로 잠금 밖에서 가져오고, 저장 직전에 중복을 다시 확인합니다
+func (s *InMemoryStorage) AddParticipant(name, baekjoonID string, startTier, startRating int, organizationID int, discordUserID string) error {
 	if !utils.IsValidUsername(name) {
 		return fmt.Errorf("invalid username: %s", name)
 	}
 	if !utils.IsValidBaekjoonID(baekjoonID) {
 		return fmt.Errorf("invalid Baekjoon ID: %s", baekjoonID)
 	}
-	if s.competition == nil || !s.competition.IsActive {
-		return fmt.Errorf("no active competition to add participant to")
-	}
-	if _, exists := s.participants[baekjoonID]; exists {
-		return fmt.Errorf("participant with Baekjoon ID %s already exists", baekjoonID)
+
+	s.state.mu.RLock()
+	err := checkNewParticipantLocked(s.activeLocked(), name, baekjoonID, discordUserID)
+	s.state.mu.RUnlock()
+	if err != nil {
+		return err
 	}
-	for _, p := range s.participants {
-		if p.Name == name {
-			return fmt.Errorf("participant with name %s already exists", name)
-		}
+
+	startSnapshot, startProblemCount, err := fetchStartSnapshot(s.apiClient, baekjoonID)
+	if err != nil {
+		return err
 	}
 
-	startProblemIDs, startProblemCount := s.fetchStartingProblems(baekjoonID)
+	s.state.mu.Lock()
+	defer s.state.mu.Unlock()
+	comp := s.activeLocked()
+	if err := checkNewParticipantLocked(comp, name, baekjoonID, discordUserID); err != nil {
+		return err
+	}
 
 	p := models.Participant{
 		ID:                baekjoonID,
@@ -61,19 +128,43 @@ func (s *InMemoryStorage) AddParticipant(name, baekjoonID string, startTier, sta
 		StartTier:         startTier,
 		StartRating:       startRating,
 		CreatedAt:         time.Now(),
-		StartProblemIDs:   startProblemIDs,
 		StartProblemCount: startProblemCount,
+		DiscordUserID:     discordUserID,
+		StartSnapshot:     startSnapshot,
+	}
+	comp.participants[baekjoonID] = p
+	return nil
+}
+
+// checkNewParticipantLocked 새 참가자의 백준 ID·이름·디스코드 연결이 겹치지 않는지 확인 (호출자가 잠금 보유)
+func checkNewParticipantLocked(comp *memoryCompetition, name, baekjoonID, discordUserID string) error {
+	if comp == nil {
+		return fmt.Errorf("no active competition to add participant to")
+	}
+	if _, exists := comp.participants[baekjoonID]; exists {
+		return fmt.Errorf("participant with Baekjoon ID %s already exists", baekjoonID)
+	}
+	for _, p := range comp.participants {
+		if p.Name == name {
+			return fmt.Errorf("participant with name %s already exists", name)
+		}
+		if discordUserID != "" && p.DiscordUserID == discordUserID {
+			return fmt.Errorf("discord user %s is already linked to %s", discordUserID, p.BaekjoonID)
+		}
 	}
-	s.participants[baekjoonID] = p
 	return nil
 }
 
 // GetParticipants 참가자 전체 조회
 func (s *InMemoryStorage) GetParticipants() []models.Participant {
-	s.mu.RLock()
-	defer s.mu.RUnlock()
-	res := make([]models.Participant, 0, len(s.participants))
-	for _, p := range s.participants {
+	s.state.mu.RLock()
+	defer s.state.mu.RUnlock()
+	comp := s.currentLocked()
+	if comp == nil {
+		return []models.Participant{}
+	}
+	res := make([]models.Participant, 0, len(comp.participants))
+	for _, p := range comp.participants {
 		res = append(res, p)
 	}
 	return res
@@ -81,102 +172,513 @@ func (s *InMemoryStorage) GetParticipants() []models.Participant {
 
 // RemoveParticipant 참가자 제거
 func (s *InMemoryStorage) RemoveParticipant(baekjoonID string) error {
-	s.mu.Lock()
-	defer s.mu.Unlock()
-	if _, ok := s.participants[baekjoonID]; !ok {
+	s.state.mu.Lock()
+	defer s.state.mu.Unlock()
+	comp := s.currentLocked()
+	if comp == nil {
+		return fmt.Errorf("no active competition")
+	}
+	if _, ok := comp.participants[baekjoonID]; !ok {
 		return fmt.Errorf("participant not found: %s", baekjoonID)
 	}
-	delete(s.participants, baekjoonID)
+	delete(comp.participants, baekjoonID)
+	delete(comp.solves, baekjoonID)
+	comp.removeFromTeams(baekjoonID)
 	return nil
 }
 
-// CreateCompetition 새 대회 생성 및 활성화
-func (s *InMemoryStorage) CreateCompetition(name string, startDate, endDate time.Time) error {
-	s.mu.Lock()
-	defer s.mu.Unlock()
-	// 이전 대회 비활성화
-	if s.competition != nil {
-		s.competition.IsActive = false
+// LinkDiscordUser 참가자와 디스코드 사용자 연결 (빈 ID면 연결 해제, 다른 참가자에 연결된 사용자는 거부)
+func (s *InMemoryStorage) LinkDiscordUser(baekjoonID, discordUserID string) error {
+	s.state.mu.Lock()
+	defer s.state.mu.Unlock()
+	comp := s.currentLocked()
+	if comp == nil {
+		return fmt.Errorf("no active competition")
 	}
-	comp := &models.Competition{
-		ID:                fmt.Sprintf("mem-%d", time.Now().UnixNano()),
-		Name:              name,
-		StartDate:         startDate,
-		EndDate:           endDate,
-		BlackoutStartDate: endDate.AddDate(0, 0, -constants.BlackoutDays),
-		IsActive:          true,
-		ShowScoreboard:    true,
+	p, ok := comp.participants[baekjoonID]
+	if !ok {
+		return fmt.Errorf("participant not found: %s", baekjoonID)
 	}
-	s.competition = comp
+	if discordUserID != "" {
+		for _, other := range comp.participants {
+			if other.BaekjoonID != baekjoonID && other.DiscordUserID == discordUserID {
+				return fmt.Errorf("discord user %s is already linked to %s", discordUserID, other.BaekjoonID)
+			}
+		}
+	}
+	p.DiscordUserID = discordUserID
+	comp.participants[baekjoonID] = p
 	return nil
 }
 
-// GetCompetition 활성 대회 조회
+// ChangeParticipantHandle 참가자의 백준 ID 변경 (스냅샷 처리 방식에 따라 해결 기록을 옮기거나 새로 시작)
+func (s *InMemoryStorage) ChangeParticipantHandle(change models.HandleChange) error {
+	if !utils.IsValidBaekjoonID(change.NewBaekjoonID) {
+		return fmt.Errorf("invalid Baekjoon ID: %s", change.NewBaekjoonID)
+	}
+	var startSnapshot []byte
+	var startProblemCount int
+	if change.Policy == models.HandleChangeResnapshot {
+		var err error
+		startSnapshot, startProblemCount, err = fetchStartSnapshot(s.apiClient, change.NewBaekjoonID)
+		if err != nil {
+			return err
+		}
+	}
+
+	s.state.mu.Lock()
+	defer s.state.mu.Unlock()
+	comp := s.activeLocked()
+	if comp == nil {
+		return fmt.Errorf("no active competition")
+	}
+	p, ok := comp.participants[change.OldBaekjoonID]
+	if !ok {
+		return fmt.Errorf("participant not found: %s", change.OldBaekjoonID)
+	}
+	if _, exists := comp.participants[change.NewBaekjoonID]; exists {
+		return fmt.Errorf("participant with Baekjoon ID %s already exists", change.NewBaekjoonID)
+	}
+
+	renamed := renameParticipant(p, change, startSnapshot, startProblemCount)
+	renamed.ID = change.NewBaekjoonID
+	delete(comp.participants, change.OldBaekjoonID)
+	comp.participants[change.NewBaekjoonID] = renamed
+
+	if solves, ok := comp.solves[change.OldBaekjoonID]; ok && change.Policy == models.HandleChangeCarryOver {
+		comp.solves[change.NewBaekjoonID] = solves
+	}
+	delete(comp.solves, change.OldBaekjoonID)
+
+	for key, team := range comp.teams {
+		team.Members = renameMember(team.Members, change.OldBaekjoonID, change.NewBaekjoonID)
+		comp.teams[key] = team
+	}
+
+	change.ID = fmt.Sprintf("rename-%d", len(comp.renames)+1)
+	comp.renames = append(comp.renames, change)
+	return nil
+}
+
+// GetHandleChanges 백준 ID 변경 기록을 시간순으로 조회
+func (s *InMemoryStorage) GetHandleChanges() ([]models.HandleChange, error) {
+	s.state.mu.RLock()
+	defer s.state.mu.RUnlock()
+	comp := s.currentLocked()
+	if comp == nil {
+		return []models.HandleChange{}, nil
+	}
+	return append([]models.HandleChange(nil), comp.renames...), nil
+}
+
+// SaveRegistrationChallenge 등록 본인 확인 토큰 저장 (같은 사용자의 이전 토큰은 교체)
+func (s *InMemoryStorage) SaveRegistrationChallenge(challenge models.RegistrationChallenge) error {
+	s.state.mu.Lock()
+	defer s.state.mu.Unlock()
+	comp := s.activeLocked()
+	if comp == nil {
+		return fmt.Errorf("no active competition")
+	}
+	if comp.challenges == nil {
+		comp.challenges = make(map[string]models.RegistrationChallenge)
+	}
+	comp.challenges[challenge.DiscordUserID] = challenge
+	return nil
+}
+
+// GetRegistrationChallenge 디스코드 사용자의 등록 본인 확인 토큰 조회 (없으면 nil)
+func (s *InMemoryStorage) GetRegistrationChallenge(discordUserID string) (*models.RegistrationChallenge, error) {
+	s.state.mu.RLock()
+	defer s.state.mu.RUnlock()
+	comp := s.currentLocked()
+	if comp == nil {
+		return nil, nil
+	}
+	challenge, ok := comp.challenges[discordUserID]
+	if !ok {
+		return nil, nil
+	}
+	return &challenge, nil
+}
+
+// DeleteRegistrationChallenge 등록 본인 확인 토큰 삭제
+func (s *InMemoryStorage) DeleteRegistrationChallenge(discordUserID string) error {
+	s.state.mu.Lock()
+	defer s.state.mu.Unlock()
+	comp := s.currentLocked()
+	if comp == nil {
+		return fmt.Errorf("no active competition")
+	}
+	delete(comp.challenges, discordUserID)
+	return nil
+}
+
+// GetRoster 참가 명단 조회 (이름순)
+func (s *InMemoryStorage) GetRoster() ([]models.RosterEntry, error) {
+	s.state.mu.RLock()
+	defer s.state.mu.RUnlock()
+	comp := s.currentLocked()
+	if comp == nil {
+		return []models.RosterEntry{}, nil
+	}
+	entries := make([]models.RosterEntry, 0, len(comp.roster))
+	for _, entry := range comp.roster {
+		entries = append(entries, entry)
+	}
+	sort.Slice(entries, func(i, j int) bool { return entries[i].Name < entries[j].Name })
+	return entries, nil
+}
+
+// SaveRosterEntries 참가 명단 항목 추가 (같은 이름은 교체)
+func (s *InMemoryStorage) SaveRosterEntries(entries []models.RosterEntry) error {
+	s.state.mu.Lock()
+	defer s.state.mu.Unlock()
+	comp := s.currentLocked()
+	if comp == nil {
+		return fmt.Errorf("no active competition")
+	}
+	if comp.roster == nil {
+		comp.roster = make(map[string]models.RosterEntry)
+	}
+	for _, entry := range entries {
+		comp.roster[entry.Key()] = entry
+	}
+	return nil
+}
+
+// RemoveRosterEntries 참가 명단에서 이름 삭제 (없는 이름은 무시)
+func (s *InMemoryStorage) RemoveRosterEntries(names []string) error {
+	s.state.mu.Lock()
+	defer s.state.mu.Unlock()
+	comp := s.currentLocked()
+	if comp == nil {
+		return fmt.Errorf("no active competition")
+	}
+	for _, name := range names {
+		delete(comp.roster, models.NormalizeRosterName(name))
+	}
+	return nil
+}
+
+// CreateCompetition 새 대회 생성 (다른 활성 대회는 그대로 유지)
+func (s *InMemoryStorage) CreateCompetition(name string, startDate, endDate time.Time) (string, error) {
+	s.state.mu.Lock()
+	defer s.state.mu.Unlock()
+	id := fmt.Sprintf("mem-%d", time.Now().UnixNano())
+	s.state.competitions[id] = &memoryCompetition{
+		competition: models.Competition{
+			ID:                id,
+			Name:              name,
+			StartDate:         startDate,
+			EndDate:           endDate,
+			BlackoutStartDate: endDate.AddDate(0, 0, -constants.BlackoutDays),
+			IsActive:          true,
+			ShowScoreboard:    true,
+			CreatedAt:         time.Now(),
+		},
+		participants: make(map[string]models.Participant),
+		solves:       make(map[string]map[int]time.Time),
+		teams:        make(map[string]models.Team),
+	}
+	return id, nil
+}
+
+// GetCompetition 작업 대상 대회 조회
 func (s *InMemoryStorage) GetCompetition() *models.Competition {
-	s.mu.RLock()
-	defer s.mu.RUnlock()
-	if s.competition == nil || !s.competition.IsActive {
+	s.state.mu.RLock()
+	defer s.state.mu.RUnlock()
+	comp := s.currentLocked()
+	if comp == nil {
 		return nil
 	}
 	// 사본 반환
-	c := *s.competition
+	c := comp.competition
 	return &c
 }
 
-// SetScoreboardVisibility 스코어보드 가시성 설정
-func (s *InMemoryStorage) SetScoreboardVisibility(visible bool) error {
-	s.mu.Lock()
-	defer s.mu.Unlock()
-	if s.competition == nil || !s.competition.IsActive {
+// GetActiveCompetitions 활성 대회 전체를 생성 순서대로 조회
+func (s *InMemoryStorage) GetActiveCompetitions() []models.Competition {
+	s.state.mu.RLock()
+	defer s.state.mu.RUnlock()
+	res := make([]models.Competition, 0, len(s.state.competitions))
+	for _, comp := range s.state.competitions {
+		if comp.competition.IsActive {
+			res = append(res, comp.competition)
+		}
+	}
+	sortCompetitions(res)
+	return res
+}
+
+// updateActive 활성 대상 대회의 필드 변경
+func (s *InMemoryStorage) updateActive(update func(c *models.Competition)) error {
+	s.state.mu.Lock()
+	defer s.state.mu.Unlock()
+	comp := s.activeLocked()
+	if comp == nil {
 		return fmt.Errorf("no active competition to update")
 	}
-	s.competition.ShowScoreboard = visible
+	update(&comp.competition)
 	return nil
 }
 
+// SetScoreboardVisibility 스코어보드 가시성 설정
+func (s *InMemoryStorage) SetScoreboardVisibility(visible bool) error {
+	return s.updateActive(func(c *models.Competition) { c.ShowScoreboard = visible })
+}
+
 // UpdateCompetitionName 이름 변경
 func (s *InMemoryStorage) UpdateCompetitionName(name string) error {
-	s.mu.Lock()
-	defer s.mu.Unlock()
-	if s.competition == nil || !s.competition.IsActive {
-		return fmt.Errorf("no active competition to update")
-	}
-	s.competition.Name = name
-	return nil
+	return s.updateActive(func(c *models.Competition) { c.Name = name })
 }
 
 // UpdateCompetitionStartDate 시작일 변경
 func (s *InMemoryStorage) UpdateCompetitionStartDate(startDate time.Time) error {
-	s.mu.Lock()
-	defer s.mu.Unlock()
-	if s.competition == nil || !s.competition.IsActive {
-		return fmt.Errorf("no active competition to update")
-	}
-	s.competition.StartDate = startDate
-	return nil
+	return s.updateActive(func(c *models.Competition) { c.StartDate = startDate })
 }
 
 // UpdateCompetitionEndDate 종료일 변경 및 블랙아웃 재계산
 func (s *InMemoryStorage) UpdateCompetitionEndDate(endDate time.Time) error {
-	s.mu.Lock()
-	defer s.mu.Unlock()
-	if s.competition == nil || !s.competition.IsActive {
+	return s.updateActive(func(c *models.Competition) {
+		c.EndDate = endDate
+		c.BlackoutStartDate = endDate.AddDate(0, 0, -constants.BlackoutDays)
+	})
+}
+
+// UpdateCompetitionScoringRules 점수 규칙 변경 (nil이면 기본 규칙)
+func (s *InMemoryStorage) UpdateCompetitionScoringRules(rules *models.ScoringRules) error {
+	return s.updateActive(func(c *models.Competition) { c.ScoringRules = rules })
+}
+
+// UpdateCompetitionEligibility 참가 자격 요건 변경 (nil이면 기본 요건)
+func (s *InMemoryStorage) UpdateCompetitionEligibility(policy *models.EligibilityPolicy) error {
+	return s.updateActive(func(c *models.Competition) { c.Eligibility = policy })
+}
+
+// UpdateCompetitionProblems 문제 목록 변경 (비어 있으면 자유 해결 대회)
+func (s *InMemoryStorage) UpdateCompetitionProblems(problems []models.TargetProblem) error {
+	return s.updateActive(func(c *models.Competition) {
+		if len(problems) == 0 {
+			c.Type = ""
+			c.Problems = nil
+			return
+		}
+		c.Type = models.CompetitionTypeProblemSet
+		c.Problems = append([]models.TargetProblem(nil), problems...)
+	})
+}
+
+// UpdateCompetitionChannel 대회 채널 지정 (같은 채널을 쓰던 다른 대회에서는 해제)
+func (s *InMemoryStorage) UpdateCompetitionChannel(channelID string) error {
+	s.state.mu.Lock()
+	defer s.state.mu.Unlock()
+	target := s.activeLocked()
+	if target == nil {
 		return fmt.Errorf("no active competition to update")
 	}
-	s.competition.EndDate = endDate
-	s.competition.BlackoutStartDate = endDate.AddDate(0, 0, -constants.BlackoutDays)
+	for _, comp := range s.state.competitions {
+		if comp != target && channelID != "" && comp.competition.ChannelID == channelID {
+			comp.competition.ChannelID = ""
+		}
+	}
+	target.competition.ChannelID = channelID
+	return nil
+}
+
+// UpdateCompetitionSchedule 일일 스코어보드 발송 시각 변경 (비어 있으면 기본 시각)
+func (s *InMemoryStorage) UpdateCompetitionSchedule(schedule string) error {
+	return s.updateActive(func(c *models.Competition) { c.ScoreboardTime = schedule })
+}
+
+// UpdateCompetitionRosterSheet 참가 명단 자동 동기화 스프레드시트 지정 (비어 있으면 동기화 안 함)
+func (s *InMemoryStorage) UpdateCompetitionRosterSheet(spreadsheetID string) error {
+	return s.updateActive(func(c *models.Competition) { c.RosterSheetID = spreadsheetID })
+}
+
+// FinalizeCompetition 최종 결과를 보관하고 대회를 비활성화
+func (s *InMemoryStorage) FinalizeCompetition(results []models.ArchivedResult) error {
+	s.state.mu.Lock()
+	defer s.state.mu.Unlock()
+	comp := s.activeLocked()
+	if comp == nil {
+		return fmt.Errorf("no active competition to finalize")
+	}
+	comp.results = append([]models.ArchivedResult(nil), results...)
+	models.SortArchivedResults(comp.results)
+	comp.competition.IsActive = false
+	comp.competition.FinalizedAt = time.Now()
+	comp.competition.ParticipantCount = len(results)
+	return nil
+}
+
+// GetArchivedCompetitions 확정된 대회를 최근 순으로 조회
+func (s *InMemoryStorage) GetArchivedCompetitions() []models.Competition {
+	s.state.mu.RLock()
+	defer s.state.mu.RUnlock()
+	res := make([]models.Competition, 0)
+	for _, comp := range s.state.competitions {
+		if comp.competition.IsFinalized() {
+			res = append(res, comp.competition)
+		}
+	}
+	models.SortArchivedCompetitions(res)
+	return res
+}
+
+// GetArchivedResults 작업 대상 대회의 보관된 결과 조회
+func (s *InMemoryStorage) GetArchivedResults() ([]models.ArchivedResult, error) {
+	s.state.mu.RLock()
+	defer s.state.mu.RUnlock()
+	comp := s.currentLocked()
+	if comp == nil {
+		return nil, fmt.Errorf("competition not found")
+	}
+	if !comp.competition.IsFinalized() {
+		return nil, fmt.Errorf("competition %s is not finalized", comp.competition.ID)
+	}
+	return append([]models.ArchivedResult(nil), comp.results...), nil
+}
+
+// SaveSnapshot 점수 스냅샷 저장
+func (s *InMemoryStorage) SaveSnapshot(snapshot models.ScoreSnapshot) error {
+	s.state.mu.Lock()
+	defer s.state.mu.Unlock()
+	comp := s.activeLocked()
+	if comp == nil {
+		return fmt.Errorf("no active competition")
+	}
+	snapshot.ID = fmt.Sprintf("snap-%d", snapshot.TakenAt.UnixNano())
+	comp.snapshots = append(comp.snapshots, snapshot)
+	sort.SliceStable(comp.snapshots, func(i, j int) bool {
+		return comp.snapshots[i].TakenAt.Before(comp.snapshots[j].TakenAt)
+	})
 	return nil
 }
 
+// GetSnapshots 점수 스냅샷 전체를 시간순으로 조회
+func (s *InMemoryStorage) GetSnapshots() ([]models.ScoreSnapshot, error) {
+	s.state.mu.RLock()
+	defer s.state.mu.RUnlock()
+	comp := s.currentLocked()
+	if comp == nil {
+		return []models.ScoreSnapshot{}, nil
+	}
+	return append([]models.ScoreSnapshot(nil), comp.snapshots...), nil
+}
+
+// GetLatestSnapshot 가장 최근 점수 스냅샷 조회 (없으면 nil)
+func (s *InMemoryStorage) GetLatestSnapshot() (*models.ScoreSnapshot, error) {
+	s.state.mu.RLock()
+	defer s.state.mu.RUnlock()
+	comp := s.currentLocked()
+	if comp == nil || len(comp.snapshots) == 0 {
+		return nil, nil
+	}
+	latest := comp.snapshots[len(comp.snapshots)-1]
+	return &latest, nil
+}
+
+// GetSeasons 시즌 전체를 시작일 순으로 조회
+func (s *InMemoryStorage) GetSeasons() []models.Season {
+	s.state.mu.RLock()
+	defer s.state.mu.RUnlock()
+	res := make([]models.Season, 0, len(s.state.seasons))
+	for _, season := range s.state.seasons {
+		res = append(res, season)
+	}
+	sortSeasons(res)
+	return res
+}
+
+// CreateSeason 시즌 생성 (같은 이름이 있으면 실패)
+func (s *InMemoryStorage) CreateSeason(season models.Season) error {
+	s.state.mu.Lock()
+	defer s.state.mu.Unlock()
+	id := seasonDocID(season.Name)
+	if _, exists := s.state.seasons[id]; exists {
+		return fmt.Errorf("season already exists: %s", season.Name)
+	}
+	season.ID = id
+	season.CreatedAt = time.Now()
+	s.state.seasons[id] = season
+	return nil
+}
+
+// DeleteSeason 시즌 삭제 (대회 결과는 그대로 유지)
+func (s *InMemoryStorage) DeleteSeason(name string) error {
+	s.state.mu.Lock()
+	defer s.state.mu.Unlock()
+	id := seasonDocID(name)
+	if _, exists := s.state.seasons[id]; !exists {
+		return fmt.Errorf("season not found: %s", name)
+	}
+	delete(s.state.seasons, id)
+	return nil
+}
+
+// GetGuildPermissions 서버 권한 설정 조회 (없으면 빈 설정)
+func (s *InMemoryStorage) GetGuildPermissions(guildID string) (*models.GuildPermissions, error) {
+	s.state.mu.RLock()
+	defer s.state.mu.RUnlock()
+	permissions := models.NewGuildPermissions(guildID)
+	if stored, exists := s.state.permissions[guildID]; exists {
+		permissions.Roles = copyGrants(stored.Roles)
+		permissions.Users = copyGrants(stored.Users)
+		permissions.UpdatedAt = stored.UpdatedAt
+	}
+	return permissions, nil
+}
+
+// SaveGuildPermissions 서버 권한 설정 저장
+func (s *InMemoryStorage) SaveGuildPermissions(permissions *models.GuildPermissions) error {
+	s.state.mu.Lock()
+	defer s.state.mu.Unlock()
+	s.state.permissions[permissions.GuildID] = models.GuildPermissions{
+		GuildID:   permissions.GuildID,
+		Roles:     copyGrants(permissions.Roles),
+		Users:     copyGrants(permissions.Users),
+		UpdatedAt: time.Now(),
+	}
+	return nil
+}
+
+// AppendAuditEntry 감사 로그 추가
+func (s *InMemoryStorage) AppendAuditEntry(entry models.AuditEntry) error {
+	s.state.mu.Lock()
+	defer s.state.mu.Unlock()
+	entry.ID = fmt.Sprintf("%d", len(s.state.audit)+1)
+	s.state.audit = append(s.state.audit, entry)
+	return nil
+}
+
+// GetAuditEntries 최근 감사 로그를 최신순으로 최대 limit개 조회
+func (s *InMemoryStorage) GetAuditEntries(limit int) ([]models.AuditEntry, error) {
+	s.state.mu.RLock()
+	defer s.state.mu.RUnlock()
+	res := make([]models.AuditEntry, 0, limit)
+	for i := len(s.state.audit) - 1; i >= 0 && len(res) < limit; i-- {
+		res = append(res, s.state.audit[i])
+	}
+	return res, nil
+}
+
+// copyGrants 권한 맵 복사 (뷰 사이에 슬라이스를 공유하지 않도록)
+func copyGrants(grants map[string][]string) map[string][]string {
+	res := make(map[string][]string, len(grants))
+	for capability, ids := range grants {
+		res[capability] = append([]string(nil), ids...)
+	}
+	return res
+}
+
 // IsBlackoutPeriod 블랙아웃 기간 여부
 func (s *InMemoryStorage) IsBlackoutPeriod() bool {
-	s.mu.RLock()
-	defer s.mu.RUnlock()
-	if s.competition == nil {
+	comp := s.GetCompetition()
+	if comp == nil {
 		return false
 	}
 	now := time.Now()
-	return now.After(s.competition.BlackoutStartDate) && now.Before(s.competition.EndDate)
+	return now.After(comp.BlackoutStartDate) && now.Before(comp.EndDate)
 }
 
 // SaveCompetition no-op
@@ -188,20 +690,172 @@ func (s *InMemoryStorage) SaveParticipants() error { return nil }
 // Close 인메모리 스토리지는 정리할 리소스가 없으므로 no-op입니다.
 func (s *InMemoryStorage) Close() error { return nil }
 
-// 내부 헬퍼: 시작 문제 목록 로딩
-func (s *InMemoryStorage) fetchStartingProblems(baekjoonID string) ([]int, int) {
-	ids := []int{}
-	count := 0
-	ctx := context.Background()
-	top100, err := s.apiClient.GetUserTop100(ctx, baekjoonID)
-	if err == nil {
-		for _, item := range top100.Items {
-			ids = append(ids, item.ProblemID)
+// BackfillStartSnapshots 전체 스냅샷이 없는 참가자들의 등록 시점 스냅샷 생성
+func (s *InMemoryStorage) BackfillStartSnapshots() (int, error) {
+	if s.GetCompetition() == nil {
+		return 0, fmt.Errorf("no active competition")
+	}
+
+	updated := 0
+	for _, participant := range s.GetParticipants() {
+		if participant.HasFullSnapshot() {
+			continue
+		}
+
+		snapshot, count, err := backfillParticipantSnapshot(s.apiClient, participant)
+		if err != nil {
+			utils.Warn("Failed to backfill snapshot for participant %s: %v", participant.BaekjoonID, err)
+			continue
+		}
+
+		s.state.mu.Lock()
+		if comp := s.currentLocked(); comp != nil {
+			if p, ok := comp.participants[participant.BaekjoonID]; ok {
+				p.StartSnapshot = snapshot
+				p.StartProblemCount = count
+				comp.participants[participant.BaekjoonID] = p
+				updated++
+			}
+		}
+		s.state.mu.Unlock()
+	}
+	return updated, nil
+}
+
+// RecordSolves 처음 발견된 문제의 발견 시각을 기록하고 전체 기록을 반환
+func (s *InMemoryStorage) RecordSolves(baekjoonID string, problemIDs []int, seenAt time.Time) (map[int]time.Time, error) {
+	s.state.mu.Lock()
+	defer s.state.mu.Unlock()
+	comp := s.activeLocked()
+	if comp == nil {
+		return nil, fmt.Errorf("no active competition")
+	}
+	records, ok := comp.solves[baekjoonID]
+	if !ok {
+		records = make(map[int]time.Time)
+		comp.solves[baekjoonID] = records
+	}
+	mergeFirstSeen(records, problemIDs, seenAt)
+	return copyFirstSeen(records), nil
+}
+
+// GetSolveRecords 참가자의 문제별 최초 발견 시각 조회
+func (s *InMemoryStorage) GetSolveRecords(baekjoonID string) (map[int]time.Time, error) {
+	s.state.mu.RLock()
+	defer s.state.mu.RUnlock()
+	comp := s.currentLocked()
+	if comp == nil {
+		return nil, fmt.Errorf("no active competition")
+	}
+	return copyFirstSeen(comp.solves[baekjoonID]), nil
+}
+
+// GetTeams 팀 전체를 이름순으로 조회
+func (s *InMemoryStorage) GetTeams() []models.Team {
+	s.state.mu.RLock()
+	defer s.state.mu.RUnlock()
+	comp := s.currentLocked()
+	if comp == nil {
+		return []models.Team{}
+	}
+	res := make([]models.Team, 0, len(comp.teams))
+	for _, team := range comp.teams {
+		team.Members = append([]string(nil), team.Members...)
+		res = append(res, team)
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i].Name < res[j].Name })
+	return res
+}
+
+// CreateTeam 팀 생성
+func (s *InMemoryStorage) CreateTeam(name string) error {
+	s.state.mu.Lock()
+	defer s.state.mu.Unlock()
+	if !utils.IsValidTeamName(name) {
+		return fmt.Errorf("invalid team name: %s", name)
+	}
+	comp := s.activeLocked()
+	if comp == nil {
+		return fmt.Errorf("no active competition")
+	}
+	key := strings.ToLower(name)
+	if _, exists := comp.teams[key]; exists {
+		return fmt.Errorf("team %s already exists", name)
+	}
+	comp.teams[key] = models.Team{ID: key, Name: utils.SanitizeString(name), Members: []string{}, CreatedAt: time.Now()}
+	return nil
+}
+
+// DeleteTeam 팀 삭제
+func (s *InMemoryStorage) DeleteTeam(name string) error {
+	s.state.mu.Lock()
+	defer s.state.mu.Unlock()
+	comp := s.activeLocked()
+	if comp == nil {
+		return fmt.Errorf("no active competition")
+	}
+	key := strings.ToLower(name)
+	if _, ok := comp.teams[key]; !ok {
+		return fmt.Errorf("team not found: %s", name)
+	}
+	delete(comp.teams, key)
+	return nil
+}
+
+// AssignTeam 참가자를 팀에 배정 (기존 팀에서는 제거)
+func (s *InMemoryStorage) AssignTeam(teamName, baekjoonID string) error {
+	s.state.mu.Lock()
+	defer s.state.mu.Unlock()
+	comp := s.activeLocked()
+	if comp == nil {
+		return fmt.Errorf("no active competition")
+	}
+	key := strings.ToLower(teamName)
+	target, ok := comp.teams[key]
+	if !ok {
+		return fmt.Errorf("team not found: %s", teamName)
+	}
+	if target.HasMember(baekjoonID) {
+		return nil
+	}
+	if len(target.Members) >= constants.MaxTeamSize {
+		return fmt.Errorf("%w: %s", interfaces.ErrTeamFull, teamName)
+	}
+	comp.removeFromTeams(baekjoonID)
+	target = comp.teams[key]
+	target.Members = append(target.Members, baekjoonID)
+	comp.teams[key] = target
+	return nil
+}
+
+// LeaveTeam 참가자를 소속 팀에서 제거
+func (s *InMemoryStorage) LeaveTeam(baekjoonID string) error {
+	s.state.mu.Lock()
+	defer s.state.mu.Unlock()
+	comp := s.activeLocked()
+	if comp == nil {
+		return fmt.Errorf("no active competition")
+	}
+	if !comp.removeFromTeams(baekjoonID) {
+		return fmt.Errorf("participant %s is not in a team", baekjoonID)
+	}
+	return nil
+}
+
+// removeFromTeams 모든 팀에서 참가자를 제거하고 제거 여부를 반환 (호출자가 잠금 보유)
+func (comp *memoryCompetition) removeFromTeams(baekjoonID string) bool {
+	removed := false
+	for key, team := range comp.teams {
+		members := make([]string, 0, len(team.Members))
+		for _, member := range team.Members {
+			if strings.EqualFold(member, baekjoonID) {
+				removed = true
+				continue
+			}
+			members = append(members, member)
 		}
-		count = len(ids)
-		utils.Info("Loaded %d starting problems for participant %s", count, baekjoonID)
-	} else {
-		utils.Warn("Failed to load starting problems for participant %s: %v", baekjoonID, err)
+		team.Members = members
+		comp.teams[key] = team
 	}
-	return ids, count
+	return removed
 }
@@ -0,0 +1,38 @@
+package storage
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/ssugameworks/kkemi/models"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// GetGuildPermissions 서버의 권한 설정을 조회합니다. 저장된 설정이 없으면 빈 설정을 반환합니다.
+func (s *FirebaseStorage) GetGuildPermissions(guildID string) (*models.GuildPermissions, error) {
+	permissions := models.NewGuildPermissions(guildID)
+	doc, err := s.client().Collection("guildPermissions").Doc(guildID).Get(s.ctx)
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return permissions, nil
+		}
+		return nil, fmt.Errorf("failed to load guild permissions %s: %w", guildID, err)
+	}
+
+	if err := doc.DataTo(permissions); err != nil {
+		return nil, fmt.Errorf("failed to decode guild permissions %s: %w", guildID, err)
+	}
+	permissions.GuildID = guildID
+	return permissions, nil
+}
+
+// SaveGuildPermissions 서버의 권한 설정을 저장합니다.
+func (s *FirebaseStorage) SaveGuildPermissions(permissions *models.GuildPermissions) error {
+	permissions.UpdatedAt = time.Now()
+	if _, err := s.client().Collection("guildPermissions").Doc(permissions.GuildID).Set(s.ctx, permissions); err != nil {
+		return fmt.Errorf("failed to save guild permissions %s: %w", permissions.GuildID, err)
+	}
+	return nil
+}
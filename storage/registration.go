@@ -0,0 +1,65 @@
+package storage
+
+import (
+	"fmt"
+
+	"github.com/ssugameworks/kkemi/models"
+
+	"cloud.google.com/go/firestore"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// challengesCollection 대회의 등록 본인 확인 토큰 컬렉션 참조를 반환합니다.
+func (s *FirebaseStorage) challengesCollection(competitionID string) *firestore.CollectionRef {
+	return s.client().Collection("competitions").Doc(competitionID).Collection("registrationChallenges")
+}
+
+// SaveRegistrationChallenge 등록 본인 확인 토큰을 저장합니다. 같은 사용자의 이전 토큰은 교체됩니다.
+func (s *FirebaseStorage) SaveRegistrationChallenge(challenge models.RegistrationChallenge) error {
+	competition := s.GetCompetition()
+	if competition == nil || !competition.IsActive {
+		return fmt.Errorf("no active competition")
+	}
+
+	return s.executeWithRetry(func() error {
+		_, err := s.challengesCollection(competition.ID).Doc(challenge.DiscordUserID).Set(s.ctx, challenge)
+		return err
+	})
+}
+
+// GetRegistrationChallenge 디스코드 사용자의 등록 본인 확인 토큰을 조회합니다. 없으면 nil을 반환합니다.
+func (s *FirebaseStorage) GetRegistrationChallenge(discordUserID string) (*models.RegistrationChallenge, error) {
+	competition := s.GetCompetition()
+	if competition == nil {
+		return nil, nil
+	}
+
+	doc, err := s.challengesCollection(competition.ID).Doc(discordUserID).Get(s.ctx)
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to load registration challenge %s: %w", discordUserID, err)
+	}
+
+	var challenge models.RegistrationChallenge
+	if err := doc.DataTo(&challenge); err != nil {
+		return nil, fmt.Errorf("failed to decode registration challenge %s: %w", discordUserID, err)
+	}
+	challenge.DiscordUserID = discordUserID
+	return &challenge, nil
+}
+
+// DeleteRegistrationChallenge 등록 본인 확인 토큰을 삭제합니다.
+func (s *FirebaseStorage) DeleteRegistrationChallenge(discordUserID string) error {
+	competition := s.GetCompetition()
+	if competition == nil {
+		return fmt.Errorf("no active competition")
+	}
+
+	if _, err := s.challengesCollection(competition.ID).Doc(discordUserID).Delete(s.ctx); err != nil {
+		return fmt.Errorf("failed to delete registration challenge %s: %w", discordUserID, err)
+	}
+	return nil
+}
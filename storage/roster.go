@@ -0,0 +1,115 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/ssugameworks/kkemi/constants"
+	"github.com/ssugameworks/kkemi/models"
+
+	"cloud.google.com/go/firestore"
+	"google.golang.org/api/iterator"
+)
+
+// rosterCollection 대회의 참가 명단 컬렉션 참조를 반환합니다.
+func (s *FirebaseStorage) rosterCollection(competitionID string) *firestore.CollectionRef {
+	return s.client().Collection("competitions").Doc(competitionID).Collection("roster")
+}
+
+// rosterDocID 명단 항목의 문서 ID(정규화한 이름)를 반환합니다.
+func rosterDocID(name string) (string, error) {
+	key := models.NormalizeRosterName(name)
+	if key == "" || key == "." || key == ".." || strings.Contains(key, "/") {
+		return "", fmt.Errorf("invalid roster name %q", name)
+	}
+	return key, nil
+}
+
+// GetRoster 대회 참가 명단을 이름순으로 조회합니다.
+func (s *FirebaseStorage) GetRoster() ([]models.RosterEntry, error) {
+	competition := s.GetCompetition()
+	if competition == nil {
+		return []models.RosterEntry{}, nil
+	}
+
+	entries := make([]models.RosterEntry, 0)
+	iter := s.rosterCollection(competition.ID).OrderBy("name", firestore.Asc).Documents(s.ctx)
+	defer iter.Stop()
+	for {
+		doc, err := iter.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to iterate roster: %w", err)
+		}
+
+		var entry models.RosterEntry
+		if err := doc.DataTo(&entry); err != nil {
+			return nil, fmt.Errorf("failed to decode roster entry %s: %w", doc.Ref.ID, err)
+		}
+		entries = append(entries, entry)
+	}
+	return entries, nil
+}
+
+// SaveRosterEntries 참가 명단 항목을 저장합니다. 같은 이름의 항목은 교체됩니다.
+func (s *FirebaseStorage) SaveRosterEntries(entries []models.RosterEntry) error {
+	competition := s.GetCompetition()
+	if competition == nil {
+		return fmt.Errorf("no active competition")
+	}
+
+	for start := 0; start < len(entries); start += constants.RosterWriteBatchSize {
+		chunk := entries[start:min(start+constants.RosterWriteBatchSize, len(entries))]
+		err := s.executeWithRetry(func() error {
+			return s.client().RunTransaction(s.ctx, func(ctx context.Context, tx *firestore.Transaction) error {
+				for _, entry := range chunk {
+					id, err := rosterDocID(entry.Name)
+					if err != nil {
+						return err
+					}
+					if err := tx.Set(s.rosterCollection(competition.ID).Doc(id), entry); err != nil {
+						return err
+					}
+				}
+				return nil
+			})
+		})
+		if err != nil {
+			return fmt.Errorf("failed to save roster entries: %w", err)
+		}
+	}
+	return nil
+}
+
+// RemoveRosterEntries 참가 명단에서 이름을 삭제합니다. 없는 이름은 무시합니다.
+func (s *FirebaseStorage) RemoveRosterEntries(names []string) error {
+	competition := s.GetCompetition()
+	if competition == nil {
+		return fmt.Errorf("no active competition")
+	}
+
+	for start := 0; start < len(names); start += constants.RosterWriteBatchSize {
+		chunk := names[start:min(start+constants.RosterWriteBatchSize, len(names))]
+		err := s.executeWithRetry(func() error {
+			return s.client().RunTransaction(s.ctx, func(ctx context.Context, tx *firestore.Transaction) error {
+				for _, name := range chunk {
+					id, err := rosterDocID(name)
+					if err != nil {
+						return err
+					}
+					if err := tx.Delete(s.rosterCollection(competition.ID).Doc(id)); err != nil {
+						return err
+					}
+				}
+				return nil
+			})
+		})
+		if err != nil {
+			return fmt.Errorf("failed to remove roster entries: %w", err)
+		}
+	}
+	return nil
+}
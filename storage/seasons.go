@@ -0,0 +1,79 @@
+package storage
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/ssugameworks/kkemi/models"
+	"github.com/ssugameworks/kkemi/utils"
+
+	"google.golang.org/api/iterator"
+)
+
+// seasonDocID 시즌 이름으로 문서 ID를 만듭니다 (대소문자 무시)
+func seasonDocID(name string) string {
+	return strings.ToLower(name)
+}
+
+// sortSeasons 시즌을 시작일(같으면 이름) 순으로 정렬합니다.
+func sortSeasons(seasons []models.Season) {
+	sort.SliceStable(seasons, func(i, j int) bool {
+		if !seasons[i].StartDate.Equal(seasons[j].StartDate) {
+			return seasons[i].StartDate.Before(seasons[j].StartDate)
+		}
+		return seasons[i].Name < seasons[j].Name
+	})
+}
+
+// GetSeasons 모든 시즌을 시작일 순으로 조회합니다.
+func (s *FirebaseStorage) GetSeasons() []models.Season {
+	seasons := make([]models.Season, 0)
+	iter := s.client().Collection("seasons").Documents(s.ctx)
+	for {
+		doc, err := iter.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			utils.Error("Failed to iterate seasons: %v", err)
+			return seasons
+		}
+
+		var season models.Season
+		doc.DataTo(&season)
+		season.ID = doc.Ref.ID
+		seasons = append(seasons, season)
+	}
+
+	sortSeasons(seasons)
+	return seasons
+}
+
+// CreateSeason 새 시즌을 만듭니다. 같은 이름의 시즌이 있으면 실패합니다.
+func (s *FirebaseStorage) CreateSeason(season models.Season) error {
+	season.Name = utils.SanitizeString(season.Name)
+	season.CreatedAt = time.Now()
+	if _, err := s.client().Collection("seasons").Doc(seasonDocID(season.Name)).Create(s.ctx, season); err != nil {
+		return fmt.Errorf("failed to create season %s: %w", season.Name, err)
+	}
+
+	utils.Info("Created season: %s", season.Name)
+	return nil
+}
+
+// DeleteSeason 시즌을 삭제합니다. 시즌에 포함된 대회 결과는 그대로 남습니다.
+func (s *FirebaseStorage) DeleteSeason(name string) error {
+	docRef := s.client().Collection("seasons").Doc(seasonDocID(name))
+	doc, err := docRef.Get(s.ctx)
+	if err != nil || !doc.Exists() {
+		return fmt.Errorf("season not found: %s", name)
+	}
+	if _, err := docRef.Delete(s.ctx); err != nil {
+		return fmt.Errorf("failed to delete season %s: %w", name, err)
+	}
+
+	utils.Info("Deleted season: %s", name)
+	return nil
+}
@@ -0,0 +1,79 @@
+package storage
+
+import (
+	"fmt"
+
+	"github.com/ssugameworks/kkemi/models"
+
+	"cloud.google.com/go/firestore"
+	"google.golang.org/api/iterator"
+)
+
+// snapshotsCollection 대회의 점수 스냅샷 컬렉션 참조를 반환합니다.
+func (s *FirebaseStorage) snapshotsCollection(competitionID string) *firestore.CollectionRef {
+	return s.client().Collection("competitions").Doc(competitionID).Collection("snapshots")
+}
+
+// SaveSnapshot 현재 대회의 점수 스냅샷을 저장합니다.
+func (s *FirebaseStorage) SaveSnapshot(snapshot models.ScoreSnapshot) error {
+	competition := s.GetCompetition()
+	if competition == nil || !competition.IsActive {
+		return fmt.Errorf("no active competition")
+	}
+
+	return s.executeWithRetry(func() error {
+		_, _, err := s.snapshotsCollection(competition.ID).Add(s.ctx, snapshot)
+		return err
+	})
+}
+
+// GetSnapshots 현재 대회의 점수 스냅샷을 시간순으로 조회합니다.
+func (s *FirebaseStorage) GetSnapshots() ([]models.ScoreSnapshot, error) {
+	competition := s.GetCompetition()
+	if competition == nil {
+		return []models.ScoreSnapshot{}, nil
+	}
+
+	snapshots := make([]models.ScoreSnapshot, 0)
+	iter := s.snapshotsCollection(competition.ID).OrderBy("takenAt", firestore.Asc).Documents(s.ctx)
+	for {
+		doc, err := iter.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to iterate snapshots: %w", err)
+		}
+
+		var snapshot models.ScoreSnapshot
+		if err := doc.DataTo(&snapshot); err != nil {
+			return nil, fmt.Errorf("failed to decode snapshot %s: %w", doc.Ref.ID, err)
+		}
+		snapshot.ID = doc.Ref.ID
+		snapshots = append(snapshots, snapshot)
+	}
+	return snapshots, nil
+}
+
+// GetLatestSnapshot 현재 대회의 가장 최근 점수 스냅샷을 조회합니다. 없으면 nil을 반환합니다.
+func (s *FirebaseStorage) GetLatestSnapshot() (*models.ScoreSnapshot, error) {
+	competition := s.GetCompetition()
+	if competition == nil {
+		return nil, nil
+	}
+
+	docs, err := s.snapshotsCollection(competition.ID).OrderBy("takenAt", firestore.Desc).Limit(1).Documents(s.ctx).GetAll()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get latest snapshot: %w", err)
+	}
+	if len(docs) == 0 {
+		return nil, nil
+	}
+
+	var snapshot models.ScoreSnapshot
+	if err := docs[0].DataTo(&snapshot); err != nil {
+		return nil, fmt.Errorf("failed to decode snapshot %s: %w", docs[0].Ref.ID, err)
+	}
+	snapshot.ID = docs[0].Ref.ID
+	return &snapshot, nil
+}
@@ -0,0 +1,50 @@
+package storage
+
+import (
+	"strconv"
+	"time"
+)
+
+// solveRecordDoc 참가자별 문제 최초 발견 시각 문서 (competitions/{id}/solves/{baekjoonID})
+type solveRecordDoc struct {
+	FirstSeen map[string]time.Time `firestore:"firstSeen"`
+}
+
+// decodeFirstSeen Firestore 맵 키(문자열)를 문제 ID로 변환합니다
+func decodeFirstSeen(stored map[string]time.Time) map[int]time.Time {
+	records := make(map[int]time.Time, len(stored))
+	for key, seenAt := range stored {
+		id, err := strconv.Atoi(key)
+		if err != nil || id <= 0 {
+			continue
+		}
+		records[id] = seenAt
+	}
+	return records
+}
+
+// mergeFirstSeen 기록되지 않은 문제만 seenAt으로 추가하고 새로 추가된 항목을 반환합니다
+// 이미 기록된 문제의 최초 발견 시각은 절대 덮어쓰지 않습니다
+func mergeFirstSeen(records map[int]time.Time, problemIDs []int, seenAt time.Time) map[string]interface{} {
+	additions := make(map[string]interface{})
+	for _, id := range problemIDs {
+		if id <= 0 {
+			continue
+		}
+		if _, exists := records[id]; exists {
+			continue
+		}
+		records[id] = seenAt
+		additions[strconv.Itoa(id)] = seenAt
+	}
+	return additions
+}
+
+// copyFirstSeen 호출자가 내부 상태를 수정하지 못하도록 기록 사본을 만듭니다
+func copyFirstSeen(records map[int]time.Time) map[int]time.Time {
+	result := make(map[int]time.Time, len(records))
+	for id, seenAt := range records {
+		result[id] = seenAt
+	}
+	return result
+}
@@ -0,0 +1,99 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ssugameworks/kkemi/api"
+	"github.com/ssugameworks/kkemi/interfaces"
+	"github.com/ssugameworks/kkemi/models"
+	"github.com/ssugameworks/kkemi/utils"
+)
+
+// legacyTop100Size 레거시 스냅샷(TOP 100)이 가득 찼는지 판단하는 기준
+const legacyTop100Size = 100
+
+// fetchStartSnapshot 등록 시점의 전체 해결 문제 집합을 조회하여 압축된 스냅샷으로 반환합니다
+// 조회에 실패하면 interfaces.ErrStartSnapshotUnavailable을 감싼 에러를 반환하며, 호출한 쪽은 참가자를 저장하지 않아야 합니다
+func fetchStartSnapshot(apiClient interfaces.APIClient, baekjoonID string) ([]byte, int, error) {
+	ctx := context.Background()
+	solved, err := apiClient.GetUserSolvedProblems(ctx, baekjoonID)
+	if err != nil {
+		utils.Warn("Failed to load starting problems for participant %s: %v", baekjoonID, err)
+		return nil, 0, fmt.Errorf("%w for %s: %v", interfaces.ErrStartSnapshotUnavailable, baekjoonID, err)
+	}
+
+	ids := make([]int, 0, len(solved))
+	for _, problem := range solved {
+		ids = append(ids, problem.ProblemID)
+	}
+
+	snapshot := models.EncodeProblemIDs(ids)
+	utils.Info("Loaded %d starting problems for participant %s (%d bytes)", len(ids), baekjoonID, len(snapshot))
+	return snapshot, len(ids), nil
+}
+
+// buildBackfillSnapshot 레거시 TOP 100 스냅샷만 가진 참가자의 전체 스냅샷을 재구성합니다
+//
+// 등록 시점의 전체 목록은 복원할 수 없으므로 다음 규칙으로 근사합니다.
+//   - 레거시 목록이 100개 미만이면 그 자체가 전체 해결 목록이었으므로 그대로 사용합니다.
+//   - 100개가 가득 찼다면 레거시 목록 중 최저 난이도보다 낮은 현재 해결 문제를 스냅샷에 추가합니다.
+//     이 문제들은 기존 TOP 100 방식에서도 점수로 인정될 수 없었으므로 기존 점수는 유지됩니다.
+func buildBackfillSnapshot(participant models.Participant, solved []api.ProblemInfo) []int {
+	ids := make([]int, 0, len(participant.StartProblemIDs))
+	ids = append(ids, participant.StartProblemIDs...)
+
+	if len(participant.StartProblemIDs) < legacyTop100Size {
+		return ids
+	}
+
+	legacy := make(map[int]bool, len(participant.StartProblemIDs))
+	for _, id := range participant.StartProblemIDs {
+		legacy[id] = true
+	}
+
+	// 레거시 TOP 100의 최저 난이도 계산 (현재 난이도 기준)
+	minLegacyLevel := -1
+	for _, problem := range solved {
+		if legacy[problem.ProblemID] && (minLegacyLevel == -1 || problem.Level < minLegacyLevel) {
+			minLegacyLevel = problem.Level
+		}
+	}
+	if minLegacyLevel == -1 {
+		return ids
+	}
+
+	for _, problem := range solved {
+		if !legacy[problem.ProblemID] && problem.Level < minLegacyLevel {
+			ids = append(ids, problem.ProblemID)
+		}
+	}
+	return ids
+}
+
+// backfillParticipantSnapshot 참가자 한 명의 전체 스냅샷을 생성합니다
+func backfillParticipantSnapshot(apiClient interfaces.APIClient, participant models.Participant) ([]byte, int, error) {
+	ctx := context.Background()
+	solved, err := apiClient.GetUserSolvedProblems(ctx, participant.BaekjoonID)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	var ids []int
+	if len(participant.StartProblemIDs) == 0 {
+		// 레거시 목록조차 없다면 등록 직후 조회에 실패한 경우이므로 현재 목록을 스냅샷으로 사용
+		ids = make([]int, 0, len(solved))
+		for _, problem := range solved {
+			ids = append(ids, problem.ProblemID)
+		}
+	} else {
+		ids = buildBackfillSnapshot(participant, solved)
+	}
+
+	snapshot := models.EncodeProblemIDs(ids)
+	decoded, err := models.DecodeProblemIDs(snapshot)
+	if err != nil {
+		return nil, 0, err
+	}
+	return snapshot, len(decoded), nil
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"sort"
 	"strings"
 	"sync"
 	"time"
@@ -21,11 +22,27 @@ import (
 
 // FirebaseStorage Firestore를 사용하여 데이터를 관리하는 저장소입니다.
 type FirebaseStorage struct {
-	client         *firestore.Client
-	apiClient      interfaces.APIClient
-	ctx            context.Context
-	app            *firebase.App
-	reconnectMutex sync.Mutex
+	// conn 대회별 뷰가 함께 쓰는 연결 상태 (재연결하면 모든 뷰에 반영)
+	conn      *firestoreConn
+	apiClient interfaces.APIClient
+	ctx       context.Context
+	// competitionID 작업 대상 대회 (비어 있으면 가장 최근에 만든 활성 대회)
+	competitionID string
+}
+
+// firestoreConn Firestore 클라이언트와 재연결 방법을 담은 공유 연결 상태입니다
+type firestoreConn struct {
+	mu     sync.RWMutex
+	client *firestore.Client
+	// dial 새 클라이언트를 만듭니다 (재연결용)
+	dial func(ctx context.Context) (*firestore.Client, error)
+}
+
+// current 현재 사용 중인 클라이언트를 반환합니다
+func (c *firestoreConn) current() *firestore.Client {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.client
 }
 
 // 에러 복구 관련 상수
@@ -59,10 +76,14 @@ func NewStorage(apiClient interfaces.APIClient) (interfaces.StorageRepository, e
 	}
 
 	s := &FirebaseStorage{
-		client:    client,
+		conn: &firestoreConn{
+			client: client,
+			dial: func(ctx context.Context) (*firestore.Client, error) {
+				return app.Firestore(ctx)
+			},
+		},
 		apiClient: apiClient,
 		ctx:       ctx,
-		app:       app,
 	}
 
 	utils.Info("Firebase storage system initialized successfully")
@@ -71,24 +92,36 @@ func NewStorage(apiClient interfaces.APIClient) (interfaces.StorageRepository, e
 
 // GetClient Firestore 클라이언트를 반환합니다 (헬스체크용)
 func (s *FirebaseStorage) GetClient() interface{} {
-	return s.client
+	return s.conn.current()
+}
+
+// client 현재 Firestore 클라이언트를 반환합니다
+func (s *FirebaseStorage) client() *firestore.Client {
+	return s.conn.current()
 }
 
-// reconnectFirestore Firestore 클라이언트를 재연결합니다
-func (s *FirebaseStorage) reconnectFirestore() error {
-	s.reconnectMutex.Lock()
-	defer s.reconnectMutex.Unlock()
+// reconnectFirestore Firestore 클라이언트를 재연결합니다.
+// failed는 오류가 난 클라이언트이며, 그사이 다른 뷰가 이미 재연결했다면 다시 연결하지 않습니다.
+func (s *FirebaseStorage) reconnectFirestore(failed *firestore.Client) error {
+	s.conn.mu.Lock()
+	defer s.conn.mu.Unlock()
+
+	if s.conn.client != failed {
+		utils.Info("Firestore client was already reconnected")
+		return nil
+	}
 
 	utils.Warn("Attempting to reconnect to Firestore")
 
 	for attempt := 1; attempt <= maxReconnectAttempts; attempt++ {
 		// 기존 클라이언트 종료
-		if s.client != nil {
-			s.client.Close()
+		if s.conn.client != nil {
+			s.conn.client.Close()
+			s.conn.client = nil
 		}
 
 		// 새 클라이언트 생성
-		newClient, err := s.app.Firestore(s.ctx)
+		newClient, err := s.conn.dial(s.ctx)
 		if err != nil {
 			utils.Warn("Firestore reconnection attempt %d/%d failed: %v", attempt, maxReconnectAttempts, err)
 			if attempt < maxReconnectAttempts {
@@ -97,7 +130,7 @@ func (s *FirebaseStorage) reconnectFirestore() error {
 			continue
 		}
 
-		s.client = newClient
+		s.conn.client = newClient
 		utils.Info("Successfully reconnected to Firestore on attempt %d", attempt)
 		return nil
 	}
@@ -107,12 +140,13 @@ func (s *FirebaseStorage) reconnectFirestore() error {
 
 // executeWithRetry Firestore 작업을 재시도 로직과 함께 실행합니다
 func (s *FirebaseStorage) executeWithRetry(operation func() error) error {
+	client := s.client()
 	err := operation()
 	if err != nil {
 		// Firestore 연결 오류인 경우 재연결 시도
 		if isFirestoreConnectionError(err) {
 			utils.Warn("Detected Firestore connection error, attempting reconnection: %v", err)
-			if reconnectErr := s.reconnectFirestore(); reconnectErr != nil {
+			if reconnectErr := s.reconnectFirestore(client); reconnectErr != nil {
 				return fmt.Errorf("operation failed and reconnection failed: %w (original: %v)", reconnectErr, err)
 			}
 			// 재연결 성공 시 작업 재시도
@@ -138,7 +172,7 @@ func isFirestoreConnectionError(err error) bool {
 }
 
 // AddParticipant 새로운 참가자를 Firestore에 추가합니다.
-func (s *FirebaseStorage) AddParticipant(name, baekjoonID string, startTier, startRating int, organizationID int) error {
+func (s *FirebaseStorage) AddParticipant(name, baekjoonID string, startTier, startRating int, organizationID int, discordUserID string) error {
 	return s.executeWithRetry(func() error {
 		// 입력값 검증
 		if !utils.IsValidUsername(name) {
@@ -153,20 +187,12 @@ func (s *FirebaseStorage) AddParticipant(name, baekjoonID string, startTier, sta
 			return fmt.Errorf("no active competition to add participant to")
 		}
 
-		// 중복 확인
-		existingDoc, err := s.client.Collection("competitions").Doc(competition.ID).Collection("participants").Doc(baekjoonID).Get(s.ctx)
-		if err == nil && existingDoc.Exists() {
-			return fmt.Errorf("participant with Baekjoon ID %s already exists", baekjoonID)
-		}
-
-		// 이름 중복 확인
-		iter := s.client.Collection("competitions").Doc(competition.ID).Collection("participants").Where("name", "==", name).Limit(1).Documents(s.ctx)
-		if doc, err := iter.Next(); err == nil && doc != nil {
-			return fmt.Errorf("participant with name %s already exists", name)
+		// 시작 스냅샷은 외부 API 호출이므로 트랜잭션 밖에서 먼저 가져옵니다
+		startSnapshot, startProblemCount, err := fetchStartSnapshot(s.apiClient, baekjoonID)
+		if err != nil {
+			return err
 		}
 
-		startProblemIDs, startProblemCount := s.fetchStartingProblems(baekjoonID)
-
 		participant := models.Participant{
 			Name:              utils.SanitizeString(name),
 			BaekjoonID:        baekjoonID,
@@ -174,11 +200,43 @@ func (s *FirebaseStorage) AddParticipant(name, baekjoonID string, startTier, sta
 			StartTier:         startTier,
 			StartRating:       startRating,
 			CreatedAt:         time.Now(),
-			StartProblemIDs:   startProblemIDs,
 			StartProblemCount: startProblemCount,
+			StartSnapshot:     startSnapshot,
+			DiscordUserID:     discordUserID,
 		}
 
-		_, err = s.client.Collection("competitions").Doc(competition.ID).Collection("participants").Doc(baekjoonID).Set(s.ctx, participant)
+		participants := s.client().Collection("competitions").Doc(competition.ID).Collection("participants")
+		docRef := participants.Doc(baekjoonID)
+		// 중복 확인과 저장을 한 트랜잭션으로 묶어 같은 디스코드 사용자의 동시 등록을 막습니다
+		err = s.client().RunTransaction(s.ctx, func(ctx context.Context, tx *firestore.Transaction) error {
+			docs, err := tx.GetAll([]*firestore.DocumentRef{docRef})
+			if err != nil {
+				return err
+			}
+			if docs[0].Exists() {
+				return fmt.Errorf("participant with Baekjoon ID %s already exists", baekjoonID)
+			}
+
+			// 이름 중복 확인
+			sameName, err := tx.Documents(participants.Where("name", "==", name).Limit(1)).GetAll()
+			if err != nil {
+				return err
+			}
+			if len(sameName) > 0 {
+				return fmt.Errorf("participant with name %s already exists", name)
+			}
+
+			// 디스코드 사용자당 참가자 하나
+			linked, found, err := findDiscordLink(tx, participants, discordUserID)
+			if err != nil {
+				return err
+			}
+			if found {
+				return fmt.Errorf("discord user %s is already linked to %s", discordUserID, linked)
+			}
+
+			return tx.Create(docRef, participant)
+		})
 		if err != nil {
 			return fmt.Errorf("failed to add participant: %w", err)
 		}
@@ -197,7 +255,7 @@ func (s *FirebaseStorage) GetParticipants() []models.Participant {
 
 	// 메모리 할당 최적화: 초기 용량 할당
 	participants := make([]models.Participant, 0, 50) // 대부분의 대회는 50명 미만
-	iter := s.client.Collection("competitions").Doc(competition.ID).Collection("participants").Documents(s.ctx)
+	iter := s.client().Collection("competitions").Doc(competition.ID).Collection("participants").Documents(s.ctx)
 	for {
 		doc, err := iter.Next()
 		if err == iterator.Done {
@@ -216,6 +274,64 @@ func (s *FirebaseStorage) GetParticipants() []models.Participant {
 	return participants
 }
 
+// LinkDiscordUser 참가자와 디스코드 사용자를 연결합니다. 빈 ID면 연결을 해제하고, 다른 참가자에 연결된 사용자는 거부합니다.
+func (s *FirebaseStorage) LinkDiscordUser(baekjoonID, discordUserID string) error {
+	competition := s.GetCompetition()
+	if competition == nil {
+		return fmt.Errorf("no active competition")
+	}
+
+	return s.executeWithRetry(func() error {
+		participants := s.client().Collection("competitions").Doc(competition.ID).Collection("participants")
+		docRef := participants.Doc(baekjoonID)
+		// 연결 여부 확인과 갱신을 한 트랜잭션으로 묶어 한 사용자가 두 참가자에 연결되지 않게 합니다
+		err := s.client().RunTransaction(s.ctx, func(ctx context.Context, tx *firestore.Transaction) error {
+			docs, err := tx.GetAll([]*firestore.DocumentRef{docRef})
+			if err != nil {
+				return err
+			}
+			if !docs[0].Exists() {
+				return fmt.Errorf("participant not found: %s", baekjoonID)
+			}
+
+			linked, found, err := findDiscordLink(tx, participants, discordUserID)
+			if err != nil {
+				return err
+			}
+			if found && linked != baekjoonID {
+				return fmt.Errorf("discord user %s is already linked to %s", discordUserID, linked)
+			}
+
+			var value interface{} = discordUserID
+			if discordUserID == "" {
+				value = firestore.Delete
+			}
+			return tx.Update(docRef, []firestore.Update{{Path: "discordUserId", Value: value}})
+		})
+		if err != nil {
+			return fmt.Errorf("failed to link discord user for %s: %w", baekjoonID, err)
+		}
+
+		utils.Info("Linked participant %s to discord user %q", baekjoonID, discordUserID)
+		return nil
+	})
+}
+
+// findDiscordLink 트랜잭션 안에서 디스코드 사용자와 연결된 참가자의 백준 ID를 찾습니다 (빈 ID는 찾지 않음)
+func findDiscordLink(tx *firestore.Transaction, participants *firestore.CollectionRef, discordUserID string) (string, bool, error) {
+	if discordUserID == "" {
+		return "", false, nil
+	}
+	docs, err := tx.Documents(participants.Where("discordUserId", "==", discordUserID).Limit(1)).GetAll()
+	if err != nil {
+		return "", false, err
+	}
+	if len(docs) == 0 {
+		return "", false, nil
+	}
+	return docs[0].Ref.ID, true, nil
+}
+
 // RemoveParticipant 백준ID로 참가자를 Firestore에서 삭제합니다.
 func (s *FirebaseStorage) RemoveParticipant(baekjoonID string) error {
 	competition := s.GetCompetition()
@@ -223,7 +339,7 @@ func (s *FirebaseStorage) RemoveParticipant(baekjoonID string) error {
 		return fmt.Errorf("no active competition")
 	}
 
-	docRef := s.client.Collection("competitions").Doc(competition.ID).Collection("participants").Doc(baekjoonID)
+	docRef := s.client().Collection("competitions").Doc(competition.ID).Collection("participants").Doc(baekjoonID)
 
 	// 참가자 존재 확인
 	doc, err := docRef.Get(s.ctx)
@@ -240,28 +356,21 @@ func (s *FirebaseStorage) RemoveParticipant(baekjoonID string) error {
 		return fmt.Errorf("failed to remove participant from Firestore: %w", err)
 	}
 
+	// 해결 기록도 함께 정리 (실패해도 참가자 삭제는 유지)
+	if _, err := s.solvesDoc(competition.ID, baekjoonID).Delete(s.ctx); err != nil {
+		utils.Warn("Failed to remove solve records for %s: %v", baekjoonID, err)
+	}
+	if err := s.LeaveTeam(baekjoonID); err != nil {
+		utils.Debug("No team membership removed for %s: %v", baekjoonID, err)
+	}
+
 	utils.Info("Removed participant from Firestore: %s", baekjoonID)
 	return nil
 }
 
 // CreateCompetition 새로운 대회를 Firestore에 생성합니다.
-func (s *FirebaseStorage) CreateCompetition(name string, startDate, endDate time.Time) error {
-	// 모든 대회를 비활성화
-	iter := s.client.Collection("competitions").Where("isActive", "==", true).Documents(s.ctx)
-	for {
-		doc, err := iter.Next()
-		if err == iterator.Done {
-			break
-		}
-		if err != nil {
-			return fmt.Errorf("failed to iterate existing competitions: %w", err)
-		}
-		_, err = doc.Ref.Update(s.ctx, []firestore.Update{{Path: "isActive", Value: false}})
-		if err != nil {
-			return fmt.Errorf("failed to deactivate old competition: %w", err)
-		}
-	}
-
+func (s *FirebaseStorage) CreateCompetition(name string, startDate, endDate time.Time) (string, error) {
+	// 다른 활성 대회는 그대로 두어 여러 대회를 동시에 진행할 수 있습니다
 	newComp := models.Competition{
 		Name:              name,
 		StartDate:         startDate,
@@ -269,28 +378,80 @@ func (s *FirebaseStorage) CreateCompetition(name string, startDate, endDate time
 		BlackoutStartDate: endDate.AddDate(0, 0, -constants.BlackoutDays),
 		IsActive:          true,
 		ShowScoreboard:    true,
+		CreatedAt:         time.Now(),
 	}
 
-	_, _, err := s.client.Collection("competitions").Add(s.ctx, newComp)
-	return err
+	docRef, _, err := s.client().Collection("competitions").Add(s.ctx, newComp)
+	if err != nil {
+		return "", err
+	}
+	return docRef.ID, nil
+}
+
+// ForCompetition 지정한 대회를 대상으로 하는 저장소 뷰를 반환합니다. 연결은 공유합니다.
+func (s *FirebaseStorage) ForCompetition(competitionID string) interfaces.StorageRepository {
+	return &FirebaseStorage{
+		conn:          s.conn,
+		apiClient:     s.apiClient,
+		ctx:           s.ctx,
+		competitionID: competitionID,
+	}
 }
 
-// GetCompetition 현재 활성화된 대회를 Firestore에서 조회합니다.
+// GetCompetition 작업 대상 대회를 Firestore에서 조회합니다.
+// 대상이 지정되지 않았으면 가장 최근에 만든 활성 대회를 반환합니다.
 func (s *FirebaseStorage) GetCompetition() *models.Competition {
-	iter := s.client.Collection("competitions").Where("isActive", "==", true).Limit(1).Documents(s.ctx)
-	doc, err := iter.Next()
-	if err == iterator.Done {
-		return nil
+	if s.competitionID != "" {
+		doc, err := s.client().Collection("competitions").Doc(s.competitionID).Get(s.ctx)
+		if err != nil {
+			utils.Error("Failed to get competition %s: %v", s.competitionID, err)
+			return nil
+		}
+		var c models.Competition
+		doc.DataTo(&c)
+		c.ID = doc.Ref.ID
+		return &c
 	}
-	if err != nil {
-		utils.Error("Failed to get active competition: %v", err)
+
+	competitions := s.GetActiveCompetitions()
+	if len(competitions) == 0 {
 		return nil
 	}
+	return &competitions[len(competitions)-1]
+}
 
-	var c models.Competition
-	doc.DataTo(&c)
-	c.ID = doc.Ref.ID
-	return &c
+// GetActiveCompetitions 모든 활성 대회를 생성 순서대로 조회합니다.
+func (s *FirebaseStorage) GetActiveCompetitions() []models.Competition {
+	competitions := make([]models.Competition, 0)
+	iter := s.client().Collection("competitions").Where("isActive", "==", true).Documents(s.ctx)
+	for {
+		doc, err := iter.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			utils.Error("Failed to get active competitions: %v", err)
+			return competitions
+		}
+
+		var c models.Competition
+		doc.DataTo(&c)
+		c.ID = doc.Ref.ID
+		competitions = append(competitions, c)
+	}
+
+	sortCompetitions(competitions)
+	return competitions
+}
+
+// sortCompetitions 대회를 생성 시각(같으면 ID) 순으로 정렬합니다.
+func sortCompetitions(competitions []models.Competition) {
+	sort.SliceStable(competitions, func(i, j int) bool {
+		if !competitions[i].CreatedAt.Equal(competitions[j].CreatedAt) {
+			return competitions[i].CreatedAt.Before(competitions[j].CreatedAt)
+		}
+		return competitions[i].ID < competitions[j].ID
+	})
 }
 
 // updateActiveCompetitionField 활성화된 대회의 특정 필드를 업데이트합니다.
@@ -299,7 +460,7 @@ func (s *FirebaseStorage) updateActiveCompetitionField(updates []firestore.Updat
 	if competition == nil {
 		return fmt.Errorf("no active competition to update")
 	}
-	_, err := s.client.Collection("competitions").Doc(competition.ID).Update(s.ctx, updates)
+	_, err := s.client().Collection("competitions").Doc(competition.ID).Update(s.ctx, updates)
 	return err
 }
 
@@ -319,6 +480,72 @@ func (s *FirebaseStorage) UpdateCompetitionEndDate(endDate time.Time) error {
 	return s.updateActiveCompetitionField(updates)
 }
 
+// UpdateCompetitionScoringRules 대회 점수 규칙을 저장합니다. nil이면 규칙을 제거하여 기본 규칙으로 되돌립니다.
+func (s *FirebaseStorage) UpdateCompetitionScoringRules(rules *models.ScoringRules) error {
+	if rules == nil {
+		return s.updateActiveCompetitionField([]firestore.Update{{Path: "scoringRules", Value: firestore.Delete}})
+	}
+	return s.updateActiveCompetitionField([]firestore.Update{{Path: "scoringRules", Value: rules}})
+}
+
+// UpdateCompetitionEligibility 대회 참가 자격 요건을 저장합니다. nil이면 요건을 제거하여 기본 요건으로 되돌립니다.
+func (s *FirebaseStorage) UpdateCompetitionEligibility(policy *models.EligibilityPolicy) error {
+	if policy == nil {
+		return s.updateActiveCompetitionField([]firestore.Update{{Path: "eligibility", Value: firestore.Delete}})
+	}
+	return s.updateActiveCompetitionField([]firestore.Update{{Path: "eligibility", Value: policy}})
+}
+
+// UpdateCompetitionProblems 문제 목록을 저장하고 대회를 문제 목록 대회로 전환합니다. 비어 있으면 자유 해결 대회로 되돌립니다.
+func (s *FirebaseStorage) UpdateCompetitionProblems(problems []models.TargetProblem) error {
+	if len(problems) == 0 {
+		return s.updateActiveCompetitionField([]firestore.Update{
+			{Path: "type", Value: firestore.Delete},
+			{Path: "problems", Value: firestore.Delete},
+		})
+	}
+	return s.updateActiveCompetitionField([]firestore.Update{
+		{Path: "type", Value: models.CompetitionTypeProblemSet},
+		{Path: "problems", Value: problems},
+	})
+}
+
+// UpdateCompetitionChannel 대회의 기본 채널을 지정합니다. 같은 채널을 쓰던 다른 활성 대회에서는 해제합니다.
+func (s *FirebaseStorage) UpdateCompetitionChannel(channelID string) error {
+	competition := s.GetCompetition()
+	if competition == nil {
+		return fmt.Errorf("no active competition to update")
+	}
+
+	if channelID != "" {
+		for _, other := range s.GetActiveCompetitions() {
+			if other.ID == competition.ID || other.ChannelID != channelID {
+				continue
+			}
+			if _, err := s.client().Collection("competitions").Doc(other.ID).Update(s.ctx, []firestore.Update{{Path: "channelId", Value: firestore.Delete}}); err != nil {
+				return fmt.Errorf("failed to release channel from competition %s: %w", other.ID, err)
+			}
+		}
+	}
+	return s.updateActiveCompetitionField([]firestore.Update{{Path: "channelId", Value: channelID}})
+}
+
+// UpdateCompetitionSchedule 일일 스코어보드 발송 시각("HH:MM")을 변경합니다. 비어 있으면 기본 시각을 사용합니다.
+func (s *FirebaseStorage) UpdateCompetitionSchedule(schedule string) error {
+	if schedule == "" {
+		return s.updateActiveCompetitionField([]firestore.Update{{Path: "scoreboardTime", Value: firestore.Delete}})
+	}
+	return s.updateActiveCompetitionField([]firestore.Update{{Path: "scoreboardTime", Value: schedule}})
+}
+
+// UpdateCompetitionRosterSheet 참가 명단을 자동 동기화할 스프레드시트를 지정합니다. 비어 있으면 동기화를 끕니다.
+func (s *FirebaseStorage) UpdateCompetitionRosterSheet(spreadsheetID string) error {
+	if spreadsheetID == "" {
+		return s.updateActiveCompetitionField([]firestore.Update{{Path: "rosterSheetId", Value: firestore.Delete}})
+	}
+	return s.updateActiveCompetitionField([]firestore.Update{{Path: "rosterSheetId", Value: spreadsheetID}})
+}
+
 func (s *FirebaseStorage) SetScoreboardVisibility(visible bool) error {
 	return s.updateActiveCompetitionField([]firestore.Update{{Path: "showScoreboard", Value: visible}})
 }
@@ -332,23 +559,106 @@ func (s *FirebaseStorage) IsBlackoutPeriod() bool {
 	return now.After(comp.BlackoutStartDate) && now.Before(comp.EndDate)
 }
 
-func (s *FirebaseStorage) fetchStartingProblems(baekjoonID string) ([]int, int) {
-	ctx := context.Background()
-	top100, err := s.apiClient.GetUserTop100(ctx, baekjoonID)
+// BackfillStartSnapshots 전체 스냅샷이 없는 기존 참가자들의 등록 시점 스냅샷을 생성합니다.
+func (s *FirebaseStorage) BackfillStartSnapshots() (int, error) {
+	competition := s.GetCompetition()
+	if competition == nil {
+		return 0, fmt.Errorf("no active competition")
+	}
+
+	updated := 0
+	for _, participant := range s.GetParticipants() {
+		if participant.HasFullSnapshot() {
+			continue
+		}
+
+		snapshot, count, err := backfillParticipantSnapshot(s.apiClient, participant)
+		if err != nil {
+			utils.Warn("Failed to backfill snapshot for participant %s: %v", participant.BaekjoonID, err)
+			continue
+		}
+
+		err = s.executeWithRetry(func() error {
+			_, err := s.client().Collection("competitions").Doc(competition.ID).Collection("participants").Doc(participant.ID).Update(s.ctx, []firestore.Update{
+				{Path: "startSnapshot", Value: snapshot},
+				{Path: "startProblemCount", Value: count},
+			})
+			return err
+		})
+		if err != nil {
+			return updated, fmt.Errorf("failed to backfill snapshot for %s: %w", participant.BaekjoonID, err)
+		}
+
+		utils.Info("Backfilled start snapshot for participant %s (%d problems)", participant.BaekjoonID, count)
+		updated++
+	}
+	return updated, nil
+}
+
+// solvesDoc 참가자의 해결 기록 문서 참조를 반환합니다.
+func (s *FirebaseStorage) solvesDoc(competitionID, baekjoonID string) *firestore.DocumentRef {
+	return s.client().Collection("competitions").Doc(competitionID).Collection("solves").Doc(baekjoonID)
+}
+
+// RecordSolves 처음 발견된 문제의 발견 시각을 트랜잭션으로 기록하고 전체 기록을 반환합니다.
+// 이미 기록된 문제의 시각은 변경하지 않으므로 여러 수집기가 동시에 호출해도 안전합니다.
+func (s *FirebaseStorage) RecordSolves(baekjoonID string, problemIDs []int, seenAt time.Time) (map[int]time.Time, error) {
+	competition := s.GetCompetition()
+	if competition == nil {
+		return nil, fmt.Errorf("no active competition")
+	}
+
+	var records map[int]time.Time
+	err := s.executeWithRetry(func() error {
+		return s.client().RunTransaction(s.ctx, func(ctx context.Context, tx *firestore.Transaction) error {
+			docRef := s.solvesDoc(competition.ID, baekjoonID)
+			// GetAll은 문서가 없어도 에러 없이 Exists() == false 스냅샷을 반환합니다
+			docs, err := tx.GetAll([]*firestore.DocumentRef{docRef})
+			if err != nil {
+				return err
+			}
+
+			var stored solveRecordDoc
+			if len(docs) > 0 && docs[0].Exists() {
+				if err := docs[0].DataTo(&stored); err != nil {
+					return err
+				}
+			}
+
+			records = decodeFirstSeen(stored.FirstSeen)
+			additions := mergeFirstSeen(records, problemIDs, seenAt)
+			if len(additions) == 0 {
+				return nil
+			}
+			return tx.Set(docRef, map[string]interface{}{"firstSeen": additions}, firestore.MergeAll)
+		})
+	})
 	if err != nil {
-		utils.Warn("Failed to load starting problems for participant %s: %v", baekjoonID, err)
-		return []int{}, 0
+		return nil, fmt.Errorf("failed to record solves for %s: %w", baekjoonID, err)
 	}
+	return records, nil
+}
 
-	// 메모리 할당 최적화: 미리 용량 할당
-	startProblemIDs := make([]int, 0, len(top100.Items))
-	for _, problem := range top100.Items {
-		startProblemIDs = append(startProblemIDs, problem.ProblemID)
+// GetSolveRecords 참가자의 문제별 최초 발견 시각을 조회합니다.
+func (s *FirebaseStorage) GetSolveRecords(baekjoonID string) (map[int]time.Time, error) {
+	competition := s.GetCompetition()
+	if competition == nil {
+		return nil, fmt.Errorf("no active competition")
 	}
 
-	startProblemCount := len(startProblemIDs)
-	utils.Info("Loaded %d starting problems for participant %s", startProblemCount, baekjoonID)
-	return startProblemIDs, startProblemCount
+	doc, err := s.solvesDoc(competition.ID, baekjoonID).Get(s.ctx)
+	if doc != nil && !doc.Exists() {
+		return map[int]time.Time{}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get solve records for %s: %w", baekjoonID, err)
+	}
+
+	var stored solveRecordDoc
+	if err := doc.DataTo(&stored); err != nil {
+		return nil, fmt.Errorf("failed to decode solve records for %s: %w", baekjoonID, err)
+	}
+	return decodeFirstSeen(stored.FirstSeen), nil
 }
 
 // SaveCompetition Firestore에서 쓰기 작업이 즉시 이루어지므로 no-op입니다.
@@ -363,9 +673,9 @@ func (s *FirebaseStorage) SaveParticipants() error {
 
 // Close Firestore 클라이언트를 종료하고 리소스를 정리합니다.
 func (s *FirebaseStorage) Close() error {
-	if s.client != nil {
+	if client := s.client(); client != nil {
 		utils.Info("Closing Firestore client")
-		return s.client.Close()
+		return client.Close()
 	}
 	return nil
 }
@@ -0,0 +1,51 @@
+package storage
+
+import (
+	"context"
+	"testing"
+
+	"cloud.google.com/go/firestore"
+	"google.golang.org/api/option"
+)
+
+// newOfflineClient 실제로 연결하지 않는 Firestore 클라이언트를 만듭니다 (gRPC 연결은 첫 요청 때 맺음)
+func newOfflineClient(ctx context.Context) (*firestore.Client, error) {
+	return firestore.NewClient(ctx, "test-project",
+		option.WithEndpoint("localhost:0"),
+		option.WithoutAuthentication())
+}
+
+func TestReconnectIsSharedAcrossViews(t *testing.T) {
+	ctx := context.Background()
+	client, err := newOfflineClient(ctx)
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+	root := &FirebaseStorage{conn: &firestoreConn{client: client, dial: newOfflineClient}, ctx: ctx}
+	view := root.ForCompetition("a").(*FirebaseStorage)
+	sibling := root.ForCompetition("b").(*FirebaseStorage)
+	defer root.Close()
+
+	if err := view.reconnectFirestore(client); err != nil {
+		t.Fatalf("Failed to reconnect: %v", err)
+	}
+
+	reconnected := view.client()
+	if reconnected == client {
+		t.Fatal("Expected reconnect to replace the client")
+	}
+	if sibling.client() != reconnected || root.client() != reconnected {
+		t.Error("Expected the sibling view and the root to use the reconnected client")
+	}
+	if sibling.client().Collection("competitions").Doc("b") == nil {
+		t.Error("Expected the sibling view to build references with the new client")
+	}
+
+	// 다른 뷰가 같은 실패로 재연결하려 하면 이미 바뀐 클라이언트를 그대로 씁니다
+	if err := sibling.reconnectFirestore(client); err != nil {
+		t.Fatalf("Failed to reconnect sibling: %v", err)
+	}
+	if sibling.client() != reconnected {
+		t.Error("Expected a stale reconnect to keep the current client")
+	}
+}
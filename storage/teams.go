@@ -0,0 +1,202 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/ssugameworks/kkemi/constants"
+	"github.com/ssugameworks/kkemi/interfaces"
+	"github.com/ssugameworks/kkemi/models"
+	"github.com/ssugameworks/kkemi/utils"
+
+	"cloud.google.com/go/firestore"
+	"google.golang.org/api/iterator"
+)
+
+// teamDocID 팀 이름으로 문서 ID를 만듭니다 (대소문자 무시)
+func teamDocID(name string) string {
+	return strings.ToLower(name)
+}
+
+// teamsCollection 대회의 팀 컬렉션 참조를 반환합니다.
+func (s *FirebaseStorage) teamsCollection(competitionID string) *firestore.CollectionRef {
+	return s.client().Collection("competitions").Doc(competitionID).Collection("teams")
+}
+
+// GetTeams 현재 대회의 모든 팀을 이름순으로 조회합니다.
+func (s *FirebaseStorage) GetTeams() []models.Team {
+	competition := s.GetCompetition()
+	if competition == nil {
+		return []models.Team{}
+	}
+
+	teams := make([]models.Team, 0)
+	iter := s.teamsCollection(competition.ID).Documents(s.ctx)
+	for {
+		doc, err := iter.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			utils.Error("Failed to iterate teams: %v", err)
+			return teams
+		}
+
+		var team models.Team
+		doc.DataTo(&team)
+		team.ID = doc.Ref.ID
+		teams = append(teams, team)
+	}
+
+	sort.Slice(teams, func(i, j int) bool { return teams[i].Name < teams[j].Name })
+	return teams
+}
+
+// CreateTeam 새 팀을 만듭니다.
+func (s *FirebaseStorage) CreateTeam(name string) error {
+	if !utils.IsValidTeamName(name) {
+		return fmt.Errorf("invalid team name: %s", name)
+	}
+
+	competition := s.GetCompetition()
+	if competition == nil {
+		return fmt.Errorf("no active competition")
+	}
+
+	team := models.Team{
+		Name:      utils.SanitizeString(name),
+		Members:   []string{},
+		CreatedAt: time.Now(),
+	}
+	// Create는 같은 ID의 문서가 있으면 실패하므로 중복 팀 생성을 막습니다
+	if _, err := s.teamsCollection(competition.ID).Doc(teamDocID(name)).Create(s.ctx, team); err != nil {
+		return fmt.Errorf("failed to create team %s: %w", name, err)
+	}
+
+	utils.Info("Created team: %s", name)
+	return nil
+}
+
+// DeleteTeam 팀을 삭제합니다. 팀원은 팀 없는 참가자로 남습니다.
+func (s *FirebaseStorage) DeleteTeam(name string) error {
+	competition := s.GetCompetition()
+	if competition == nil {
+		return fmt.Errorf("no active competition")
+	}
+
+	docRef := s.teamsCollection(competition.ID).Doc(teamDocID(name))
+	doc, err := docRef.Get(s.ctx)
+	if doc != nil && !doc.Exists() {
+		return fmt.Errorf("team not found: %s", name)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to check team existence: %w", err)
+	}
+
+	if _, err := docRef.Delete(s.ctx); err != nil {
+		return fmt.Errorf("failed to delete team %s: %w", name, err)
+	}
+
+	utils.Info("Deleted team: %s", name)
+	return nil
+}
+
+// AssignTeam 참가자를 팀에 배정합니다. 다른 팀에 속해 있었다면 그 팀에서 빠집니다.
+func (s *FirebaseStorage) AssignTeam(teamName, baekjoonID string) error {
+	competition := s.GetCompetition()
+	if competition == nil {
+		return fmt.Errorf("no active competition")
+	}
+
+	return s.executeWithRetry(func() error {
+		return s.client().RunTransaction(s.ctx, func(ctx context.Context, tx *firestore.Transaction) error {
+			targetRef := s.teamsCollection(competition.ID).Doc(teamDocID(teamName))
+			targetDoc, err := tx.Get(targetRef)
+			if targetDoc != nil && !targetDoc.Exists() {
+				return fmt.Errorf("team not found: %s", teamName)
+			}
+			if err != nil {
+				return err
+			}
+
+			var target models.Team
+			if err := targetDoc.DataTo(&target); err != nil {
+				return err
+			}
+			if target.HasMember(baekjoonID) {
+				return nil
+			}
+			if len(target.Members) >= constants.MaxTeamSize {
+				return fmt.Errorf("%w: %s", interfaces.ErrTeamFull, teamName)
+			}
+
+			current, err := tx.Documents(s.teamsCollection(competition.ID).Where("members", "array-contains", baekjoonID)).GetAll()
+			if err != nil {
+				return err
+			}
+			for _, doc := range current {
+				if err := tx.Update(doc.Ref, []firestore.Update{{Path: "members", Value: firestore.ArrayRemove(baekjoonID)}}); err != nil {
+					return err
+				}
+			}
+			return tx.Update(targetRef, []firestore.Update{{Path: "members", Value: firestore.ArrayUnion(baekjoonID)}})
+		})
+	})
+}
+
+// LeaveTeam 참가자를 소속 팀에서 뺍니다. 백준 ID는 대소문자를 구분하지 않습니다.
+func (s *FirebaseStorage) LeaveTeam(baekjoonID string) error {
+	competition := s.GetCompetition()
+	if competition == nil {
+		return fmt.Errorf("no active competition")
+	}
+
+	return s.executeWithRetry(func() error {
+		return s.client().RunTransaction(s.ctx, func(ctx context.Context, tx *firestore.Transaction) error {
+			member, err := s.canonicalBaekjoonID(tx, competition.ID, baekjoonID)
+			if err != nil {
+				return err
+			}
+			docs, err := tx.Documents(s.teamsCollection(competition.ID).Where("members", "array-contains", member)).GetAll()
+			if err != nil {
+				return fmt.Errorf("failed to find team of %s: %w", baekjoonID, err)
+			}
+			if len(docs) == 0 {
+				return fmt.Errorf("participant %s is not in a team", baekjoonID)
+			}
+
+			for _, doc := range docs {
+				if err := tx.Update(doc.Ref, []firestore.Update{{Path: "members", Value: firestore.ArrayRemove(member)}}); err != nil {
+					return fmt.Errorf("failed to leave team: %w", err)
+				}
+			}
+			return nil
+		})
+	})
+}
+
+// canonicalBaekjoonID 트랜잭션 안에서 참가자 문서에 저장된 대소문자 그대로의 백준 ID를 찾습니다 (참가자가 아니면 입력값 그대로)
+func (s *FirebaseStorage) canonicalBaekjoonID(tx *firestore.Transaction, competitionID, baekjoonID string) (string, error) {
+	participants := s.client().Collection("competitions").Doc(competitionID).Collection("participants")
+	docs, err := tx.GetAll([]*firestore.DocumentRef{participants.Doc(baekjoonID)})
+	if err != nil {
+		return "", err
+	}
+	if docs[0].Exists() {
+		return baekjoonID, nil
+	}
+
+	all, err := tx.Documents(participants).GetAll()
+	if err != nil {
+		return "", err
+	}
+	for _, doc := range all {
+		if strings.EqualFold(doc.Ref.ID, baekjoonID) {
+			return doc.Ref.ID, nil
+		}
+	}
+	return baekjoonID, nil
+}
@@ -0,0 +1,78 @@
+package tracker
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/ssugameworks/kkemi/api"
+	"github.com/ssugameworks/kkemi/interfaces"
+	"github.com/ssugameworks/kkemi/models"
+	"github.com/ssugameworks/kkemi/utils"
+)
+
+// SolveTracker solved.ac를 주기적으로 조회하여 참가자별 문제 최초 발견 시각을 기록합니다
+type SolveTracker struct {
+	storage interfaces.StorageRepository
+	client  interfaces.APIClient
+	now     func() time.Time
+}
+
+// NewSolveTracker 새로운 SolveTracker 인스턴스를 생성합니다
+func NewSolveTracker(storage interfaces.StorageRepository, client interfaces.APIClient) *SolveTracker {
+	return &SolveTracker{
+		storage: storage,
+		client:  client,
+		now:     time.Now,
+	}
+}
+
+// Track 참가자의 현재 해결 문제를 조회하고, 등록 시점 스냅샷에 없는 문제 중 처음 보는 문제를 기록합니다
+// 조회한 해결 문제 목록과 누적된 최초 발견 기록을 함께 반환합니다
+func (t *SolveTracker) Track(ctx context.Context, participant models.Participant) ([]api.ProblemInfo, map[int]time.Time, error) {
+	solved, err := t.client.GetUserSolvedProblems(ctx, participant.BaekjoonID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	startProblems := make(map[int]bool)
+	for _, id := range participant.GetStartProblemIDs() {
+		startProblems[id] = true
+	}
+
+	newIDs := make([]int, 0)
+	for _, problem := range solved {
+		if !startProblems[problem.ProblemID] {
+			newIDs = append(newIDs, problem.ProblemID)
+		}
+	}
+
+	records, err := t.storage.RecordSolves(participant.BaekjoonID, newIDs, t.now())
+	if err != nil {
+		return nil, nil, err
+	}
+	return solved, records, nil
+}
+
+// PollAll 활성 대회의 모든 참가자를 한 번씩 조회하여 해결 기록을 갱신합니다
+// 점수 인정 구간이 끝난 뒤에는 기록을 더 이상 늘리지 않아 최종 순위가 고정됩니다
+func (t *SolveTracker) PollAll(ctx context.Context) (int, error) {
+	competition := t.storage.GetCompetition()
+	if competition == nil || !competition.IsActive {
+		return 0, fmt.Errorf("no active competition")
+	}
+	if !t.now().Before(competition.ScoringWindowEnd()) {
+		utils.Debug("Scoring window closed - skipping solve tracking")
+		return 0, nil
+	}
+
+	tracked := 0
+	for _, participant := range t.storage.GetParticipants() {
+		if _, _, err := t.Track(ctx, participant); err != nil {
+			utils.Warn("Failed to track solves for participant %s: %v", participant.BaekjoonID, err)
+			continue
+		}
+		tracked++
+	}
+	return tracked, nil
+}
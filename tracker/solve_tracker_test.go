@@ -0,0 +1,169 @@
+package tracker
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ssugameworks/kkemi/api"
+	"github.com/ssugameworks/kkemi/storage"
+)
+
+type mockAPIClient struct {
+	solved []api.ProblemInfo
+}
+
+func (m *mockAPIClient) GetUserInfo(ctx context.Context, handle string) (*api.UserInfo, error) {
+	return &api.UserInfo{Handle: handle}, nil
+}
+
+func (m *mockAPIClient) GetUserTop100(ctx context.Context, handle string) (*api.Top100Response, error) {
+	return &api.Top100Response{}, nil
+}
+
+func (m *mockAPIClient) GetUserAdditionalInfo(ctx context.Context, handle string) (*api.UserAdditionalInfo, error) {
+	return nil, nil
+}
+
+func (m *mockAPIClient) GetUserOrganizations(ctx context.Context, handle string) ([]api.Organization, error) {
+	return []api.Organization{}, nil
+}
+
+func (m *mockAPIClient) GetOrganization(ctx context.Context, organizationID int) (*api.Organization, error) {
+	return nil, nil
+}
+
+func (m *mockAPIClient) GetUserSolvedProblems(ctx context.Context, handle string) ([]api.ProblemInfo, error) {
+	return m.solved, nil
+}
+
+func (m *mockAPIClient) GetProblemInfo(ctx context.Context, problemID int) (*api.ProblemInfo, error) {
+	return &api.ProblemInfo{ProblemID: problemID}, nil
+}
+
+func (m *mockAPIClient) GetClassProblemIDs(ctx context.Context, class int) ([]int, error) {
+	return []int{}, nil
+}
+
+func (m *mockAPIClient) SearchProblems(ctx context.Context, query string, page int) (*api.ProblemSearchResponse, error) {
+	return &api.ProblemSearchResponse{}, nil
+}
+
+func TestSolveTracker_FirstSeenIsNeverOverwritten(t *testing.T) {
+	client := &mockAPIClient{solved: []api.ProblemInfo{{ProblemID: 1000, Level: 5}}}
+	store := storage.NewInMemoryStorage(client)
+
+	start := time.Now().AddDate(0, 0, -1)
+	if _, err := store.CreateCompetition("test", start, start.AddDate(0, 0, 7)); err != nil {
+		t.Fatalf("Failed to create competition: %v", err)
+	}
+	// 등록 시점에 1000번을 이미 해결한 상태
+	if err := store.AddParticipant("홍길동", "testuser", 6, 0, 0, ""); err != nil {
+		t.Fatalf("Failed to add participant: %v", err)
+	}
+	participant := store.GetParticipants()[0]
+
+	tracker := NewSolveTracker(store, client)
+	firstPoll := start.Add(2 * time.Hour)
+	tracker.now = func() time.Time { return firstPoll }
+
+	client.solved = append(client.solved, api.ProblemInfo{ProblemID: 2000, Level: 7})
+	_, records, err := tracker.Track(context.Background(), participant)
+	if err != nil {
+		t.Fatalf("Track failed: %v", err)
+	}
+	if _, ok := records[1000]; ok {
+		t.Error("Problem solved before registration should not be recorded")
+	}
+	if !records[2000].Equal(firstPoll) {
+		t.Errorf("Expected first seen %v, got %v", firstPoll, records[2000])
+	}
+
+	// 이후 조회에서도 최초 발견 시각은 유지되어야 함
+	tracker.now = func() time.Time { return firstPoll.Add(time.Hour) }
+	client.solved = append(client.solved, api.ProblemInfo{ProblemID: 3000, Level: 8})
+	_, records, err = tracker.Track(context.Background(), participant)
+	if err != nil {
+		t.Fatalf("Track failed: %v", err)
+	}
+	if !records[2000].Equal(firstPoll) {
+		t.Errorf("First seen time was overwritten: %v", records[2000])
+	}
+	if !records[3000].Equal(firstPoll.Add(time.Hour)) {
+		t.Errorf("Expected new problem recorded at second poll, got %v", records[3000])
+	}
+}
+
+func TestSolveTracker_PollAllStopsAfterWindow(t *testing.T) {
+	client := &mockAPIClient{}
+	store := storage.NewInMemoryStorage(client)
+
+	start := time.Now().AddDate(0, 0, -10)
+	end := start.AddDate(0, 0, 3)
+	if _, err := store.CreateCompetition("test", start, end); err != nil {
+		t.Fatalf("Failed to create competition: %v", err)
+	}
+	if err := store.AddParticipant("홍길동", "testuser", 6, 0, 0, ""); err != nil {
+		t.Fatalf("Failed to add participant: %v", err)
+	}
+
+	client.solved = []api.ProblemInfo{{ProblemID: 2000, Level: 7}}
+	tracker := NewSolveTracker(store, client)
+
+	tracked, err := tracker.PollAll(context.Background())
+	if err != nil {
+		t.Fatalf("PollAll failed: %v", err)
+	}
+	if tracked != 0 {
+		t.Errorf("Expected no tracking after scoring window, got %d", tracked)
+	}
+
+	records, err := store.GetSolveRecords("testuser")
+	if err != nil {
+		t.Fatalf("GetSolveRecords failed: %v", err)
+	}
+	if len(records) != 0 {
+		t.Errorf("Expected no records after window closed, got %v", records)
+	}
+}
+
+func TestSolveTracker_CompetitionsAreIsolated(t *testing.T) {
+	client := &mockAPIClient{}
+	store := storage.NewInMemoryStorage(client)
+
+	start := time.Now().AddDate(0, 0, -1)
+	freshmanID, err := store.CreateCompetition("freshman", start, start.AddDate(0, 0, 7))
+	if err != nil {
+		t.Fatalf("Failed to create competition: %v", err)
+	}
+	openID, err := store.CreateCompetition("open", start, start.AddDate(0, 0, 7))
+	if err != nil {
+		t.Fatalf("Failed to create competition: %v", err)
+	}
+	if len(store.GetActiveCompetitions()) != 2 {
+		t.Fatalf("Expected both competitions to stay active")
+	}
+
+	freshman := store.ForCompetition(freshmanID)
+	open := store.ForCompetition(openID)
+	if err := freshman.AddParticipant("홍길동", "testuser", 6, 0, 0, ""); err != nil {
+		t.Fatalf("Failed to add participant: %v", err)
+	}
+	if len(open.GetParticipants()) != 0 {
+		t.Error("Participant leaked into the other competition")
+	}
+
+	client.solved = []api.ProblemInfo{{ProblemID: 2000, Level: 7}}
+	tracked, err := NewSolveTracker(freshman, client).PollAll(context.Background())
+	if err != nil || tracked != 1 {
+		t.Fatalf("Expected 1 tracked participant, got %d (%v)", tracked, err)
+	}
+
+	records, err := open.GetSolveRecords("testuser")
+	if err != nil {
+		t.Fatalf("GetSolveRecords failed: %v", err)
+	}
+	if len(records) != 0 {
+		t.Errorf("Solve records leaked into the other competition: %v", records)
+	}
+}
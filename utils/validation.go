@@ -3,7 +3,6 @@ package utils
 import (
 	"fmt"
 	"net/url"
-	"os"
 	"regexp"
 	"strings"
 	"time"
@@ -179,6 +178,37 @@ func IsValidBaekjoonID(id string) bool {
 	return true
 }
 
+// IsValidTeamName 팀 이름 유효성 검사 (사용자명 규칙 + 공백 불가 + 스코어보드 열 너비 이내)
+func IsValidTeamName(name string) bool {
+	if strings.ContainsAny(name, " \t") {
+		return false
+	}
+	if GetDisplayWidth(name) > constants.MaxTeamNameDisplayWidth {
+		return false
+	}
+	return IsValidUsername(name)
+}
+
+// IsValidSeasonName 시즌 이름 유효성 검사 (사용자명 규칙 + 공백 불가)
+func IsValidSeasonName(name string) bool {
+	return !strings.ContainsAny(name, " \t") && IsValidUsername(name)
+}
+
+// spreadsheetIDPattern 구글 스프레드시트 ID 형식 (주소의 /d/ 뒤 부분)
+var spreadsheetIDPattern = regexp.MustCompile(`^[A-Za-z0-9_-]{20,100}$`)
+
+// ParseSpreadsheetID 스프레드시트 ID 또는 주소에서 ID를 꺼내 검증합니다
+func ParseSpreadsheetID(input string) (string, bool) {
+	id := strings.TrimSpace(input)
+	if _, rest, found := strings.Cut(id, "/spreadsheets/d/"); found {
+		id, _, _ = strings.Cut(rest, "/")
+	}
+	if !spreadsheetIDPattern.MatchString(id) {
+		return "", false
+	}
+	return id, true
+}
+
 // IsValidDateRange 날짜 유효성 검사
 func IsValidDateRange(startDate, endDate time.Time) bool {
 	return !endDate.Before(startDate)
@@ -289,51 +319,6 @@ func SanitizeString(s string) string {
 	return strings.TrimSpace(cleaned.String())
 }
 
-// IsNameInBackupList 백업 참가자 명단에서 이름을 확인합니다
-func IsNameInBackupList(name string) bool {
-	backupList := getBackupParticipantList()
-	if len(backupList) == 0 {
-		return false
-	}
-
-	normalizedName := normalizeNameForComparison(name)
-
-	for _, participant := range backupList {
-		normalizedParticipant := normalizeNameForComparison(participant)
-		if normalizedName == normalizedParticipant {
-			return true
-		}
-	}
-	return false
-}
-
-// getBackupParticipantList 환경변수에서 백업 참가자 명단을 가져옵니다
-func getBackupParticipantList() []string {
-	envValue := os.Getenv(constants.EnvBackupParticipantList)
-	if envValue == "" {
-		return []string{}
-	}
-
-	// 쉼표로 구분된 문자열을 배열로 변환
-	participants := strings.Split(envValue, ",")
-
-	// 각 이름의 앞뒤 공백 제거
-	for i, participant := range participants {
-		participants[i] = strings.TrimSpace(participant)
-	}
-
-	return participants
-}
-
-// normalizeNameForComparison 이름 비교를 위한 정규화 (공백 제거, 소문자 변환)
-func normalizeNameForComparison(name string) string {
-	// 앞뒤 공백 제거 및 소문자 변환
-	normalized := normalizeString(name)
-	// 중간 공백 제거
-	normalized = strings.ReplaceAll(normalized, " ", "")
-	return normalized
-}
-
 // IsValidURL URL 형식 검증
 func IsValidURL(urlStr string) bool {
 	if urlStr == "" {